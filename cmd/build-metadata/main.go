@@ -4,10 +4,16 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"runtime"
+	"runtime/debug"
 	"strconv"
 	"strings"
 	"time"
@@ -23,17 +29,24 @@ import (
 	_ "github.com/lfreleng-actions/build-metadata-action/internal/extractor/golang"
 	_ "github.com/lfreleng-actions/build-metadata-action/internal/extractor/haskell"
 	_ "github.com/lfreleng-actions/build-metadata-action/internal/extractor/helm"
-	_ "github.com/lfreleng-actions/build-metadata-action/internal/extractor/java"
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor/java"
 	_ "github.com/lfreleng-actions/build-metadata-action/internal/extractor/javascript"
 	_ "github.com/lfreleng-actions/build-metadata-action/internal/extractor/julia"
+	_ "github.com/lfreleng-actions/build-metadata-action/internal/extractor/nim"
+	_ "github.com/lfreleng-actions/build-metadata-action/internal/extractor/openapi"
+	_ "github.com/lfreleng-actions/build-metadata-action/internal/extractor/perl"
 	_ "github.com/lfreleng-actions/build-metadata-action/internal/extractor/php"
 	python "github.com/lfreleng-actions/build-metadata-action/internal/extractor/python"
+	_ "github.com/lfreleng-actions/build-metadata-action/internal/extractor/r"
 	_ "github.com/lfreleng-actions/build-metadata-action/internal/extractor/ruby"
 	_ "github.com/lfreleng-actions/build-metadata-action/internal/extractor/rust"
 	_ "github.com/lfreleng-actions/build-metadata-action/internal/extractor/scala"
 	_ "github.com/lfreleng-actions/build-metadata-action/internal/extractor/swift"
 	_ "github.com/lfreleng-actions/build-metadata-action/internal/extractor/terraform"
+	_ "github.com/lfreleng-actions/build-metadata-action/internal/extractor/zig"
 	"github.com/lfreleng-actions/build-metadata-action/internal/output"
+	"github.com/lfreleng-actions/build-metadata-action/internal/posture"
+	"github.com/lfreleng-actions/build-metadata-action/internal/repository"
 	"github.com/lfreleng-actions/build-metadata-action/internal/version"
 	"github.com/sethvargo/go-githubactions"
 )
@@ -41,10 +54,104 @@ import (
 const (
 	// Action metadata
 	actionName        = "build-metadata-action"
-	actionVersion     = "1.0.0"
 	actionDescription = "Universal action to capture and display metadata related to project builds"
 )
 
+// Build identity recorded in the generator block, injected at build time
+// with -ldflags "-X main.actionVersion=v1.2.3 -X main.actionCommit=<sha>"
+var (
+	actionVersion = "dev"
+	actionCommit  = ""
+)
+
+// cliFormats are the values accepted by the --format flag
+var cliFormats = []string{"summary", "markdown", "json", "yaml", "sarif"}
+
+// parseFormatFlag validates the --format flag value
+func parseFormatFlag(value string) (string, error) {
+	format := strings.ToLower(strings.TrimSpace(value))
+	for _, allowed := range cliFormats {
+		if format == allowed {
+			return format, nil
+		}
+	}
+	return "", fmt.Errorf("unknown format %q (allowed: %s)", value, strings.Join(cliFormats, ", "))
+}
+
+// outputTarget is one --output format=path destination; a path of "-"
+// means stdout
+type outputTarget struct {
+	Format string
+	Path   string
+}
+
+// outputTargets collects the repeatable --output flag
+type outputTargets []outputTarget
+
+// String implements flag.Value
+func (t *outputTargets) String() string {
+	parts := make([]string, 0, len(*t))
+	for _, target := range *t {
+		parts = append(parts, target.Format+"="+target.Path)
+	}
+	return strings.Join(parts, ",")
+}
+
+// Set implements flag.Value, validating the format and path
+func (t *outputTargets) Set(value string) error {
+	format, path, ok := strings.Cut(value, "=")
+	if !ok || strings.TrimSpace(path) == "" {
+		return fmt.Errorf("invalid output %q (expected format=path, e.g. json=meta.json or summary=-)", value)
+	}
+	format, err := parseFormatFlag(format)
+	if err != nil {
+		return err
+	}
+	*t = append(*t, outputTarget{Format: format, Path: strings.TrimSpace(path)})
+	return nil
+}
+
+// writeOutputs renders each target's format and writes it to its path,
+// or to stdout for "-". Every target is attempted; the write errors are
+// returned together.
+func writeOutputs(targets outputTargets, render func(format string) (string, error), stdout io.Writer) error {
+	var errs []error
+	for _, target := range targets {
+		content, err := render(target.Format)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to generate %s output: %w", target.Format, err))
+			continue
+		}
+		if !strings.HasSuffix(content, "\n") {
+			content += "\n"
+		}
+
+		if target.Path == "-" {
+			_, err = io.WriteString(stdout, content)
+		} else {
+			err = os.WriteFile(target.Path, []byte(content), 0644)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to write %s output to %s: %w", target.Format, target.Path, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sarifOutputPath returns where the sarif output format writes its file:
+// the runner's temporary directory, which later steps in the job can
+// read, then the workspace, then the system temporary directory
+func sarifOutputPath() string {
+	dir := os.TempDir()
+	for _, name := range []string{"RUNNER_TEMP", "GITHUB_WORKSPACE"} {
+		if value := os.Getenv(name); value != "" {
+			dir = value
+			break
+		}
+	}
+	return filepath.Join(dir, "build-metadata.sarif")
+}
+
 // parseMultiSeparatorInput normalizes input that can be comma, space, or newline separated
 // into a slice of trimmed strings. Empty strings are filtered out.
 func parseMultiSeparatorInput(input string) []string {
@@ -73,23 +180,103 @@ type Metadata struct {
 	// Language-specific metadata
 	LanguageSpecific map[string]interface{} `json:"language_specific,omitempty"`
 
+	// Sub-projects found below the project root (monorepos)
+	Subprojects []extractor.DetectedProject `json:"subprojects,omitempty"`
+
 	// Build metadata
 	Build BuildMetadata `json:"build"`
+
+	// The build-metadata-action build that generated the output
+	Generator GeneratorMetadata `json:"generator"`
+}
+
+// GeneratorMetadata identifies the build-metadata-action build that
+// produced the metadata, for tracing wrong output back to a release
+type GeneratorMetadata struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Commit  string `json:"commit,omitempty"`
+}
+
+// generatorMetadata returns the generator block. Without an injected
+// commit it falls back to the VCS revision Go embeds in the binary.
+func generatorMetadata() GeneratorMetadata {
+	generator := GeneratorMetadata{
+		Name:    actionName,
+		Version: actionVersion,
+		Commit:  actionCommit,
+	}
+	if generator.Commit == "" {
+		if info, ok := debug.ReadBuildInfo(); ok {
+			for _, setting := range info.Settings {
+				if setting.Key == "vcs.revision" {
+					generator.Commit = setting.Value
+				}
+			}
+		}
+	}
+	return generator
 }
 
 // CommonMetadata contains metadata common to all project types
 type CommonMetadata struct {
 	ProjectType      string    `json:"project_type"`
+	ExtractorName    string    `json:"extractor_name,omitempty"`   // Registered extractor that produced the metadata, e.g. "cpp"
+	PrimaryLanguage  string    `json:"primary_language,omitempty"` // Coarse language label, e.g. "Python"
 	ProjectName      string    `json:"project_name"`
 	ProjectVersion   string    `json:"project_version"`
+	Description      string    `json:"description,omitempty"`
 	ProjectPath      string    `json:"project_path"`
+	ProjectPathRel   string    `json:"project_path_relative,omitempty"` // Relative to the git toplevel, "." at the root
 	VersionSource    string    `json:"version_source"`
 	VersioningType   string    `json:"versioning_type"`
+	VersionChannel   string    `json:"version_channel"` // stable, prerelease, dev, snapshot or unknown
+	IsPrerelease     bool      `json:"is_prerelease"`
 	BuildTimestamp   time.Time `json:"build_timestamp"`
+	BuildOS          string    `json:"build_os"`   // Runner OS from CI, or runtime.GOOS as a runner name
+	BuildArch        string    `json:"build_arch"` // Runner architecture from CI, or runtime.GOARCH as a runner name
 	GitSHA           string    `json:"git_sha,omitempty"`
 	GitBranch        string    `json:"git_branch,omitempty"`
+	IsDefaultBranch  *bool     `json:"is_default_branch,omitempty"` // nil when the default branch is unknown
 	GitTag           string    `json:"git_tag,omitempty"`
-	ProjectMatchRepo bool      `json:"project_match_repo,omitempty"`
+	GitCommitAuthor  string    `json:"git_commit_author,omitempty"`
+	GitCommitEmail   string    `json:"git_commit_email,omitempty"`
+	GitCommitDate    string    `json:"git_commit_date,omitempty"`
+	License          string    `json:"license,omitempty"`        // As written in the manifest, or identified from the license file
+	LicenseSPDX      string    `json:"license_spdx,omitempty"`   // Normalized SPDX expression
+	LicenseSource    string    `json:"license_source,omitempty"` // "manifest" or "file"
+	RepositoryName   string    `json:"repository_name,omitempty"`
+	ProjectMatchRepo *bool     `json:"project_match_repo,omitempty"` // nil when the repository is unknown
+
+	// Provider-independent repository identity from the git remote or
+	// .gitreview, e.g. "group/subgroup/repo" hosted on "gitlab"
+	RepositorySlug     string `json:"repository_slug,omitempty"`
+	RepositoryProvider string `json:"repository_provider,omitempty"`
+
+	// Automated dependency updates (renovate, dependabot, or none)
+	DependencyAutomation string   `json:"dependency_automation,omitempty"`
+	DependencyEcosystems []string `json:"dependency_ecosystems,omitempty"`
+
+	// Configured code quality tools (editorconfig, eslint, ruff, ...);
+	// nil unless detect_tooling is enabled
+	Tooling map[string]bool `json:"tooling,omitempty"`
+
+	// Dev Container configuration; nil when the repository has none
+	Devcontainer *detector.Devcontainer `json:"devcontainer,omitempty"`
+
+	// Security posture derived from the signals collected above
+	SecurityPosture *posture.Posture `json:"security_posture,omitempty"`
+
+	// Error reported by the language extractor, including recovered panics
+	ExtractionError string `json:"extraction_error,omitempty"`
+
+	// Notes from the language extractor about possibly incomplete data
+	ExtractionWarnings []string `json:"extraction_warnings,omitempty"`
+
+	// Changes since the latest tag; nil unless changes_since_tag is enabled
+	// and the project is in a tagged git repository
+	FilesChangedSinceTag    *int  `json:"files_changed_since_tag,omitempty"`
+	ManifestChangedSinceTag *bool `json:"manifest_changed_since_tag,omitempty"`
 }
 
 // BuildMetadata contains build-specific metadata
@@ -102,11 +289,33 @@ type BuildMetadata struct {
 }
 
 func main() {
-	action := githubactions.New()
+	formatFlag := flag.String("format", "", "output format: "+strings.Join(cliFormats, ", ")+" (overrides the output_format input)")
+	var outputFlags outputTargets
+	flag.Var(&outputFlags, "output", "format=path to write, repeatable, e.g. json=meta.json or summary=- for stdout (overrides --format and the output_format input)")
+	disableFlag := flag.String("disable", "", "comma-separated extractors to disable, e.g. docker,python (overrides the disable_extractors input)")
+	overrideNameFlag := flag.String("override-name", "", "project name replacing the extracted one (overrides the override_name input)")
+	overrideVersionFlag := flag.String("override-version", "", "project version replacing the extracted one (overrides the override_version input)")
+	matrixOnlyFlag := flag.Bool("matrix-only", false, "print only the normalized version matrix JSON to stdout, e.g. for piping to jq")
+	strictFlag := flag.Bool("strict", false, "fail when a detected manifest cannot be parsed instead of falling back (overrides the strict input)")
+	flag.Parse()
+
+	// With --matrix-only stdout carries the matrix and nothing else, so
+	// logs and workflow commands are sent to stderr instead
+	stdout := os.Stdout
+	if *matrixOnlyFlag {
+		os.Stdout = os.Stderr
+	}
 
 	// Detect if running in CI environment
 	isCI := os.Getenv("GITHUB_ACTIONS") == "true" || os.Getenv("CI") == "true"
 
+	// Outside CI, progress and warnings go to stderr so that stdout
+	// carries only the requested output formats
+	action := githubactions.New()
+	if !isCI {
+		action = githubactions.New(githubactions.WithWriter(os.Stderr))
+	}
+
 	// Get inputs early so we can use verboseOutput for debugging
 	verboseOutput := action.GetInput("verbose") == "true"
 
@@ -132,6 +341,17 @@ func main() {
 	// If explicitly set to empty string, no output will be generated
 	// If not provided, action.yaml default "summary" is used
 	outputFormats := parseMultiSeparatorInput(outputFormatInput)
+	if *formatFlag != "" {
+		format, ferr := parseFormatFlag(*formatFlag)
+		if ferr != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", ferr)
+			os.Exit(2)
+		}
+		outputFormats = []string{format}
+	}
+	if len(outputFlags) > 0 {
+		outputFormats = nil
+	}
 
 	includeEnvironment := action.GetInput("include_environment") != "false"
 	useVersionExtract := action.GetInput("use_version_extract") != "false"
@@ -151,6 +371,67 @@ func main() {
 	artifactFormats := parseMultiSeparatorInput(artifactFormatsInput)
 	validateOutput := action.GetInput("validate_output") != "false"
 	exportEnvVars := action.GetInput("export_env_vars") == "true"
+	failOnNameMismatch := action.GetInput("fail_on_name_mismatch") == "true"
+	changesSinceTag := action.GetInput("changes_since_tag") == "true"
+	commitDetails := action.GetInput("commit_details") == "true"
+	detectTooling := action.GetInput("detect_tooling") == "true"
+
+	// Build timestamp location and summary rendering. The defaults keep
+	// the historical behaviour: a UTC timestamp rendered in the human
+	// "2006-01-02 15:04:05 UTC" form.
+	summaryOptions := output.DefaultSummaryOptions()
+	if raw := action.GetInput("timestamp_format"); raw != "" {
+		format, ferr := output.ParseTimestampFormat(raw)
+		if ferr != nil {
+			action.Warningf("Invalid timestamp_format, using default: %v", ferr)
+		}
+		summaryOptions.TimestampFormat = format
+	}
+	if raw := action.GetInput("summary_mode"); raw != "" {
+		mode, merr := output.ParseSummaryMode(raw)
+		if merr != nil {
+			action.Warningf("Invalid summary_mode, using default: %v", merr)
+		}
+		summaryOptions.Mode = mode
+	}
+	summaryOptions.ShowCompleteness = action.GetInput("summary_completeness") == "true"
+	summaryOptions.ShowBuildCommands = action.GetInput("summary_build_commands") == "true"
+	var summaryTemplate string
+	if raw := action.GetInput("summary_template"); raw != "" {
+		if content, terr := os.ReadFile(raw); terr == nil {
+			summaryTemplate = string(content)
+		} else {
+			action.Warningf("Failed to read summary_template, using default: %v", terr)
+		}
+	}
+	buildLocation := time.UTC
+	if raw := action.GetInput("build_timezone"); raw != "" {
+		if loc, lerr := time.LoadLocation(raw); lerr == nil {
+			buildLocation = loc
+		} else {
+			action.Warningf("Invalid build_timezone %q, using UTC: %v", raw, lerr)
+		}
+	}
+	subprojectDepth := extractor.DefaultSubprojectDepth
+	if raw := action.GetInput("subproject_depth"); raw != "" {
+		if parsed, perr := strconv.Atoi(raw); perr == nil && parsed >= 0 {
+			subprojectDepth = parsed
+		} else {
+			action.Warningf("Invalid subproject_depth %q, using %d", raw, subprojectDepth)
+		}
+	}
+	projectSearchDepth := detector.DefaultProjectSearchDepth
+	if raw := action.GetInput("project_search_depth"); raw != "" {
+		if parsed, perr := strconv.Atoi(raw); perr == nil && parsed >= 0 {
+			projectSearchDepth = parsed
+		} else {
+			action.Warningf("Invalid project_search_depth %q, using %d", raw, projectSearchDepth)
+		}
+	}
+	fieldAliases, aerr := output.ParseFieldAliases(action.GetInput("field_aliases"))
+	if aerr != nil {
+		action.Warningf("Invalid field_aliases, ignoring: %v", aerr)
+	}
 
 	// Parse the Python extractor inputs up front (cheap string/int
 	// handling, no network). Actual policy resolution -- which may
@@ -168,6 +449,48 @@ func main() {
 		defaultPythonEOLTimeoutSeconds = 5 // matches action.yaml
 		defaultPythonEOLMaxRetries     = 2 // matches action.yaml
 	)
+	// Optional runner OS cross matrix (matrix_os_json). "true" selects
+	// the default OS list; any other non-empty value names the runners.
+	if includeOS := action.GetInput("include_os"); includeOS != "" && includeOS != "false" {
+		osList := []string{}
+		if includeOS != "true" {
+			osList = parseMultiSeparatorInput(includeOS)
+		}
+		extractor.SetMatrixOS(osList)
+	}
+
+	// Opt-in: transitive dependency counts from lock files
+	extractor.SetLockfileDependencies(action.GetInput("lockfile_dependencies") == "true")
+	strict := *strictFlag || action.GetInput("strict") == "true"
+	extractor.SetStrictMode(strict)
+
+	// Values CI knows better than a placeholder or stale manifest
+	overrideName := strings.TrimSpace(action.GetInput("override_name"))
+	if *overrideNameFlag != "" {
+		overrideName = strings.TrimSpace(*overrideNameFlag)
+	}
+	overrideVersion := strings.TrimSpace(action.GetInput("override_version"))
+	if *overrideVersionFlag != "" {
+		overrideVersion = strings.TrimSpace(*overrideVersionFlag)
+	}
+
+	// Extractors excluded from dispatch, by extractor name or project type
+	disabledExtractors := parseMultiSeparatorInput(action.GetInput("disable_extractors"))
+	if *disableFlag != "" {
+		disabledExtractors = parseMultiSeparatorInput(*disableFlag)
+	}
+	for _, name := range disabledExtractors {
+		if _, err := extractor.GetExtractor(name); err != nil {
+			if isCI {
+				action.Warningf("Cannot disable unknown extractor: %s", name)
+			} else {
+				fmt.Fprintf(os.Stderr, "Warning: Cannot disable unknown extractor: %s\n", name)
+			}
+			continue
+		}
+		extractor.DisableExtractor(name)
+	}
+
 	pythonOffline := action.GetInput("python_offline_mode") == "true"
 	pythonTimeout := time.Duration(defaultPythonEOLTimeoutSeconds) * time.Second
 	if raw := action.GetInput("python_eol_timeout"); raw != "" {
@@ -186,14 +509,16 @@ func main() {
 	metadata := &Metadata{
 		Common: CommonMetadata{
 			ProjectPath:    absPath,
-			BuildTimestamp: time.Now().UTC(),
+			BuildTimestamp: time.Now().In(buildLocation),
 		},
 		Build: BuildMetadata{
 			CIPlatform: os.Getenv("CI_PLATFORM"),
 			RunnerOS:   os.Getenv("RUNNER_OS"),
 			RunnerArch: os.Getenv("RUNNER_ARCH"),
 		},
+		Generator: generatorMetadata(),
 	}
+	metadata.Common.BuildOS, metadata.Common.BuildArch = buildPlatform(metadata.Build)
 
 	// Set CI platform specific values
 	if os.Getenv("GITHUB_ACTIONS") == "true" {
@@ -217,24 +542,103 @@ func main() {
 	if isCI {
 		action.Infof("Detecting project type in: %s", absPath)
 	} else {
-		fmt.Printf("Detecting project type in: %s\n", absPath)
+		fmt.Fprintf(os.Stderr, "Detecting project type in: %s\n", absPath)
 	}
+	// Dependency automation is configured at the repository root, even
+	// when the project itself is found in a subdirectory below
+	repoRoot := absPath
 	projectType, err := detector.DetectProjectType(absPath)
+	if err != nil && projectSearchDepth > 0 {
+		if nestedPath, nestedType, nerr := detector.DetectNestedProjectType(absPath, projectSearchDepth); nerr == nil {
+			if isCI {
+				action.Infof("No project at %s, using nested project in %s", absPath, nestedPath)
+			} else {
+				fmt.Fprintf(os.Stderr, "No project at %s, using nested project in %s\n", absPath, nestedPath)
+			}
+			absPath = nestedPath
+			metadata.Common.ProjectPath = absPath
+			projectType, err = nestedType, nil
+		}
+	}
 	if err != nil {
 		if isCI {
 			action.Warningf("Failed to detect project type: %v", err)
 		} else {
-			fmt.Printf("Warning: Failed to detect project type: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Warning: Failed to detect project type: %v\n", err)
 		}
 		projectType = "unknown"
 	}
+	// Projects migrating between Maven and Gradle carry both build files
+	if perr := java.SetPreferredBuildTool(action.GetInput("preferred_build_tool")); perr != nil {
+		if isCI {
+			action.Warningf("Invalid preferred_build_tool, ignoring: %v", perr)
+		} else {
+			fmt.Fprintf(os.Stderr, "Warning: Invalid preferred_build_tool, ignoring: %v\n", perr)
+		}
+	}
+	if resolved := java.ResolveProjectType(absPath, projectType); resolved != projectType {
+		if isCI {
+			action.Infof("Both Maven and Gradle build files found, using %s", resolved)
+		} else {
+			fmt.Fprintf(os.Stderr, "Both Maven and Gradle build files found, using %s\n", resolved)
+		}
+		projectType = resolved
+	}
+
+	// Fall back to the next detected project type when the extractor
+	// for the preferred one has been disabled
+	if projectType != "unknown" && extractor.IsExtractorDisabled(projectType) {
+		if candidates, derr := detector.DetectAllProjectTypes(absPath); derr == nil {
+			for _, candidate := range candidates {
+				if !extractor.IsExtractorDisabled(candidate) {
+					if isCI {
+						action.Infof("Extractor for %s is disabled, using %s", projectType, candidate)
+					} else {
+						fmt.Fprintf(os.Stderr, "Extractor for %s is disabled, using %s\n", projectType, candidate)
+					}
+					projectType = candidate
+					break
+				}
+			}
+		}
+	}
 	metadata.Common.ProjectType = projectType
+	metadata.Common.PrimaryLanguage = output.PrimaryLanguage(projectType)
 	if isCI {
 		action.Infof("Detected project type: %s", projectType)
 	} else {
-		fmt.Printf("Detected project type: %s\n", projectType)
+		fmt.Fprintf(os.Stderr, "Detected project type: %s\n", projectType)
 	}
 
+	// Detect automated dependency update configuration (renovate/dependabot)
+	if automation, err := detector.DetectDependencyAutomation(repoRoot); err != nil {
+		if isCI {
+			action.Warningf("Failed to parse dependency automation config: %v", err)
+		} else {
+			fmt.Fprintf(os.Stderr, "Warning: Failed to parse dependency automation config: %v\n", err)
+		}
+		metadata.Common.DependencyAutomation = automation.Tool
+	} else {
+		metadata.Common.DependencyAutomation = automation.Tool
+		metadata.Common.DependencyEcosystems = automation.Ecosystems
+	}
+
+	// Opt-in: quality tooling facets for repository health dashboards
+	if detectTooling {
+		metadata.Common.Tooling = detector.DetectTooling(repoRoot, absPath)
+	}
+
+	// Dev Container adoption, with the image and features when parseable
+	devcontainer, err := detector.DetectDevcontainer(repoRoot)
+	if err != nil {
+		if isCI {
+			action.Warningf("Failed to parse devcontainer config: %v", err)
+		} else {
+			fmt.Fprintf(os.Stderr, "Warning: Failed to parse devcontainer config: %v\n", err)
+		}
+	}
+	metadata.Common.Devcontainer = devcontainer
+
 	// Configure the Python extractor policy from action inputs. The
 	// policy is package-scoped in `internal/extractor/python` because
 	// the Extractor.Extract interface has a fixed signature; setting
@@ -255,14 +659,14 @@ func main() {
 		if isCI {
 			action.Infof("Extracting version information...")
 		} else {
-			fmt.Println("Extracting version information...")
+			fmt.Fprintln(os.Stderr, "Extracting version information...")
 		}
 		versionInfo, err := version.ExtractVersion(absPath, projectType)
 		if err != nil {
 			if isCI {
 				action.Warningf("Failed to extract version: %v", err)
 			} else {
-				fmt.Printf("Warning: Failed to extract version: %v\n", err)
+				fmt.Fprintf(os.Stderr, "Warning: Failed to extract version: %v\n", err)
 			}
 		} else {
 			metadata.Common.ProjectVersion = versionInfo.Version
@@ -281,36 +685,118 @@ func main() {
 		if isCI {
 			action.Warningf("No specific extractor for project type %s: %v", projectType, err)
 		} else {
-			fmt.Printf("Warning: No specific extractor for project type %s: %v\n", projectType, err)
+			fmt.Fprintf(os.Stderr, "Warning: No specific extractor for project type %s: %v\n", projectType, err)
 		}
 	} else {
+		metadata.Common.ExtractorName = extractorImpl.Name()
 		if isCI {
 			action.Infof("Extracting %s project metadata...", projectType)
 		} else {
-			fmt.Printf("Extracting %s project metadata...\n", projectType)
+			fmt.Fprintf(os.Stderr, "Extracting %s project metadata...\n", projectType)
 		}
 
-		// Extract project-specific metadata
-		projectMetadata, err := extractorImpl.Extract(absPath)
+		// Extract project-specific metadata, bounded so a pathological
+		// repository cannot hang the action
+		extractCtx, cancelExtract := context.WithTimeout(context.Background(), extractor.DefaultExtractTimeout)
+		defer cancelExtract()
+		projectMetadata, err := extractor.ExtractContext(extractCtx, extractorImpl, absPath)
+		// Strict mode fails on any extraction error. Extractors report an
+		// unparseable manifest as a ManifestParseError rather than
+		// falling back, so those errors surface here too.
+		if strict && err != nil {
+			if isCI {
+				action.Fatalf("Strict mode: %v", err)
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: strict mode: %v\n", err)
+				os.Exit(1)
+			}
+		}
 		if err != nil {
+			metadata.Common.ExtractionError = err.Error()
 			if isCI {
 				action.Warningf("Failed to extract project metadata: %v", err)
 			} else {
-				fmt.Printf("Warning: Failed to extract project metadata: %v\n", err)
+				fmt.Fprintf(os.Stderr, "Warning: Failed to extract project metadata: %v\n", err)
 			}
 		} else {
+			// Without a manifest version, fall back to a VERSION or
+			// version.txt file, then the latest git tag, then the changelog
+			if extractor.ApplyVersionFile(absPath, projectMetadata) && verboseOutput {
+				if isCI {
+					action.Infof("Using version %s from version file", projectMetadata.Version)
+				} else {
+					fmt.Fprintf(os.Stderr, "Using version %s from version file\n", projectMetadata.Version)
+				}
+			}
+
+			// Fall back to the latest git tag when the manifest has no version
+			if extractor.ApplyGitTagVersionContext(extractCtx, absPath, projectMetadata) && verboseOutput {
+				if isCI {
+					action.Infof("Using version %s from git tag", projectMetadata.Version)
+				} else {
+					fmt.Fprintf(os.Stderr, "Using version %s from git tag\n", projectMetadata.Version)
+				}
+			}
+
+			// Lowest priority: the topmost released version in the changelog
+			if extractor.ApplyChangelogVersion(absPath, projectMetadata) && verboseOutput {
+				if isCI {
+					action.Infof("Using version %s from %s", projectMetadata.Version, projectMetadata.VersionSource)
+				} else {
+					fmt.Fprintf(os.Stderr, "Using version %s from %s\n", projectMetadata.Version, projectMetadata.VersionSource)
+				}
+			}
+
+			// Identify the license from LICENSE/COPYING when the manifest
+			// does not declare one
+			if extractor.ApplyLicenseFile(absPath, projectMetadata) && verboseOutput {
+				if isCI {
+					action.Infof("Using license %s from license file", projectMetadata.License)
+				} else {
+					fmt.Fprintf(os.Stderr, "Using license %s from license file\n", projectMetadata.License)
+				}
+			}
+
 			// Update common metadata
 			if projectMetadata.Name != "" {
 				metadata.Common.ProjectName = projectMetadata.Name
 			}
+			metadata.Common.Description = projectMetadata.Description
 			if projectMetadata.Version != "" && metadata.Common.ProjectVersion == "" {
 				metadata.Common.ProjectVersion = projectMetadata.Version
 				metadata.Common.VersionSource = projectMetadata.VersionSource
 			}
+			if projectMetadata.License != "" {
+				metadata.Common.License = projectMetadata.License
+				metadata.Common.LicenseSPDX = extractor.NormalizeLicense(projectMetadata.License)
+				metadata.Common.LicenseSource = "manifest"
+				if projectMetadata.LicenseSource != "" {
+					metadata.Common.LicenseSource = projectMetadata.LicenseSource
+				}
+			}
 
 			// Store language-specific metadata
 			metadata.LanguageSpecific = projectMetadata.LanguageSpecific
 
+			// A malformed matrix would break fromJSON() in the calling
+			// workflow, so report it as a warning instead of emitting it
+			if matrixJSON, ok := projectMetadata.LanguageSpecific["matrix_json"].(string); ok {
+				if verr := output.ValidateMatrixJSON(matrixJSON); verr != nil {
+					delete(projectMetadata.LanguageSpecific, "matrix_json")
+					projectMetadata.Warnings = append(projectMetadata.Warnings, fmt.Sprintf("Dropped invalid matrix_json: %v", verr))
+				}
+			}
+
+			// Surface notes about partially extracted data
+			metadata.Common.ExtractionWarnings = projectMetadata.Warnings
+			for _, warning := range projectMetadata.Warnings {
+				if isCI {
+					action.Warningf("%s", warning)
+				} else {
+					fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+				}
+			}
+
 			// Extract versioning_type from language-specific metadata
 			if versioningType, ok := projectMetadata.LanguageSpecific["versioning_type"].(string); ok {
 				metadata.Common.VersioningType = versioningType
@@ -326,20 +812,79 @@ func main() {
 		if isCI {
 			action.Infof("Collecting environment metadata...")
 		} else {
-			fmt.Println("Collecting environment metadata...")
+			fmt.Fprintln(os.Stderr, "Collecting environment metadata...")
 		}
 		envMetadata, err := environment.Collect()
 		if err != nil {
 			if isCI {
 				action.Warningf("Failed to collect environment metadata: %v", err)
 			} else {
-				fmt.Printf("Warning: Failed to collect environment metadata: %v\n", err)
+				fmt.Fprintf(os.Stderr, "Warning: Failed to collect environment metadata: %v\n", err)
 			}
 		} else {
 			metadata.Environment = *envMetadata
+			metadata.Environment.ToolVersionMismatch = output.ToolVersionMismatches(metadata)
+		}
+	}
+
+	// Discover monorepo sub-projects below the project root
+	metadata.Subprojects = extractor.DetectAllWithDepth(absPath, subprojectDepth)
+
+	applyOverrides(metadata, overrideName, overrideVersion)
+
+	// Release channel of the final version, for release gating
+	metadata.Common.VersionChannel = extractor.VersionChannel(metadata.Common.ProjectVersion)
+	metadata.Common.IsPrerelease = extractor.IsPrerelease(metadata.Common.VersionChannel)
+
+	// Provenance: who made HEAD and when, when the project is in a git
+	// repository. Opt-in, as it publishes the author's email address.
+	commitCtx, cancelCommit := context.WithTimeout(context.Background(), extractor.DefaultExtractTimeout)
+	if commitDetails {
+		if commit := extractor.HeadCommit(commitCtx, absPath); commit != nil {
+			metadata.Common.GitCommitAuthor = commit.Author
+			metadata.Common.GitCommitEmail = commit.Email
+			metadata.Common.GitCommitDate = commit.Date
+		}
+	}
+	metadata.Common.ProjectPathRel = extractor.RelativeProjectPath(commitCtx, absPath)
+	if repo, err := repository.DetectRepository(absPath); err == nil {
+		metadata.Common.RepositorySlug = repo.Slug()
+		metadata.Common.RepositoryProvider = repo.Provider()
+	}
+
+	// Whether this build is on the default branch. Shallow CI checkouts
+	// lack origin/HEAD, so fall back to the event payload; pull requests
+	// are compared by their head branch.
+	defaultBranch := extractor.DefaultBranch(commitCtx, absPath)
+	if defaultBranch == "" {
+		defaultBranch = eventDefaultBranch()
+	}
+	branch := metadata.Common.GitBranch
+	if branch == "" {
+		branch = os.Getenv("GITHUB_HEAD_REF")
+	}
+	if branch == "" {
+		branch = extractor.CurrentBranch(commitCtx, absPath)
+	}
+	metadata.Common.IsDefaultBranch = extractor.IsDefaultBranch(branch, defaultBranch)
+	cancelCommit()
+
+	// Opt-in: diffing against the latest tag needs git history and can be
+	// slow on large repositories
+	if changesSinceTag {
+		manifestPath, _ := metadata.LanguageSpecific["manifest_path"].(string)
+		changesCtx, cancelChanges := context.WithTimeout(context.Background(), extractor.DefaultExtractTimeout)
+		if changes := extractor.ChangesSinceTag(changesCtx, absPath, manifestPath); changes != nil {
+			metadata.Common.FilesChangedSinceTag = &changes.FilesChanged
+			metadata.Common.ManifestChangedSinceTag = &changes.ManifestChanged
 		}
+		cancelChanges()
 	}
 
+	// Compose the security posture from signals gathered by the detectors
+	// and extractors above
+	metadata.Common.SecurityPosture = composeSecurityPosture(repoRoot, absPath, metadata)
+
 	// Set outputs for common fields
 	// When not in CI, print to stdout instead of trying to write to GitHub Actions files
 	setOutput := func(name, value string) {
@@ -353,23 +898,64 @@ func main() {
 				action.SetEnv(envName, value)
 			}
 		} else if verboseOutput {
-			// Local execution - print to stdout if verbose
+			// Local execution - print to stderr if verbose
 			if value != "" {
-				fmt.Printf("%s=%s\n", name, value)
+				fmt.Fprintf(os.Stderr, "%s=%s\n", name, value)
 			}
 		}
 	}
 
 	setOutput("project_type", metadata.Common.ProjectType)
+	setOutput("extractor_name", metadata.Common.ExtractorName)
+	setOutput("primary_language", metadata.Common.PrimaryLanguage)
 	setOutput("project_name", metadata.Common.ProjectName)
 	setOutput("project_version", metadata.Common.ProjectVersion)
 	setOutput("project_path", metadata.Common.ProjectPath)
+	setOutput("project_path_relative", metadata.Common.ProjectPathRel)
 	setOutput("version_source", metadata.Common.VersionSource)
 	setOutput("versioning_type", metadata.Common.VersioningType)
+	setOutput("version_channel", metadata.Common.VersionChannel)
+	setOutput("is_prerelease", strconv.FormatBool(metadata.Common.IsPrerelease))
 	setOutput("build_timestamp", metadata.Common.BuildTimestamp.Format(time.RFC3339))
+	setOutput("build_os", metadata.Common.BuildOS)
+	setOutput("build_arch", metadata.Common.BuildArch)
 	setOutput("git_sha", metadata.Common.GitSHA)
 	setOutput("git_branch", metadata.Common.GitBranch)
+	if metadata.Common.IsDefaultBranch != nil {
+		setOutput("is_default_branch", strconv.FormatBool(*metadata.Common.IsDefaultBranch))
+	}
 	setOutput("git_tag", metadata.Common.GitTag)
+	setOutput("repository_slug", metadata.Common.RepositorySlug)
+	setOutput("repository_provider", metadata.Common.RepositoryProvider)
+	setOutput("git_commit_author", metadata.Common.GitCommitAuthor)
+	setOutput("git_commit_email", metadata.Common.GitCommitEmail)
+	setOutput("git_commit_date", metadata.Common.GitCommitDate)
+	setOutput("license", metadata.Common.License)
+	setOutput("license_spdx", metadata.Common.LicenseSPDX)
+	setOutput("license_source", metadata.Common.LicenseSource)
+	setOutput("dependency_automation", metadata.Common.DependencyAutomation)
+	setOutput("dependency_ecosystems", strings.Join(metadata.Common.DependencyEcosystems, ","))
+	if metadata.Common.Tooling != nil {
+		toolingJSON, _ := json.Marshal(metadata.Common.Tooling)
+		setOutput("tooling", string(toolingJSON))
+	}
+	if metadata.Common.Devcontainer != nil {
+		devcontainerJSON, _ := json.Marshal(metadata.Common.Devcontainer)
+		setOutput("devcontainer", string(devcontainerJSON))
+	}
+	setOutput("security_posture_score", strconv.Itoa(metadata.Common.SecurityPosture.Score))
+	setOutput("security_posture_level", metadata.Common.SecurityPosture.Level)
+	setOutput("extraction_error", metadata.Common.ExtractionError)
+	if metadata.Common.FilesChangedSinceTag != nil {
+		setOutput("files_changed_since_tag", strconv.Itoa(*metadata.Common.FilesChangedSinceTag))
+		setOutput("manifest_changed_since_tag", strconv.FormatBool(*metadata.Common.ManifestChangedSinceTag))
+	}
+
+	setOutput("subproject_count", strconv.Itoa(len(metadata.Subprojects)))
+	if len(metadata.Subprojects) > 0 {
+		subprojectsJSON, _ := json.Marshal(metadata.Subprojects)
+		setOutput("subprojects", string(subprojectsJSON))
+	}
 
 	// Set outputs for build metadata
 	setOutput("ci_platform", metadata.Build.CIPlatform)
@@ -378,8 +964,17 @@ func main() {
 	setOutput("runner_os", metadata.Build.RunnerOS)
 	setOutput("runner_arch", metadata.Build.RunnerArch)
 
-	// Implement project_match_repo comparison (common to all project types)
-	if metadata.Common.ProjectName != "" {
+	// Implement project_match_repo comparison (common to all project types).
+	// Extractors that know how to derive a repository-comparable name
+	// (e.g. the package portion of PHP's "vendor/package") report their
+	// own comparison, which takes precedence.
+	if parts := strings.Split(os.Getenv("GITHUB_REPOSITORY"), "/"); len(parts) == 2 {
+		metadata.Common.RepositoryName = parts[1]
+	}
+	if extractorMatch, ok := metadata.LanguageSpecific["project_match_repo"].(bool); ok {
+		metadata.Common.ProjectMatchRepo = &extractorMatch
+		setOutput("project_match_repo", fmt.Sprintf("%t", extractorMatch))
+	} else if metadata.Common.ProjectName != "" {
 		repoFullName := os.Getenv("GITHUB_REPOSITORY")
 		if repoFullName != "" {
 			// Extract repo name from owner/repo format
@@ -387,7 +982,7 @@ func main() {
 			if len(parts) == 2 {
 				repoName := parts[1]
 				projectMatchRepo := metadata.Common.ProjectName == repoName
-				metadata.Common.ProjectMatchRepo = projectMatchRepo
+				metadata.Common.ProjectMatchRepo = &projectMatchRepo
 				setOutput("project_match_repo", fmt.Sprintf("%t", projectMatchRepo))
 				if verboseOutput {
 					if isCI {
@@ -398,9 +993,9 @@ func main() {
 						}
 					} else {
 						if projectMatchRepo {
-							fmt.Printf("Project name matches repository name: %s\n", repoName)
+							fmt.Fprintf(os.Stderr, "Project name matches repository name: %s\n", repoName)
 						} else {
-							fmt.Printf("Project name (%s) does not match repository name (%s)\n", metadata.Common.ProjectName, repoName)
+							fmt.Fprintf(os.Stderr, "Project name (%s) does not match repository name (%s)\n", metadata.Common.ProjectName, repoName)
 						}
 					}
 				}
@@ -432,13 +1027,32 @@ func main() {
 		}
 	}
 
+	// The version matrix under a stable "version" key, alongside the
+	// language-specific key of matrix_json or <language>_version_matrix
+	if matrix := extractor.NormalizeMetadataMatrix(metadata.LanguageSpecific); matrix != "" {
+		setOutput("matrix", matrix)
+	}
+
+	if *matrixOnlyFlag {
+		matrix, merr := output.GenerateMatrixJSON(metadata)
+		if merr != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", merr)
+			os.Exit(1)
+		}
+		fmt.Fprintln(stdout, matrix)
+		return
+	}
+
+	// Field aliases only apply to rendered JSON/YAML, not internally
+	renderedMetadata := output.ApplyFieldAliases(metadata, fieldAliases)
+
 	// Generate complete metadata JSON
-	metadataJSON, err := json.MarshalIndent(metadata, "", "  ")
+	metadataJSON, err := json.MarshalIndent(renderedMetadata, "", "  ")
 	if err != nil {
 		if isCI {
 			action.Warningf("Failed to marshal metadata to JSON: %v", err)
 		} else {
-			fmt.Printf("Warning: Failed to marshal metadata to JSON: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Warning: Failed to marshal metadata to JSON: %v\n", err)
 		}
 	} else {
 		setOutput("metadata_json", string(metadataJSON))
@@ -452,7 +1066,7 @@ func main() {
 		switch format {
 		case "summary":
 			// Generate GitHub Step Summary
-			summary := output.GenerateSummary(metadata)
+			summary := renderSummary(action, metadata, summaryTemplate, summaryOptions)
 			action.AddStepSummary(summary)
 
 			// Also output to console if verbose
@@ -471,15 +1085,32 @@ func main() {
 			action.SetOutput("markdown_output", markdown)
 
 		case "yaml":
-			// Generate YAML output
-			action.SetOutput("metadata_yaml", string(metadataJSON)) // TODO: Implement YAML conversion
-			if verboseOutput {
-				action.Infof("YAML output format requested (using JSON for now)")
+			// Output YAML to stdout
+			metadataYAML, err := metadataYAMLFromJSON(metadataJSON)
+			if err != nil {
+				action.Warningf("Failed to generate YAML output: %v", err)
+				continue
+			}
+			fmt.Println(metadataYAML)
+			action.SetOutput("metadata_yaml", metadataYAML)
+
+		case "sarif":
+			// Generate SARIF 2.1.0 for code scanning upload
+			sarif, err := output.GenerateSARIF(metadata)
+			if err != nil {
+				action.Warningf("Failed to generate SARIF output: %v", err)
+				continue
+			}
+			sarifPath := sarifOutputPath()
+			if err := os.WriteFile(sarifPath, []byte(sarif), 0644); err != nil {
+				action.Warningf("Failed to write SARIF output: %v", err)
+				continue
 			}
+			setOutput("sarif_file", sarifPath)
 
 		case "both":
 			// Generate both summary and JSON (legacy support)
-			summary := output.GenerateSummary(metadata)
+			summary := renderSummary(action, metadata, summaryTemplate, summaryOptions)
 			action.AddStepSummary(summary)
 			fmt.Println(string(metadataJSON))
 
@@ -492,6 +1123,33 @@ func main() {
 		}
 	}
 
+	// Explicit --output destinations, each rendered independently
+	if len(outputFlags) > 0 {
+		err := writeOutputs(outputFlags, func(format string) (string, error) {
+			switch format {
+			case "summary":
+				return renderSummary(action, metadata, summaryTemplate, summaryOptions), nil
+			case "markdown":
+				return output.GenerateMarkdown(metadata), nil
+			case "json":
+				return string(metadataJSON), nil
+			case "yaml":
+				return metadataYAMLFromJSON(metadataJSON)
+			case "sarif":
+				return output.GenerateSARIF(metadata)
+			}
+			return "", fmt.Errorf("unknown format %q", format)
+		}, os.Stdout)
+		if err != nil {
+			if isCI {
+				action.Fatalf("%v", err)
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		}
+	}
+
 	// Upload artifacts if enabled
 	if artifactUpload {
 		action.Infof("Uploading build metadata artifacts...")
@@ -514,7 +1172,7 @@ func main() {
 		}
 
 		// Upload artifacts
-		artifactResult, err := uploader.Upload(metadata, jobName)
+		artifactResult, err := uploader.Upload(renderedMetadata, jobName)
 		if err != nil {
 			action.Warningf("Failed to upload artifacts: %v", err)
 		} else {
@@ -538,26 +1196,39 @@ func main() {
 		action.Infof("✅ Build metadata extraction completed successfully")
 	} else {
 		// Print summary for local execution
-		fmt.Println("\n" + strings.Repeat("=", 60))
-		fmt.Println("✅ Build Metadata Extraction Complete")
-		fmt.Println(strings.Repeat("=", 60))
-		fmt.Printf("Project Type:    %s\n", metadata.Common.ProjectType)
+		fmt.Fprintln(os.Stderr, "\n"+strings.Repeat("=", 60))
+		fmt.Fprintln(os.Stderr, "✅ Build Metadata Extraction Complete")
+		fmt.Fprintln(os.Stderr, strings.Repeat("=", 60))
+		fmt.Fprintf(os.Stderr, "Project Type:    %s\n", metadata.Common.ProjectType)
 		if metadata.Common.ProjectName != "" {
-			fmt.Printf("Project Name:    %s\n", metadata.Common.ProjectName)
+			fmt.Fprintf(os.Stderr, "Project Name:    %s\n", metadata.Common.ProjectName)
 		}
 		if metadata.Common.ProjectVersion != "" {
-			fmt.Printf("Project Version: %s\n", metadata.Common.ProjectVersion)
+			fmt.Fprintf(os.Stderr, "Project Version: %s\n", metadata.Common.ProjectVersion)
 			if metadata.Common.VersionSource != "" {
-				fmt.Printf("Version Source:  %s\n", metadata.Common.VersionSource)
+				fmt.Fprintf(os.Stderr, "Version Source:  %s\n", metadata.Common.VersionSource)
 			}
 		}
-		fmt.Printf("Project Path:    %s\n", metadata.Common.ProjectPath)
-		fmt.Println(strings.Repeat("=", 60))
+		fmt.Fprintf(os.Stderr, "Project Path:    %s\n", metadata.Common.ProjectPath)
+		fmt.Fprintln(os.Stderr, strings.Repeat("=", 60))
 
 		// Offer to show full JSON
 		if !verboseOutput {
-			fmt.Println("\nTip: Use INPUT_VERBOSE=true for detailed output")
-			fmt.Println("     or pipe output with: ... 2>/dev/null | jq")
+			fmt.Fprintln(os.Stderr, "\nTip: Use INPUT_VERBOSE=true for detailed output")
+			fmt.Fprintln(os.Stderr, "     or pipe output with: ... 2>/dev/null | jq")
+		}
+	}
+
+	// Opt-in hard failure when the project name drifts from the repository
+	if failOnNameMismatch {
+		if err := output.CheckNameMatch(metadata); err != nil {
+			setOutput("success", "false")
+			if isCI {
+				action.Fatalf("%v", err)
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
 		}
 	}
 
@@ -565,6 +1236,107 @@ func main() {
 	setOutput("success", "true")
 }
 
+// metadataYAMLFromJSON renders the metadata JSON document as YAML so that
+// both formats use the same keys
+func metadataYAMLFromJSON(metadataJSON []byte) (string, error) {
+	var yamlSource interface{}
+	if err := json.Unmarshal(metadataJSON, &yamlSource); err != nil {
+		return "", err
+	}
+	return output.GetMetadataYAML(yamlSource, false)
+}
+
+// eventDefaultBranch returns the repository default branch from the
+// GitHub Actions event payload, or an empty string outside Actions
+func eventDefaultBranch() string {
+	eventPath := os.Getenv("GITHUB_EVENT_PATH")
+	if eventPath == "" {
+		return ""
+	}
+	content, err := os.ReadFile(eventPath)
+	if err != nil {
+		return ""
+	}
+
+	var event struct {
+		Repository struct {
+			DefaultBranch string `json:"default_branch"`
+		} `json:"repository"`
+	}
+	if err := json.Unmarshal(content, &event); err != nil {
+		return ""
+	}
+	return event.Repository.DefaultBranch
+}
+
+// renderSummary renders the step summary with the user's template, falling
+// back to the default layout when there is none or it fails
+func renderSummary(action *githubactions.Action, metadata *Metadata, tmpl string, opts output.SummaryOptions) string {
+	if tmpl != "" {
+		summary, err := output.GenerateFromTemplateWithOptions(metadata, tmpl, opts)
+		if err == nil {
+			return summary
+		}
+		action.Warningf("Invalid summary_template, using default: %v", err)
+	}
+	return output.GenerateSummaryWithOptions(metadata, opts)
+}
+
+// buildPlatform returns the OS and architecture of the build runner,
+// preferring the CI-provided RUNNER_OS and RUNNER_ARCH and falling back to
+// the Go runtime so local runs report them too. Runtime values are mapped
+// to the runner vocabulary, e.g. "linux"/"amd64" to "Linux"/"X64", so
+// both sources read the same.
+func buildPlatform(build BuildMetadata) (buildOS, buildArch string) {
+	buildOS, buildArch = build.RunnerOS, build.RunnerArch
+	if buildOS == "" {
+		buildOS = runnerName(runnerOSNames, runtime.GOOS)
+	}
+	if buildArch == "" {
+		buildArch = runnerName(runnerArchNames, runtime.GOARCH)
+	}
+	return buildOS, buildArch
+}
+
+// runnerOSNames maps GOOS values to their RUNNER_OS names
+var runnerOSNames = map[string]string{
+	"linux":   "Linux",
+	"darwin":  "macOS",
+	"windows": "Windows",
+}
+
+// runnerArchNames maps GOARCH values to their RUNNER_ARCH names
+var runnerArchNames = map[string]string{
+	"amd64": "X64",
+	"386":   "X86",
+	"arm64": "ARM64",
+	"arm":   "ARM",
+}
+
+// runnerName returns the runner name for a Go runtime value, or the value
+// itself when runners have no name for it
+func runnerName(names map[string]string, value string) string {
+	if name, ok := names[value]; ok {
+		return name
+	}
+	return value
+}
+
+// applyOverrides replaces the extracted project name and version with
+// explicitly configured values. An overridden name invalidates any
+// repository comparison made by the extractor, so project_match_repo is
+// recomputed against the new name.
+func applyOverrides(metadata *Metadata, name, version string) {
+	if name != "" {
+		metadata.Common.ProjectName = name
+		delete(metadata.LanguageSpecific, "project_match_repo")
+	}
+	if version != "" {
+		metadata.Common.ProjectVersion = version
+		metadata.Common.VersionSource = "override"
+	}
+}
+
 // normalizeProjectTypeToLanguage converts project type variants to base language names
 // for consistent output prefixing (e.g., "python-modern" -> "python")
 func normalizeProjectTypeToLanguage(projectType string) string {
@@ -584,6 +1356,7 @@ func normalizeProjectTypeToLanguage(projectType string) string {
 		"csharp-props":       "csharp",
 		"dotnet-project":     "dotnet",
 		"go-module":          "go",
+		"go-workspace":       "go",
 		"rust-cargo":         "rust",
 		"ruby-gemspec":       "ruby",
 		"ruby-bundler":       "ruby",
@@ -612,3 +1385,31 @@ func normalizeProjectTypeToLanguage(projectType string) string {
 	// Return as-is if no mapping found
 	return strings.ToLower(projectType)
 }
+
+// composeSecurityPosture derives the security posture from existing
+// metadata signals without any additional manifest parsing. The security
+// policy is looked up at the repository root, as GitHub does; lock files
+// belong to the project, which may be in a subdirectory.
+func composeSecurityPosture(repoRoot, projectPath string, metadata *Metadata) *posture.Posture {
+	signals := posture.Signals{
+		DependencyAutomation: metadata.Common.DependencyAutomation,
+		HasSecurityPolicy:    posture.HasSecurityPolicy(repoRoot),
+	}
+
+	if hasLockFile, ok := metadata.LanguageSpecific["has_lock_file"].(bool); ok {
+		signals.HasLockFile = hasLockFile
+	} else {
+		signals.HasLockFile = posture.HasLockFile(projectPath)
+	}
+
+	for _, warning := range output.CollectWarnings(metadata) {
+		switch warning.RuleID {
+		case output.RuleUnpinnedBaseImage:
+			signals.UnpinnedBaseImages++
+		case output.RuleEOLVersions:
+			signals.HasEOLVersions = true
+		}
+	}
+
+	return posture.Compute(signals)
+}
@@ -0,0 +1,423 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/detector"
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+	"github.com/lfreleng-actions/build-metadata-action/internal/output"
+	"github.com/lfreleng-actions/build-metadata-action/internal/posture"
+)
+
+// TestMetadataMatchesSchemaRequiredKeys tests that the metadata structs
+// always emit the keys the published JSON Schema requires
+func TestMetadataMatchesSchemaRequiredKeys(t *testing.T) {
+	var schema struct {
+		Required   []string `json:"required"`
+		Properties map[string]struct {
+			Required []string `json:"required"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal([]byte(output.JSONSchema()), &schema); err != nil {
+		t.Fatalf("Failed to parse schema: %v", err)
+	}
+
+	// An empty document shows which keys are emitted unconditionally
+	document, err := json.Marshal(&Metadata{})
+	if err != nil {
+		t.Fatalf("Failed to marshal metadata: %v", err)
+	}
+	var parsed map[string]map[string]interface{}
+	if err := json.Unmarshal(document, &parsed); err != nil {
+		t.Fatalf("Failed to parse metadata: %v", err)
+	}
+
+	for _, section := range schema.Required {
+		fields, ok := parsed[section]
+		if !ok {
+			t.Errorf("Metadata JSON is missing required section %q", section)
+			continue
+		}
+		for _, key := range schema.Properties[section].Required {
+			if _, ok := fields[key]; !ok {
+				t.Errorf("Metadata JSON is missing required key %s.%s", section, key)
+			}
+		}
+	}
+}
+
+// TestApplyOverrides tests that the name and version overrides apply
+// independently of each other
+func TestApplyOverrides(t *testing.T) {
+	newMetadata := func() *Metadata {
+		return &Metadata{
+			Common: CommonMetadata{
+				ProjectName:    "placeholder",
+				ProjectVersion: "0.0.0",
+				VersionSource:  "package.json",
+			},
+			LanguageSpecific: map[string]interface{}{
+				"project_match_repo": true,
+			},
+		}
+	}
+
+	t.Run("name only", func(t *testing.T) {
+		metadata := newMetadata()
+		applyOverrides(metadata, "real-name", "")
+
+		if metadata.Common.ProjectName != "real-name" {
+			t.Errorf("ProjectName = %q, want real-name", metadata.Common.ProjectName)
+		}
+		if metadata.Common.ProjectVersion != "0.0.0" || metadata.Common.VersionSource != "package.json" {
+			t.Errorf("Version = %q from %q, want the extracted version unchanged",
+				metadata.Common.ProjectVersion, metadata.Common.VersionSource)
+		}
+		// The extractor's comparison used the old name and must be recomputed
+		if _, ok := metadata.LanguageSpecific["project_match_repo"]; ok {
+			t.Error("project_match_repo from the extractor should be dropped")
+		}
+	})
+
+	t.Run("version only", func(t *testing.T) {
+		metadata := newMetadata()
+		applyOverrides(metadata, "", "2.3.4")
+
+		if metadata.Common.ProjectVersion != "2.3.4" {
+			t.Errorf("ProjectVersion = %q, want 2.3.4", metadata.Common.ProjectVersion)
+		}
+		if metadata.Common.VersionSource != "override" {
+			t.Errorf("VersionSource = %q, want override", metadata.Common.VersionSource)
+		}
+		if metadata.Common.ProjectName != "placeholder" {
+			t.Errorf("ProjectName = %q, want the extracted name unchanged", metadata.Common.ProjectName)
+		}
+		if _, ok := metadata.LanguageSpecific["project_match_repo"]; !ok {
+			t.Error("project_match_repo should be kept when the name is not overridden")
+		}
+	})
+}
+
+// TestBuildPlatform tests that the build OS and architecture fall back to
+// the Go runtime, in the runner vocabulary, without CI runner information
+func TestBuildPlatform(t *testing.T) {
+	buildOS, buildArch := buildPlatform(BuildMetadata{})
+	if want := runnerName(runnerOSNames, runtime.GOOS); buildOS != want {
+		t.Errorf("build OS = %q, want %q", buildOS, want)
+	}
+	if want := runnerName(runnerArchNames, runtime.GOARCH); buildArch != want {
+		t.Errorf("build arch = %q, want %q", buildArch, want)
+	}
+
+	buildOS, buildArch = buildPlatform(BuildMetadata{RunnerOS: "Linux", RunnerArch: "X64"})
+	if buildOS != "Linux" || buildArch != "X64" {
+		t.Errorf("buildPlatform() = %q, %q, want the CI-provided Linux, X64", buildOS, buildArch)
+	}
+
+	for _, tt := range []struct {
+		names map[string]string
+		value string
+		want  string
+	}{
+		{runnerOSNames, "linux", "Linux"},
+		{runnerOSNames, "darwin", "macOS"},
+		{runnerArchNames, "amd64", "X64"},
+		{runnerArchNames, "arm64", "ARM64"},
+		{runnerOSNames, "plan9", "plan9"},
+	} {
+		if got := runnerName(tt.names, tt.value); got != tt.want {
+			t.Errorf("runnerName(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+// TestOutputTargetsSet tests parsing and validation of --output values
+func TestOutputTargetsSet(t *testing.T) {
+	var targets outputTargets
+	for _, value := range []string{"json=meta.json", "Summary=-", "sarif=results.sarif"} {
+		if err := targets.Set(value); err != nil {
+			t.Fatalf("Set(%q) error = %v", value, err)
+		}
+	}
+	want := outputTargets{{Format: "json", Path: "meta.json"}, {Format: "summary", Path: "-"}, {Format: "sarif", Path: "results.sarif"}}
+	if len(targets) != len(want) || targets[0] != want[0] || targets[1] != want[1] || targets[2] != want[2] {
+		t.Errorf("targets = %v, want %v", targets, want)
+	}
+
+	for _, value := range []string{"json", "json=", "xml=out.xml"} {
+		if err := targets.Set(value); err == nil {
+			t.Errorf("Set(%q) should fail", value)
+		}
+	}
+}
+
+// TestSarifOutputPath tests that the SARIF file is written where later
+// workflow steps can find it
+func TestSarifOutputPath(t *testing.T) {
+	runnerTemp := t.TempDir()
+	workspace := t.TempDir()
+
+	t.Setenv("RUNNER_TEMP", runnerTemp)
+	t.Setenv("GITHUB_WORKSPACE", workspace)
+	if got, want := sarifOutputPath(), filepath.Join(runnerTemp, "build-metadata.sarif"); got != want {
+		t.Errorf("sarifOutputPath() = %q, want %q", got, want)
+	}
+
+	t.Setenv("RUNNER_TEMP", "")
+	if got, want := sarifOutputPath(), filepath.Join(workspace, "build-metadata.sarif"); got != want {
+		t.Errorf("sarifOutputPath() = %q, want %q", got, want)
+	}
+
+	t.Setenv("GITHUB_WORKSPACE", "")
+	if got, want := sarifOutputPath(), filepath.Join(os.TempDir(), "build-metadata.sarif"); got != want {
+		t.Errorf("sarifOutputPath() = %q, want %q", got, want)
+	}
+}
+
+// TestWriteOutputs tests writing two formats to files and one to stdout
+func TestWriteOutputs(t *testing.T) {
+	tmpDir := t.TempDir()
+	jsonPath := filepath.Join(tmpDir, "meta.json")
+	summaryPath := filepath.Join(tmpDir, "summary.md")
+
+	targets := outputTargets{
+		{Format: "json", Path: jsonPath},
+		{Format: "summary", Path: summaryPath},
+		{Format: "yaml", Path: "-"},
+	}
+	render := func(format string) (string, error) {
+		return "rendered " + format, nil
+	}
+
+	var stdout bytes.Buffer
+	if err := writeOutputs(targets, render, &stdout); err != nil {
+		t.Fatalf("writeOutputs() error = %v", err)
+	}
+
+	for path, want := range map[string]string{
+		jsonPath:    "rendered json\n",
+		summaryPath: "rendered summary\n",
+	} {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", path, err)
+		}
+		if string(content) != want {
+			t.Errorf("%s = %q, want %q", filepath.Base(path), content, want)
+		}
+	}
+	if stdout.String() != "rendered yaml\n" {
+		t.Errorf("stdout = %q, want %q", stdout.String(), "rendered yaml\n")
+	}
+}
+
+// TestWriteOutputs_WriteError tests that a failed write is reported while
+// the remaining targets are still written
+func TestWriteOutputs_WriteError(t *testing.T) {
+	tmpDir := t.TempDir()
+	jsonPath := filepath.Join(tmpDir, "meta.json")
+	targets := outputTargets{
+		{Format: "summary", Path: filepath.Join(tmpDir, "missing", "summary.md")},
+		{Format: "json", Path: jsonPath},
+	}
+
+	err := writeOutputs(targets, func(format string) (string, error) {
+		return "{}", nil
+	}, &bytes.Buffer{})
+	if err == nil || !strings.Contains(err.Error(), "failed to write summary output") {
+		t.Errorf("writeOutputs() error = %v, want a summary write error", err)
+	}
+	if _, err := os.Stat(jsonPath); err != nil {
+		t.Errorf("json output should still be written: %v", err)
+	}
+}
+
+// TestGeneratedMatrixJSONIsValid tests that the matrix_json generated by
+// extractors passes output.ValidateMatrixJSON
+func TestGeneratedMatrixJSONIsValid(t *testing.T) {
+	tests := []struct {
+		extractor string
+		files     map[string]string
+	}{
+		{extractor: "go-module", files: map[string]string{"go.mod": "module example.com/app\n\ngo 1.22\n"}},
+		{extractor: "php", files: map[string]string{"composer.json": `{"name": "vendor/app", "require": {"php": ">=8.1"}}`}},
+		{extractor: "rust-cargo", files: map[string]string{"Cargo.toml": "[package]\nname = \"app\"\nversion = \"0.1.0\"\nrust-version = \"1.75\"\n"}},
+		{extractor: "swift", files: map[string]string{"Package.swift": "// swift-tools-version:5.9\nimport PackageDescription\n\nlet package = Package(name: \"App\")\n"}},
+		{extractor: "terraform", files: map[string]string{"versions.tf": "terraform {\n  required_version = \">= 1.5.0\"\n}\n"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.extractor, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+					t.Fatalf("Failed to write %s: %v", name, err)
+				}
+			}
+
+			impl, err := extractor.GetExtractor(tt.extractor)
+			if err != nil {
+				t.Fatalf("GetExtractor(%q) error = %v", tt.extractor, err)
+			}
+			metadata, err := impl.Extract(dir)
+			if err != nil {
+				t.Fatalf("Extract() error = %v", err)
+			}
+			matrixJSON, ok := metadata.LanguageSpecific["matrix_json"].(string)
+			if !ok {
+				t.Fatalf("matrix_json not generated")
+			}
+			if err := output.ValidateMatrixJSON(matrixJSON); err != nil {
+				t.Errorf("ValidateMatrixJSON(%s) error = %v", matrixJSON, err)
+			}
+		})
+	}
+}
+
+// TestExtractorName tests that extractor_name records the extractor
+// chosen for the detected project type
+func TestExtractorName(t *testing.T) {
+	tests := []struct {
+		file     string
+		content  string
+		expected string
+	}{
+		{file: "CMakeLists.txt", content: "project(app VERSION 1.0)\n", expected: "cpp"},
+		{file: "build.sbt", content: "name := \"app\"\n", expected: "scala"},
+		{file: "go.mod", content: "module example.com/app\n\ngo 1.22\n", expected: "go-module"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, tt.file), []byte(tt.content), 0644); err != nil {
+				t.Fatalf("Failed to write %s: %v", tt.file, err)
+			}
+
+			projectType, err := detector.DetectProjectType(dir)
+			if err != nil {
+				t.Fatalf("DetectProjectType() error = %v", err)
+			}
+			impl, err := extractor.GetExtractor(projectType)
+			if err != nil {
+				t.Fatalf("GetExtractor(%q) error = %v", projectType, err)
+			}
+
+			metadata := &Metadata{Common: CommonMetadata{ProjectType: projectType, ExtractorName: impl.Name()}}
+			document, err := json.Marshal(metadata)
+			if err != nil {
+				t.Fatalf("Failed to marshal metadata: %v", err)
+			}
+			var parsed struct {
+				Common map[string]interface{} `json:"common"`
+			}
+			if err := json.Unmarshal(document, &parsed); err != nil {
+				t.Fatalf("Failed to parse metadata: %v", err)
+			}
+			if got := parsed.Common["extractor_name"]; got != tt.expected {
+				t.Errorf("extractor_name for %s = %v, want %s", projectType, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestGeneratorMetadata tests the generator block defaults and the
+// values injected through ldflags
+func TestGeneratorMetadata(t *testing.T) {
+	generator := generatorMetadata()
+	if generator.Name != "build-metadata-action" || generator.Version != "dev" {
+		t.Errorf("generatorMetadata() = %+v, want build-metadata-action at dev", generator)
+	}
+
+	defer func(version, commit string) { actionVersion, actionCommit = version, commit }(actionVersion, actionCommit)
+	actionVersion, actionCommit = "v1.2.3", "0123456789abcdef"
+	generator = generatorMetadata()
+	if generator.Version != "v1.2.3" || generator.Commit != "0123456789abcdef" {
+		t.Errorf("generatorMetadata() = %+v, want the injected version and commit", generator)
+	}
+}
+
+// TestMatrixOnlyPHP tests the --matrix-only output for a PHP project
+func TestMatrixOnlyPHP(t *testing.T) {
+	dir := t.TempDir()
+	composer := `{"name": "vendor/app", "require": {"php": ">=8.2"}}`
+	if err := os.WriteFile(filepath.Join(dir, "composer.json"), []byte(composer), 0644); err != nil {
+		t.Fatalf("Failed to write composer.json: %v", err)
+	}
+
+	impl, err := extractor.GetExtractor("php")
+	if err != nil {
+		t.Fatalf("GetExtractor(php) error = %v", err)
+	}
+	projectMetadata, err := impl.Extract(dir)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	metadata := &Metadata{
+		Common:           CommonMetadata{ProjectType: "php-composer"},
+		LanguageSpecific: projectMetadata.LanguageSpecific,
+	}
+
+	matrixJSON, err := output.GenerateMatrixJSON(metadata)
+	if err != nil {
+		t.Fatalf("GenerateMatrixJSON() error = %v", err)
+	}
+	var matrix struct {
+		Version []string            `json:"version"`
+		Include []map[string]string `json:"include"`
+	}
+	if err := json.Unmarshal([]byte(matrixJSON), &matrix); err != nil {
+		t.Fatalf("matrix output %s is not JSON: %v", matrixJSON, err)
+	}
+	if len(matrix.Version) == 0 || matrix.Version[0] != "8.2" {
+		t.Errorf("version = %v, want versions from 8.2", matrix.Version)
+	}
+	if len(matrix.Include) != len(matrix.Version) || matrix.Include[0]["php-version"] != "8.2" {
+		t.Errorf("include = %v, want a php-version entry per version", matrix.Include)
+	}
+
+	metadata.LanguageSpecific = map[string]interface{}{}
+	if _, err := output.GenerateMatrixJSON(metadata); err == nil {
+		t.Error("GenerateMatrixJSON() should fail without a matrix")
+	}
+}
+
+// TestComposeSecurityPosture_NestedProject tests that a project found in a
+// subdirectory still credits the repository's SECURITY.md
+func TestComposeSecurityPosture_NestedProject(t *testing.T) {
+	repoRoot := t.TempDir()
+	projectPath := filepath.Join(repoRoot, "app")
+	if err := os.MkdirAll(filepath.Join(repoRoot, ".github"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(projectPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(repoRoot, ".github", "SECURITY.md"), []byte("# Security\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(projectPath, "package-lock.json"), []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result := composeSecurityPosture(repoRoot, projectPath, &Metadata{LanguageSpecific: map[string]interface{}{}})
+
+	passed := make(map[string]bool)
+	for _, factor := range result.Factors {
+		passed[factor.Name] = factor.Passed
+	}
+	for _, name := range []string{posture.FactorSecurityPolicy, posture.FactorLockFile} {
+		if !passed[name] {
+			t.Errorf("factor %s passed = false, want true", name)
+		}
+	}
+}
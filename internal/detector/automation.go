@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package detector
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Dependency automation tools
+const (
+	DependencyAutomationRenovate   = "renovate"
+	DependencyAutomationDependabot = "dependabot"
+	DependencyAutomationNone       = "none"
+)
+
+// DependencyAutomation describes automated dependency update configuration
+type DependencyAutomation struct {
+	Tool       string   // "renovate", "dependabot", or "none"
+	ConfigFile string   // Path of the configuration file relative to the project
+	Ecosystems []string // Configured package ecosystems (dependabot only)
+}
+
+// renovateConfigFiles lists the locations Renovate reads its configuration from
+var renovateConfigFiles = []string{
+	"renovate.json",
+	"renovate.json5",
+	".renovaterc",
+	".renovaterc.json",
+	".renovaterc.json5",
+	".github/renovate.json",
+	".github/renovate.json5",
+	".gitlab/renovate.json",
+	".gitlab/renovate.json5",
+}
+
+// dependabotConfigFiles lists the locations Dependabot reads its configuration from
+var dependabotConfigFiles = []string{
+	".github/dependabot.yml",
+	".github/dependabot.yaml",
+}
+
+// dependabotConfig represents the parts of dependabot.yml we care about
+type dependabotConfig struct {
+	Updates []struct {
+		PackageEcosystem string `yaml:"package-ecosystem"`
+	} `yaml:"updates"`
+}
+
+// DetectDependencyAutomation checks for Renovate or Dependabot configuration.
+// Renovate takes precedence when both are configured.
+func DetectDependencyAutomation(projectPath string) (*DependencyAutomation, error) {
+	for _, name := range renovateConfigFiles {
+		if fileExists(projectPath, name) {
+			return &DependencyAutomation{
+				Tool:       DependencyAutomationRenovate,
+				ConfigFile: name,
+			}, nil
+		}
+	}
+
+	for _, name := range dependabotConfigFiles {
+		if !fileExists(projectPath, name) {
+			continue
+		}
+
+		automation := &DependencyAutomation{
+			Tool:       DependencyAutomationDependabot,
+			ConfigFile: name,
+		}
+
+		ecosystems, err := parseDependabotEcosystems(filepath.Join(projectPath, name))
+		if err != nil {
+			return automation, err
+		}
+		automation.Ecosystems = ecosystems
+
+		return automation, nil
+	}
+
+	return &DependencyAutomation{Tool: DependencyAutomationNone}, nil
+}
+
+// parseDependabotEcosystems returns the unique package ecosystems in a
+// dependabot configuration, in the order they are declared
+func parseDependabotEcosystems(path string) ([]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dependabot config: %w", err)
+	}
+
+	var config dependabotConfig
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse dependabot config: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	ecosystems := make([]string, 0, len(config.Updates))
+	for _, update := range config.Updates {
+		if update.PackageEcosystem == "" || seen[update.PackageEcosystem] {
+			continue
+		}
+		seen[update.PackageEcosystem] = true
+		ecosystems = append(ecosystems, update.PackageEcosystem)
+	}
+
+	return ecosystems, nil
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package detector
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestDetectDependencyAutomation_Renovate tests detection of a renovate config
+func TestDetectDependencyAutomation_Renovate(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "renovate.json"), []byte(`{"extends": ["config:base"]}`), 0644); err != nil {
+		t.Fatalf("Failed to write renovate.json: %v", err)
+	}
+
+	automation, err := DetectDependencyAutomation(tmpDir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if automation.Tool != DependencyAutomationRenovate {
+		t.Errorf("Tool = %v, want %v", automation.Tool, DependencyAutomationRenovate)
+	}
+	if automation.ConfigFile != "renovate.json" {
+		t.Errorf("ConfigFile = %v, want renovate.json", automation.ConfigFile)
+	}
+	if len(automation.Ecosystems) != 0 {
+		t.Errorf("Ecosystems = %v, want none", automation.Ecosystems)
+	}
+}
+
+// TestDetectDependencyAutomation_Dependabot tests detection of a dependabot config
+func TestDetectDependencyAutomation_Dependabot(t *testing.T) {
+	dependabotYAML := `version: 2
+updates:
+  - package-ecosystem: "gomod"
+    directory: "/"
+    schedule:
+      interval: "weekly"
+  - package-ecosystem: "github-actions"
+    directory: "/"
+    schedule:
+      interval: "weekly"
+`
+
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".github"), 0755); err != nil {
+		t.Fatalf("Failed to create .github: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".github", "dependabot.yml"), []byte(dependabotYAML), 0644); err != nil {
+		t.Fatalf("Failed to write dependabot.yml: %v", err)
+	}
+
+	automation, err := DetectDependencyAutomation(tmpDir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if automation.Tool != DependencyAutomationDependabot {
+		t.Errorf("Tool = %v, want %v", automation.Tool, DependencyAutomationDependabot)
+	}
+
+	expected := []string{"gomod", "github-actions"}
+	if !reflect.DeepEqual(automation.Ecosystems, expected) {
+		t.Errorf("Ecosystems = %v, want %v", automation.Ecosystems, expected)
+	}
+}
+
+// TestDetectDependencyAutomation_None tests a project without automation config
+func TestDetectDependencyAutomation_None(t *testing.T) {
+	automation, err := DetectDependencyAutomation(t.TempDir())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if automation.Tool != DependencyAutomationNone {
+		t.Errorf("Tool = %v, want %v", automation.Tool, DependencyAutomationNone)
+	}
+}
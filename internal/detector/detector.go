@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 )
 
 // ProjectType represents a detected project type
@@ -39,7 +40,7 @@ var detectionRules = []DetectionRule{
 	// Python - Modern
 	{Type: "python", Subtype: "modern", Files: []string{"pyproject.toml"}, Priority: 2},
 	{Type: "python", Subtype: "legacy", Files: []string{"setup.py"}, Priority: 9},
-	{Type: "python", Subtype: "setup-cfg", Files: []string{"setup.cfg"}, Priority: 9},
+	{Type: "python", Subtype: "legacy", Files: []string{"setup.cfg"}, Priority: 9},
 
 	// JavaScript/Node.js
 	{Type: "javascript", Subtype: "npm", Files: []string{"package.json"}, Priority: 1},
@@ -55,6 +56,7 @@ var detectionRules = []DetectionRule{
 	{Type: "csharp", Subtype: "props", Files: []string{"*.props"}, Priority: 6},
 
 	// Go
+	{Type: "go", Subtype: "workspace", Files: []string{"go.work"}, Priority: 6},
 	{Type: "go", Subtype: "module", Files: []string{"go.mod"}, Priority: 6},
 
 	// Rust
@@ -91,6 +93,9 @@ var detectionRules = []DetectionRule{
 	{Type: "c", Subtype: "autoconf", Files: []string{"configure.ac"}, Priority: 8},
 	{Type: "c", Subtype: "autoconf-legacy", Files: []string{"configure.in"}, Priority: 9},
 	{Type: "c", Subtype: "meson", Files: []string{"meson.build"}, Priority: 14},
+	// Conan recipe without a recognized build system
+	{Type: "c", Subtype: "conan", Files: []string{"conanfile.py"}, Priority: 22},
+	{Type: "c", Subtype: "conan", Files: []string{"conanfile.txt"}, Priority: 22},
 
 	// Kotlin (check before java-gradle-kts since build.gradle.kts could be either)
 	{Type: "kotlin", Subtype: "gradle", Files: []string{"build.gradle.kts"}, Priority: 3},
@@ -108,12 +113,21 @@ var detectionRules = []DetectionRule{
 	// Perl
 	{Type: "perl", Subtype: "cpan", Files: []string{"Makefile.PL"}, Priority: 21},
 	{Type: "perl", Subtype: "module-build", Files: []string{"Build.PL"}, Priority: 21},
+	{Type: "perl", Subtype: "cpanfile", Files: []string{"cpanfile"}, Priority: 21},
+
+	// Nim
+	{Type: "nim", Subtype: "nimble", Files: []string{"*.nimble"}, Priority: 22},
 
 	// R
 	{Type: "r", Subtype: "package", Files: []string{"DESCRIPTION"}, Priority: 22},
 
+	// Zig
+	{Type: "zig", Subtype: "package", Files: []string{"build.zig.zon"}, Priority: 22},
+	{Type: "zig", Subtype: "build", Files: []string{"build.zig"}, Priority: 22},
+
 	// Docker
 	{Type: "docker", Subtype: "", Files: []string{"Dockerfile"}, Priority: 23},
+	{Type: "docker", Subtype: "", Files: []string{"*.dockerfile"}, Priority: 23},
 
 	// Helm
 	{Type: "helm", Subtype: "chart", Files: []string{"Chart.yaml"}, Priority: 24},
@@ -122,30 +136,113 @@ var detectionRules = []DetectionRule{
 	{Type: "terraform", Subtype: "module", Files: []string{"main.tf"}, Priority: 25},
 	{Type: "terraform", Subtype: "module", Files: []string{"variables.tf"}, Priority: 25},
 	{Type: "terraform", Subtype: "module", Files: []string{"*.tf"}, Priority: 26},
+
+	// OpenAPI/Swagger, last so that code repositories shipping an API
+	// spec are still detected as their language
+	{Type: "openapi", Subtype: "spec", Files: []string{"openapi.yaml"}, Priority: 27},
+	{Type: "openapi", Subtype: "spec", Files: []string{"openapi.yml"}, Priority: 27},
+	{Type: "openapi", Subtype: "spec", Files: []string{"openapi.json"}, Priority: 27},
+	{Type: "openapi", Subtype: "spec", Files: []string{"swagger.yaml"}, Priority: 27},
+	{Type: "openapi", Subtype: "spec", Files: []string{"swagger.yml"}, Priority: 27},
+	{Type: "openapi", Subtype: "spec", Files: []string{"swagger.json"}, Priority: 27},
+}
+
+// DefaultProjectSearchDepth is the default number of directory levels
+// searched below the given path when no project is detected there
+const DefaultProjectSearchDepth = 1
+
+// nestedSearchIgnoreDirs are dependency directories never searched for a
+// nested project (hidden directories are skipped as well)
+var nestedSearchIgnoreDirs = map[string]bool{
+	"node_modules": true,
+	"vendor":       true,
+	"target":       true,
+	"venv":         true,
+	"__pycache__":  true,
 }
 
 // DetectProjectType attempts to detect the project type at the given path
 func DetectProjectType(projectPath string) (string, error) {
+	if pt := detectProjectType(projectPath); pt != nil {
+		return pt.String(), nil
+	}
+
+	return "", fmt.Errorf("could not detect project type in %s", projectPath)
+}
+
+// DetectNestedProjectType searches up to maxDepth directory levels below
+// projectPath for a single project, for repositories whose project lives
+// in a subdirectory such as ./backend. Each level is searched in full and
+// the highest priority match wins, ties going to the first directory in
+// name order. Returns the project directory and its type.
+func DetectNestedProjectType(projectPath string, maxDepth int) (string, string, error) {
+	level := []string{projectPath}
+	for depth := 1; depth <= maxDepth && len(level) > 0; depth++ {
+		var next []string
+		var bestPath string
+		var best *ProjectType
+
+		for _, dir := range level {
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				continue
+			}
+			for _, entry := range entries {
+				name := entry.Name()
+				if !entry.IsDir() || strings.HasPrefix(name, ".") || nestedSearchIgnoreDirs[name] {
+					continue
+				}
+				subdir := filepath.Join(dir, name)
+				next = append(next, subdir)
+				if pt := detectProjectType(subdir); pt != nil && (best == nil || pt.Priority < best.Priority) {
+					best = pt
+					bestPath = subdir
+				}
+			}
+		}
+
+		if best != nil {
+			return bestPath, best.String(), nil
+		}
+		level = next
+	}
+
+	return "", "", fmt.Errorf("could not detect project type in %s or %d level(s) below it", projectPath, maxDepth)
+}
+
+// sortRules orders rules by priority. Ties go to the rule requiring more
+// files, being the more specific match (typescript-npm over
+// javascript-npm), and then to declaration order (go-workspace over
+// go-module).
+func sortRules(rules []DetectionRule) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].Priority != rules[j].Priority {
+			return rules[i].Priority < rules[j].Priority
+		}
+		return len(rules[i].Files) > len(rules[j].Files)
+	})
+}
+
+// detectProjectType returns the highest priority project type matching
+// projectPath, or nil when no rule matches
+func detectProjectType(projectPath string) *ProjectType {
 	// Sort rules by priority (higher priority first)
 	sortedRules := make([]DetectionRule, len(detectionRules))
 	copy(sortedRules, detectionRules)
-	sort.Slice(sortedRules, func(i, j int) bool {
-		return sortedRules[i].Priority < sortedRules[j].Priority
-	})
+	sortRules(sortedRules)
 
 	// Check each rule
 	for _, rule := range sortedRules {
 		if matchesRule(projectPath, rule) {
-			pt := &ProjectType{
+			return &ProjectType{
 				Type:     rule.Type,
 				Subtype:  rule.Subtype,
 				Priority: rule.Priority,
 			}
-			return pt.String(), nil
 		}
 	}
 
-	return "", fmt.Errorf("could not detect project type in %s", projectPath)
+	return nil
 }
 
 // DetectAllProjectTypes returns all matching project types (useful for monorepos)
@@ -156,9 +253,7 @@ func DetectAllProjectTypes(projectPath string) ([]string, error) {
 	// Sort rules by priority
 	sortedRules := make([]DetectionRule, len(detectionRules))
 	copy(sortedRules, detectionRules)
-	sort.Slice(sortedRules, func(i, j int) bool {
-		return sortedRules[i].Priority < sortedRules[j].Priority
-	})
+	sortRules(sortedRules)
 
 	// Check each rule
 	for _, rule := range sortedRules {
@@ -177,8 +272,14 @@ func DetectAllProjectTypes(projectPath string) ([]string, error) {
 		return nil, fmt.Errorf("could not detect any project types in %s", projectPath)
 	}
 
-	// Convert to strings
+	// Convert to strings, collapsing rules that share a type (setup.py
+	// and setup.cfg both report python-legacy)
+	seen := make(map[string]bool)
 	for _, pt := range detected {
+		if seen[pt.String()] {
+			continue
+		}
+		seen[pt.String()] = true
 		projectTypes = append(projectTypes, pt.String())
 	}
 
@@ -33,6 +33,14 @@ func TestDetectProjectType(t *testing.T) {
 			expectedType: "python-legacy",
 			expectError:  false,
 		},
+		{
+			name: "Python legacy (setup.cfg only)",
+			setupFiles: map[string]string{
+				"setup.cfg": "[metadata]\nname = test",
+			},
+			expectedType: "python-legacy",
+			expectError:  false,
+		},
 		{
 			name: "JavaScript/Node.js",
 			setupFiles: map[string]string{
@@ -82,6 +90,15 @@ func TestDetectProjectType(t *testing.T) {
 			expectedType: "go-module",
 			expectError:  false,
 		},
+		{
+			name: "Go workspace",
+			setupFiles: map[string]string{
+				"go.work": "go 1.22\n\nuse ./api",
+				"go.mod":  "module example.com/test",
+			},
+			expectedType: "go-workspace",
+			expectError:  false,
+		},
 		{
 			name: "Rust Cargo",
 			setupFiles: map[string]string{
@@ -138,6 +155,23 @@ func TestDetectProjectType(t *testing.T) {
 			expectedType: "c-cmake",
 			expectError:  false,
 		},
+		{
+			name: "C/C++ Conan recipe only",
+			setupFiles: map[string]string{
+				"conanfile.txt": "[requires]\nzlib/1.3\n",
+			},
+			expectedType: "c-conan",
+			expectError:  false,
+		},
+		{
+			name: "C/C++ CMake with Conan",
+			setupFiles: map[string]string{
+				"CMakeLists.txt": "cmake_minimum_required(VERSION 3.10)",
+				"conanfile.py":   "from conan import ConanFile",
+			},
+			expectedType: "c-cmake",
+			expectError:  false,
+		},
 		{
 			name: "Elixir Mix",
 			setupFiles: map[string]string{
@@ -338,6 +372,37 @@ func TestDetectionPriority(t *testing.T) {
 			},
 			expectedFirst: "java-maven",
 		},
+		{
+			name: "Go module over an included OpenAPI spec",
+			setupFiles: map[string]string{
+				"go.mod":       "module example.com/api",
+				"openapi.yaml": "openapi: 3.0.0",
+			},
+			expectedFirst: "go-module",
+		},
+		{
+			name: "OpenAPI spec on its own",
+			setupFiles: map[string]string{
+				"openapi.yaml": "openapi: 3.0.0",
+			},
+			expectedFirst: "openapi-spec",
+		},
+		{
+			name: "Zig package manifest over build script",
+			setupFiles: map[string]string{
+				"build.zig":     "const std = @import(\"std\");",
+				"build.zig.zon": ".{ .name = .app, .version = \"0.1.0\" }",
+			},
+			expectedFirst: "zig-package",
+		},
+		{
+			name: "Perl Makefile.PL over cpanfile",
+			setupFiles: map[string]string{
+				"Makefile.PL": "use ExtUtils::MakeMaker;",
+				"cpanfile":    "requires 'Moo';",
+			},
+			expectedFirst: "perl-cpan",
+		},
 	}
 
 	for _, tt := range tests {
@@ -595,6 +660,85 @@ func TestSubdirectoryDetection(t *testing.T) {
 	}
 }
 
+// TestDetectNestedProjectType tests finding a single project below the root
+func TestDetectNestedProjectType(t *testing.T) {
+	tests := []struct {
+		name         string
+		files        []string
+		maxDepth     int
+		expectedPath string
+		expectedType string
+		expectError  bool
+	}{
+		{
+			name:         "Go module in backend",
+			files:        []string{"backend/go.mod", "docs/README.md"},
+			maxDepth:     1,
+			expectedPath: "backend",
+			expectedType: "go-module",
+		},
+		{
+			name:         "Best priority wins",
+			files:        []string{"api/go.mod", "web/package.json"},
+			maxDepth:     1,
+			expectedPath: "web",
+			expectedType: "javascript-npm",
+		},
+		{
+			name:         "Hidden and dependency directories skipped",
+			files:        []string{".github/package.json", "node_modules/dep/package.json", "vendor/go.mod", "service/go.mod"},
+			maxDepth:     1,
+			expectedPath: "service",
+			expectedType: "go-module",
+		},
+		{
+			name:        "Beyond max depth",
+			files:       []string{"services/api/go.mod"},
+			maxDepth:    1,
+			expectError: true,
+		},
+		{
+			name:         "Within larger depth",
+			files:        []string{"services/api/go.mod"},
+			maxDepth:     2,
+			expectedPath: "services/api",
+			expectedType: "go-module",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			for _, file := range tt.files {
+				path := filepath.Join(tmpDir, file)
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatalf("Failed to create directory: %v", err)
+				}
+				if err := os.WriteFile(path, []byte("{}"), 0644); err != nil {
+					t.Fatalf("Failed to write %s: %v", file, err)
+				}
+			}
+
+			path, projectType, err := DetectNestedProjectType(tmpDir, tt.maxDepth)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error, got %s (%s)", projectType, path)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if want := filepath.Join(tmpDir, tt.expectedPath); path != want {
+				t.Errorf("Path = %v, want %v", path, want)
+			}
+			if projectType != tt.expectedType {
+				t.Errorf("Type = %v, want %v", projectType, tt.expectedType)
+			}
+		})
+	}
+}
+
 // TestCaseInsensitivity tests file name case handling
 func TestCaseInsensitivity(t *testing.T) {
 	// Note: This test may behave differently on case-insensitive filesystems (macOS/Windows)
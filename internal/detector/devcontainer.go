@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package detector
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/jsonutil"
+)
+
+// devcontainerConfigFiles lists the locations Dev Containers reads its
+// configuration from
+var devcontainerConfigFiles = []string{
+	".devcontainer/devcontainer.json",
+	".devcontainer.json",
+}
+
+// Devcontainer describes a Dev Container configuration
+type Devcontainer struct {
+	ConfigFile string   `json:"config_file"` // Path relative to the project
+	Name       string   `json:"name,omitempty"`
+	Image      string   `json:"image,omitempty"`
+	Dockerfile string   `json:"dockerfile,omitempty"` // build.dockerfile, when built rather than pulled
+	Features   []string `json:"features,omitempty"`   // Feature references, sorted
+}
+
+// devcontainerConfig represents the parts of devcontainer.json we care about
+type devcontainerConfig struct {
+	Name  string `json:"name"`
+	Image string `json:"image"`
+	Build struct {
+		Dockerfile string `json:"dockerfile"`
+	} `json:"build"`
+	Features map[string]json.RawMessage `json:"features"`
+}
+
+// DetectDevcontainer reads the Dev Container configuration, returning nil
+// when the project has none. The file is JSONC, so comments and trailing
+// commas are removed before parsing. When parsing fails the returned
+// Devcontainer still records the configuration file.
+func DetectDevcontainer(projectPath string) (*Devcontainer, error) {
+	for _, name := range devcontainerConfigFiles {
+		if !fileExists(projectPath, name) {
+			continue
+		}
+
+		devcontainer := &Devcontainer{ConfigFile: name}
+		content, err := os.ReadFile(filepath.Join(projectPath, name))
+		if err != nil {
+			return devcontainer, err
+		}
+
+		var config devcontainerConfig
+		plain := jsonutil.StripTrailingCommas(jsonutil.RemoveComments(string(content)))
+		if err := json.Unmarshal([]byte(plain), &config); err != nil {
+			return devcontainer, fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+
+		devcontainer.Name = config.Name
+		devcontainer.Image = config.Image
+		devcontainer.Dockerfile = config.Build.Dockerfile
+		for feature := range config.Features {
+			devcontainer.Features = append(devcontainer.Features, feature)
+		}
+		sort.Strings(devcontainer.Features)
+		return devcontainer, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package detector
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestDetectDevcontainer tests parsing a devcontainer.json with comments
+func TestDetectDevcontainer(t *testing.T) {
+	devcontainerJSON := `// For format details, see https://aka.ms/devcontainer.json
+{
+	"name": "Go // dev",
+	/* The base image
+	   is pinned by the team */
+	"image": "mcr.microsoft.com/devcontainers/go:1-1.22-bookworm",
+	"features": {
+		"ghcr.io/devcontainers/features/node:1": {"version": "lts"},
+		"ghcr.io/devcontainers/features/docker-in-docker:2": {}, // for integration tests
+	},
+	"customizations": {"vscode": {"extensions": ["golang.go",]}},
+}
+`
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".devcontainer"), 0755); err != nil {
+		t.Fatalf("Failed to create .devcontainer: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".devcontainer", "devcontainer.json"), []byte(devcontainerJSON), 0644); err != nil {
+		t.Fatalf("Failed to write devcontainer.json: %v", err)
+	}
+
+	devcontainer, err := DetectDevcontainer(tmpDir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := &Devcontainer{
+		ConfigFile: ".devcontainer/devcontainer.json",
+		Name:       "Go // dev",
+		Image:      "mcr.microsoft.com/devcontainers/go:1-1.22-bookworm",
+		Features: []string{
+			"ghcr.io/devcontainers/features/docker-in-docker:2",
+			"ghcr.io/devcontainers/features/node:1",
+		},
+	}
+	if !reflect.DeepEqual(devcontainer, want) {
+		t.Errorf("DetectDevcontainer() = %+v, want %+v", devcontainer, want)
+	}
+}
+
+// TestDetectDevcontainer_Build tests a root .devcontainer.json built from
+// a Dockerfile
+func TestDetectDevcontainer_Build(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, ".devcontainer.json"), []byte(`{"build": {"dockerfile": "Dockerfile"}}`), 0644); err != nil {
+		t.Fatalf("Failed to write .devcontainer.json: %v", err)
+	}
+
+	devcontainer, err := DetectDevcontainer(tmpDir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if devcontainer.ConfigFile != ".devcontainer.json" || devcontainer.Dockerfile != "Dockerfile" {
+		t.Errorf("DetectDevcontainer() = %+v, want .devcontainer.json built from Dockerfile", devcontainer)
+	}
+}
+
+// TestDetectDevcontainer_None tests a project without a devcontainer
+func TestDetectDevcontainer_None(t *testing.T) {
+	devcontainer, err := DetectDevcontainer(t.TempDir())
+	if err != nil || devcontainer != nil {
+		t.Errorf("DetectDevcontainer() = %+v, %v, want nil, nil", devcontainer, err)
+	}
+}
+
+// TestDetectDevcontainer_Invalid tests that a malformed file is still
+// reported as present
+func TestDetectDevcontainer_Invalid(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, ".devcontainer.json"), []byte(`{"image": `), 0644); err != nil {
+		t.Fatalf("Failed to write .devcontainer.json: %v", err)
+	}
+
+	devcontainer, err := DetectDevcontainer(tmpDir)
+	if err == nil {
+		t.Error("Expected a parse error")
+	}
+	if devcontainer == nil || devcontainer.ConfigFile != ".devcontainer.json" {
+		t.Errorf("DetectDevcontainer() = %+v, want the config file recorded", devcontainer)
+	}
+}
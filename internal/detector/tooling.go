@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package detector
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+)
+
+// toolingConfigFiles lists the configuration files that indicate each
+// quality tool is set up. Patterns may contain wildcards.
+var toolingConfigFiles = map[string][]string{
+	"editorconfig":  {".editorconfig"},
+	"eslint":        {".eslintrc*", "eslint.config.*"},
+	"prettier":      {".prettierrc*", "prettier.config.*"},
+	"stylelint":     {".stylelintrc*", "stylelint.config.*"},
+	"golangci_lint": {".golangci.yml", ".golangci.yaml", ".golangci.toml", ".golangci.json"},
+	"ruff":          {"ruff.toml", ".ruff.toml"},
+	"pre_commit":    {".pre-commit-config.yaml", ".pre-commit-config.yml"},
+	"markdownlint":  {".markdownlint*"},
+	"yamllint":      {".yamllint", ".yamllint.yml", ".yamllint.yaml"},
+}
+
+// packageJSONToolingKeys maps package.json keys that embed a tool's
+// configuration to the tool
+var packageJSONToolingKeys = map[string]string{
+	"eslintConfig": "eslint",
+	"prettier":     "prettier",
+	"stylelint":    "stylelint",
+}
+
+// pyprojectToolingTables maps pyproject.toml [tool.*] tables to the tool
+var pyprojectToolingTables = map[string]string{
+	"ruff": "ruff",
+}
+
+// DetectTooling reports which code quality tools are configured in the
+// repository or the project, e.g. EditorConfig, ESLint or Ruff. Both the
+// repository root and the project path, when it is a subdirectory, are
+// checked. Every known tool is present in the result so that dashboards
+// can tell "not configured" from "not checked". Configuration embedded in
+// package.json or pyproject.toml counts as well.
+func DetectTooling(repoRoot, projectPath string) map[string]bool {
+	tooling := make(map[string]bool, len(toolingConfigFiles))
+	for tool := range toolingConfigFiles {
+		tooling[tool] = false
+	}
+
+	detectToolingIn(repoRoot, tooling)
+	if projectPath != "" && filepath.Clean(projectPath) != filepath.Clean(repoRoot) {
+		detectToolingIn(projectPath, tooling)
+	}
+	return tooling
+}
+
+// detectToolingIn marks the tools configured in dir
+func detectToolingIn(dir string, tooling map[string]bool) {
+	for tool, patterns := range toolingConfigFiles {
+		for _, pattern := range patterns {
+			// Glob directly: fileExists only expands leading wildcards
+			if matches, err := filepath.Glob(filepath.Join(dir, pattern)); err == nil && len(matches) > 0 {
+				tooling[tool] = true
+				break
+			}
+		}
+	}
+
+	if content, err := os.ReadFile(filepath.Join(dir, "package.json")); err == nil {
+		var pkg map[string]json.RawMessage
+		if json.Unmarshal(content, &pkg) == nil {
+			for key, tool := range packageJSONToolingKeys {
+				if _, ok := pkg[key]; ok {
+					tooling[tool] = true
+				}
+			}
+		}
+	}
+
+	var pyproject struct {
+		Tool map[string]interface{} `toml:"tool"`
+	}
+	if _, err := toml.DecodeFile(filepath.Join(dir, "pyproject.toml"), &pyproject); err == nil {
+		for table, tool := range pyprojectToolingTables {
+			if _, ok := pyproject.Tool[table]; ok {
+				tooling[tool] = true
+			}
+		}
+	}
+}
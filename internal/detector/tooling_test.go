@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package detector
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestDetectTooling tests detection of quality tool configuration files
+func TestDetectTooling(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		".editorconfig":  "root = true\n",
+		".eslintrc.json": `{"extends": "eslint:recommended"}`,
+		".golangci.yml":  "linters:\n  enable:\n    - gofmt\n",
+		"pyproject.toml": "[project]\nname = \"demo\"\n\n[tool.ruff]\nline-length = 100\n",
+		"package.json":   `{"name": "demo", "prettier": {"semi": false}}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	tooling := DetectTooling(tmpDir, tmpDir)
+
+	for _, tool := range []string{"editorconfig", "eslint", "golangci_lint", "ruff", "prettier"} {
+		if !tooling[tool] {
+			t.Errorf("tooling[%q] = false, want true", tool)
+		}
+	}
+	for _, tool := range []string{"stylelint", "pre_commit", "markdownlint", "yamllint"} {
+		configured, ok := tooling[tool]
+		if !ok {
+			t.Errorf("tooling[%q] missing, want false", tool)
+		} else if configured {
+			t.Errorf("tooling[%q] = true, want false", tool)
+		}
+	}
+}
+
+// TestDetectTooling_Empty tests that every tool is reported as absent
+func TestDetectTooling_Empty(t *testing.T) {
+	tmpDir := t.TempDir()
+	tooling := DetectTooling(tmpDir, tmpDir)
+
+	if len(tooling) != len(toolingConfigFiles) {
+		t.Errorf("len(tooling) = %d, want %d", len(tooling), len(toolingConfigFiles))
+	}
+	for tool, configured := range tooling {
+		if configured {
+			t.Errorf("tooling[%q] = true, want false", tool)
+		}
+	}
+}
+
+// TestDetectTooling_ProjectSubdirectory tests that configuration in a
+// project subdirectory is found alongside the repository root
+func TestDetectTooling_ProjectSubdirectory(t *testing.T) {
+	repoRoot := t.TempDir()
+	projectPath := filepath.Join(repoRoot, "services", "api")
+	if err := os.MkdirAll(projectPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		filepath.Join(repoRoot, ".editorconfig"):     "root = true\n",
+		filepath.Join(projectPath, "pyproject.toml"): "[project]\nname = \"api\"\n\n[tool.ruff]\nline-length = 100\n",
+		filepath.Join(projectPath, "package.json"):   `{"name": "api", "eslintConfig": {"root": true}}`,
+		filepath.Join(projectPath, ".yamllint.yaml"): "extends: default\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(name, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	tooling := DetectTooling(repoRoot, projectPath)
+
+	for _, tool := range []string{"editorconfig", "ruff", "eslint", "yamllint"} {
+		if !tooling[tool] {
+			t.Errorf("tooling[%q] = false, want true", tool)
+		}
+	}
+	if tooling["prettier"] {
+		t.Error("tooling[\"prettier\"] = true, want false")
+	}
+}
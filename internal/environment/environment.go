@@ -21,8 +21,13 @@ type Metadata struct {
 	// Setup Actions detected
 	SetupActions map[string]SetupActionInfo `json:"setup_actions,omitempty"`
 
-	// Tool versions
+	// Tool versions, unfiltered: every tool detected on the runner is kept
+	// here for structured output even when the summary hides it
 	Tools map[string]string `json:"tools,omitempty"`
+
+	// Tools whose detected version falls outside the project's declared
+	// constraint
+	ToolVersionMismatch []string `json:"tool_version_mismatch,omitempty"`
 }
 
 // CIEnvironment contains CI platform information
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// changelogFiles lists changelog file names in lookup order
+var changelogFiles = []string{
+	"CHANGELOG.md",
+	"CHANGELOG",
+	"CHANGES.md",
+	"CHANGES",
+	"HISTORY.md",
+}
+
+// changelogHeadingRe matches a markdown heading that starts with a version,
+// e.g. "## [1.2.3] - 2025-01-01", "## v1.2.3 (2025-01-01)" or
+// "# Version 1.2.3". "Unreleased" headings do not match.
+var changelogHeadingRe = regexp.MustCompile(
+	`(?i)^#{1,6}\s+(?:version\s+|release\s+)?\[?v?(\d+\.\d+(?:\.\d+)?(?:-[0-9A-Za-z.-]+)?(?:\+[0-9A-Za-z.-]+)?)\]?(?:\s|$)`)
+
+// ReadVersionFromChangelog returns the topmost released version from the
+// project changelog, skipping "Unreleased" sections
+func ReadVersionFromChangelog(projectPath string) (string, bool) {
+	version, _, ok := readChangelogVersion(projectPath)
+	return version, ok
+}
+
+// ApplyChangelogVersion fills in an empty Version from the changelog. It
+// is a no-op when a version is already known or no changelog entry is
+// found. Returns true when the version was set.
+func ApplyChangelogVersion(projectPath string, metadata *ProjectMetadata) bool {
+	if metadata == nil || metadata.Version != "" {
+		return false
+	}
+
+	version, source, ok := readChangelogVersion(projectPath)
+	if !ok {
+		return false
+	}
+
+	metadata.Version = version
+	metadata.VersionSource = source
+	return true
+}
+
+// readChangelogVersion returns the topmost released version and the
+// changelog file it was read from
+func readChangelogVersion(projectPath string) (string, string, bool) {
+	for _, name := range changelogFiles {
+		file, err := os.Open(filepath.Join(projectPath, name))
+		if err != nil {
+			continue
+		}
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			if m := changelogHeadingRe.FindStringSubmatch(scanner.Text()); m != nil {
+				file.Close()
+				return m[1], name, true
+			}
+		}
+		file.Close()
+	}
+
+	return "", "", false
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestReadVersionFromChangelog tests reading the topmost released version
+func TestReadVersionFromChangelog(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			name: "keep-a-changelog",
+			content: `# Changelog
+
+All notable changes to this project will be documented in this file.
+
+## [Unreleased]
+
+### Added
+- Something new
+
+## [1.2.3] - 2025-01-01
+
+### Fixed
+- A bug
+
+## [1.2.2] - 2024-12-01
+
+[1.2.3]: https://example.com/compare/v1.2.2...v1.2.3
+`,
+			expected: "1.2.3",
+		},
+		{
+			name:     "v-prefixed heading with date",
+			content:  "# Changes\n\n## v2.0.0-rc.1 (2025-03-04)\n\n- Breaking change\n",
+			expected: "2.0.0-rc.1",
+		},
+		{
+			name:     "version keyword heading",
+			content:  "# Version 0.9\n\n- Initial release\n",
+			expected: "0.9",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, "CHANGELOG.md"), []byte(tt.content), 0644); err != nil {
+				t.Fatalf("Failed to write CHANGELOG.md: %v", err)
+			}
+
+			version, ok := ReadVersionFromChangelog(dir)
+			if !ok {
+				t.Fatal("ReadVersionFromChangelog should find a version")
+			}
+			if version != tt.expected {
+				t.Errorf("Version = %v, want %v", version, tt.expected)
+			}
+		})
+	}
+}
+
+// TestReadVersionFromChangelog_UnreleasedOnly tests a changelog without releases
+func TestReadVersionFromChangelog_UnreleasedOnly(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "CHANGES"), []byte("## [Unreleased]\n\n- Work in progress\n"), 0644); err != nil {
+		t.Fatalf("Failed to write CHANGES: %v", err)
+	}
+
+	if version, ok := ReadVersionFromChangelog(dir); ok {
+		t.Errorf("ReadVersionFromChangelog() = %v, want no version", version)
+	}
+}
+
+// TestApplyChangelogVersion tests the changelog version fallback
+func TestApplyChangelogVersion(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "CHANGES.md"), []byte("## 3.1.0\n"), 0644); err != nil {
+		t.Fatalf("Failed to write CHANGES.md: %v", err)
+	}
+
+	metadata := &ProjectMetadata{}
+	if !ApplyChangelogVersion(dir, metadata) {
+		t.Fatal("ApplyChangelogVersion should set the version")
+	}
+	if metadata.Version != "3.1.0" || metadata.VersionSource != "CHANGES.md" {
+		t.Errorf("Metadata = %+v, want version 3.1.0 from CHANGES.md", metadata)
+	}
+
+	existing := &ProjectMetadata{Version: "1.0.0", VersionSource: "VERSION"}
+	if ApplyChangelogVersion(dir, existing) {
+		t.Error("ApplyChangelogVersion should not override an existing version")
+	}
+}
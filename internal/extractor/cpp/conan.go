@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package cpp
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+)
+
+// ConanFile represents parsed conanfile.txt or conanfile.py metadata
+type ConanFile struct {
+	Name     string
+	Version  string
+	Requires []string
+}
+
+var (
+	// Class attributes in conanfile.py, e.g. name = "zlib"
+	conanNameRegex    = regexp.MustCompile(`(?m)^\s*name\s*=\s*["']([^"']+)["']`)
+	conanVersionRegex = regexp.MustCompile(`(?m)^\s*version\s*=\s*["']([^"']+)["']`)
+	// requires = [...], requires = (...) or requires = "a", "b"
+	conanRequiresRegex = regexp.MustCompile(`(?ms)^\s*requires\s*=\s*(\[[^\]]*\]|\([^)]*\)|[^\n]+)`)
+	// self.requires("fmt/10.2.1") inside requirements()
+	conanSelfRequiresRegex = regexp.MustCompile(`self\.requires\s*\(\s*["']([^"']+)["']`)
+	conanQuotedRegex       = regexp.MustCompile(`["']([^"']+)["']`)
+)
+
+// findConanFile returns the conanfile in projectPath, preferring
+// conanfile.py, or an empty string when there is none
+func findConanFile(projectPath string) string {
+	for _, name := range []string{"conanfile.py", "conanfile.txt"} {
+		if extractor.FileExists(projectPath, name) {
+			return filepath.Join(projectPath, name)
+		}
+	}
+	return ""
+}
+
+// applyConanFile records Conan dependencies alongside the build system
+// metadata. Name and version from conanfile.py only fill in values the
+// build system did not provide.
+func applyConanFile(projectPath string, metadata *extractor.ProjectMetadata) {
+	path := findConanFile(projectPath)
+	if path == "" {
+		return
+	}
+
+	var conan *ConanFile
+	var err error
+	if filepath.Base(path) == "conanfile.py" {
+		conan, err = parseConanfilePy(path)
+	} else {
+		conan, err = parseConanfileTxt(path)
+	}
+	if err != nil {
+		return
+	}
+
+	metadata.LanguageSpecific["package_manager"] = "conan"
+	metadata.LanguageSpecific["conan_file"] = filepath.Base(path)
+	metadata.LanguageSpecific["conan_dependencies"] = conan.Requires
+	metadata.LanguageSpecific["conan_dependency_count"] = len(conan.Requires)
+
+	if metadata.Name == "" && conan.Name != "" {
+		metadata.Name = conan.Name
+	}
+	if metadata.Version == "" && conan.Version != "" {
+		metadata.Version = conan.Version
+		metadata.VersionSource = "conanfile.py"
+	}
+}
+
+// parseConanfileTxt reads the [requires] section of a conanfile.txt
+func parseConanfileTxt(path string) (*ConanFile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	conan := &ConanFile{Requires: []string{}}
+	inRequires := false
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			inRequires = strings.EqualFold(line, "[requires]")
+			continue
+		}
+		if inRequires {
+			// Drop trailing comments, e.g. "zlib/1.3 # compression"
+			if idx := strings.Index(line, "#"); idx >= 0 {
+				line = strings.TrimSpace(line[:idx])
+			}
+			if line != "" {
+				conan.Requires = append(conan.Requires, line)
+			}
+		}
+	}
+
+	return conan, scanner.Err()
+}
+
+// parseConanfilePy extracts name, version and requirements from a
+// conanfile.py recipe without executing it
+func parseConanfilePy(path string) (*ConanFile, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	text := stripPythonComments(string(content))
+
+	conan := &ConanFile{Requires: []string{}}
+	if matches := conanNameRegex.FindStringSubmatch(text); matches != nil {
+		conan.Name = matches[1]
+	}
+	if matches := conanVersionRegex.FindStringSubmatch(text); matches != nil {
+		conan.Version = matches[1]
+	}
+
+	seen := make(map[string]bool)
+	add := func(ref string) {
+		if !seen[ref] {
+			seen[ref] = true
+			conan.Requires = append(conan.Requires, ref)
+		}
+	}
+	if matches := conanRequiresRegex.FindStringSubmatch(text); matches != nil {
+		for _, ref := range conanQuotedRegex.FindAllStringSubmatch(matches[1], -1) {
+			add(ref[1])
+		}
+	}
+	for _, match := range conanSelfRequiresRegex.FindAllStringSubmatch(text, -1) {
+		add(match[1])
+	}
+
+	return conan, nil
+}
+
+// stripPythonComments removes full-line "#" comments so commented-out
+// requirements are not reported
+func stripPythonComments(content string) string {
+	var result strings.Builder
+	for _, line := range strings.Split(content, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		result.WriteString(line)
+		result.WriteString("\n")
+	}
+	return result.String()
+}
@@ -8,6 +8,8 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
@@ -47,35 +49,39 @@ type CMakeProject struct {
 // Detect checks if this is a C++ project
 func (e *Extractor) Detect(projectPath string) bool {
 	// Check for CMakeLists.txt
-	if _, err := os.Stat(filepath.Join(projectPath, "CMakeLists.txt")); err == nil {
+	if extractor.FileExists(projectPath, "CMakeLists.txt") {
 		return true
 	}
 
 	// Check for .qmake.conf (Qt qmake)
-	if _, err := os.Stat(filepath.Join(projectPath, ".qmake.conf")); err == nil {
+	if extractor.FileExists(projectPath, ".qmake.conf") {
 		return true
 	}
 
 	// Check for Makefile
-	if _, err := os.Stat(filepath.Join(projectPath, "Makefile")); err == nil {
+	if extractor.FileExists(projectPath, "Makefile") {
 		return true
 	}
 
 	// Check for configure.ac (Autotools)
-	if _, err := os.Stat(filepath.Join(projectPath, "configure.ac")); err == nil {
+	if extractor.FileExists(projectPath, "configure.ac") {
 		return true
 	}
 
 	// Check for meson.build
-	if _, err := os.Stat(filepath.Join(projectPath, "meson.build")); err == nil {
+	if extractor.FileExists(projectPath, "meson.build") {
+		return true
+	}
+
+	// Check for a Conan recipe
+	if findConanFile(projectPath) != "" {
 		return true
 	}
 
 	// Check for common C++ source files
 	patterns := []string{"*.cpp", "*.cc", "*.cxx", "*.hpp", "*.hxx", "*.h"}
 	for _, pattern := range patterns {
-		matches, err := filepath.Glob(filepath.Join(projectPath, pattern))
-		if err == nil && len(matches) > 0 {
+		if len(extractor.GlobFiles(projectPath, pattern)) > 0 {
 			return true
 		}
 	}
@@ -84,8 +90,7 @@ func (e *Extractor) Detect(projectPath string) bool {
 	srcDir := filepath.Join(projectPath, "src")
 	if info, err := os.Stat(srcDir); err == nil && info.IsDir() {
 		for _, pattern := range patterns {
-			matches, err := filepath.Glob(filepath.Join(srcDir, pattern))
-			if err == nil && len(matches) > 0 {
+			if len(extractor.GlobFiles(srcDir, pattern)) > 0 {
 				return true
 			}
 		}
@@ -100,12 +105,29 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 		LanguageSpecific: make(map[string]interface{}),
 	}
 
+	if err := e.extractBuildSystem(projectPath, metadata); err != nil {
+		return nil, err
+	}
+
+	// Conan dependencies sit alongside whichever build system is used
+	applyConanFile(projectPath, metadata)
+
+	return metadata, nil
+}
+
+// extractBuildSystem reads metadata from the first build system manifest
+// found. A manifest that cannot be parsed is skipped, or is an error in
+// strict mode.
+func (e *Extractor) extractBuildSystem(projectPath string, metadata *extractor.ProjectMetadata) error {
 	// Try CMakeLists.txt first
 	cmakePath := filepath.Join(projectPath, "CMakeLists.txt")
 	if _, err := os.Stat(cmakePath); err == nil {
 		if err := e.extractFromCMake(cmakePath, metadata); err == nil {
 			metadata.LanguageSpecific["build_system"] = "CMake"
-			return metadata, nil
+			extractor.RecordManifest(metadata, cmakePath)
+			return nil
+		} else if ferr := extractor.ManifestParseFailure(metadata, "CMakeLists.txt", err); ferr != nil {
+			return ferr
 		}
 	}
 
@@ -114,7 +136,10 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 	if _, err := os.Stat(qmakePath); err == nil {
 		if err := e.extractFromQmake(qmakePath, metadata); err == nil {
 			metadata.LanguageSpecific["build_system"] = "qmake"
-			return metadata, nil
+			extractor.RecordManifest(metadata, qmakePath)
+			return nil
+		} else if ferr := extractor.ManifestParseFailure(metadata, ".qmake.conf", err); ferr != nil {
+			return ferr
 		}
 	}
 
@@ -123,7 +148,10 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 	if _, err := os.Stat(mesonPath); err == nil {
 		if err := e.extractFromMeson(mesonPath, metadata); err == nil {
 			metadata.LanguageSpecific["build_system"] = "Meson"
-			return metadata, nil
+			extractor.RecordManifest(metadata, mesonPath)
+			return nil
+		} else if ferr := extractor.ManifestParseFailure(metadata, "meson.build", err); ferr != nil {
+			return ferr
 		}
 	}
 
@@ -132,13 +160,20 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 	if _, err := os.Stat(configurePath); err == nil {
 		if err := e.extractFromAutotools(configurePath, metadata); err == nil {
 			metadata.LanguageSpecific["build_system"] = "Autotools"
-			return metadata, nil
+			extractor.RecordManifest(metadata, configurePath)
+			return nil
+		} else if ferr := extractor.ManifestParseFailure(metadata, "configure.ac", err); ferr != nil {
+			return ferr
 		}
 	}
 
 	// Fallback to basic detection
 	metadata.LanguageSpecific["build_system"] = "Makefile"
-	return metadata, nil
+	makefilePath := filepath.Join(projectPath, "Makefile")
+	if err := extractFromMakefile(makefilePath, metadata); err == nil {
+		extractor.RecordManifest(metadata, makefilePath)
+	}
+	return nil
 }
 
 // extractFromCMake parses CMakeLists.txt
@@ -155,13 +190,9 @@ func (e *Extractor) extractFromCMake(path string, metadata *extractor.ProjectMet
 	projectRegex := regexp.MustCompile(`(?i)project\s*\(\s*([^\s)]+)(?:\s+VERSION\s+([0-9.]+))?(?:\s+DESCRIPTION\s+"([^"]+)")?`)
 	cxxStandardRegex := regexp.MustCompile(`(?i)set\s*\(\s*CMAKE_CXX_STANDARD\s+(\d+)\s*\)`)
 	cStandardRegex := regexp.MustCompile(`(?i)set\s*\(\s*CMAKE_C_STANDARD\s+(\d+)\s*\)`)
-	addExecutableRegex := regexp.MustCompile(`(?i)add_executable\s*\(\s*([^\s)]+)`)
-	addLibraryRegex := regexp.MustCompile(`(?i)add_library\s*\(\s*([^\s)]+)`)
-	findPackageRegex := regexp.MustCompile(`(?i)find_package\s*\(\s*([^\s)]+)`)
 
-	var executables []string
-	var libraries []string
-	var dependencies []string
+	targets := &cmakeTargets{visited: map[string]bool{filepath.Clean(filepath.Dir(path)): true}}
+	var subdirectories []string
 
 	for scanner.Scan() {
 		line := scanner.Text()
@@ -194,19 +225,9 @@ func (e *Extractor) extractFromCMake(path string, metadata *extractor.ProjectMet
 			metadata.LanguageSpecific["c_standard"] = matches[1]
 		}
 
-		// Extract executables
-		if matches := addExecutableRegex.FindStringSubmatch(line); matches != nil {
-			executables = append(executables, matches[1])
-		}
-
-		// Extract libraries
-		if matches := addLibraryRegex.FindStringSubmatch(line); matches != nil {
-			libraries = append(libraries, matches[1])
-		}
-
-		// Extract dependencies
-		if matches := findPackageRegex.FindStringSubmatch(line); matches != nil {
-			dependencies = append(dependencies, matches[1])
+		// Extract executables, libraries, dependencies and test signals
+		if subdir := targets.scanLine(line); subdir != "" {
+			subdirectories = append(subdirectories, subdir)
 		}
 	}
 
@@ -214,21 +235,183 @@ func (e *Extractor) extractFromCMake(path string, metadata *extractor.ProjectMet
 		return err
 	}
 
+	// Targets, dependencies and tests commonly live in subdirectories
+	// pulled in with add_subdirectory(), so follow those as well
+	for _, subdir := range subdirectories {
+		targets.scanSubdirectory(filepath.Join(filepath.Dir(path), subdir), 1)
+	}
+
 	// Store extracted information
-	if len(executables) > 0 {
-		metadata.LanguageSpecific["executables"] = executables
+	if len(targets.executables) > 0 {
+		metadata.LanguageSpecific["executables"] = targets.executables
 	}
-	if len(libraries) > 0 {
-		metadata.LanguageSpecific["libraries"] = libraries
+	if len(targets.libraries) > 0 {
+		metadata.LanguageSpecific["libraries"] = targets.libraries
 	}
-	if len(dependencies) > 0 {
-		metadata.LanguageSpecific["dependencies"] = dependencies
-		metadata.LanguageSpecific["dependency_count"] = len(dependencies)
+	if kind := targets.projectKind(); kind != "" {
+		metadata.LanguageSpecific["project_kind"] = kind
+	}
+	metadata.LanguageSpecific["has_tests"] = targets.hasTests
+	if targets.testFramework != "" {
+		metadata.LanguageSpecific["test_framework"] = targets.testFramework
+	}
+	if len(targets.dependencies) > 0 {
+		metadata.LanguageSpecific["dependencies"] = targets.dependencies
+		metadata.LanguageSpecific["dependency_count"] = len(targets.dependencies)
+	}
+
+	// Fall back to target_compile_features(... cxx_std_NN) when the
+	// standard is not set explicitly
+	if _, ok := metadata.LanguageSpecific["cxx_standard"]; !ok && targets.cxxStandard != "" {
+		metadata.LanguageSpecific["cxx_standard"] = targets.cxxStandard
+	}
+	if _, ok := metadata.LanguageSpecific["c_standard"]; !ok && targets.cStandard != "" {
+		metadata.LanguageSpecific["c_standard"] = targets.cStandard
 	}
 
 	return nil
 }
 
+// maxCMakeSubdirectoryDepth bounds how deep add_subdirectory() is followed
+const maxCMakeSubdirectoryDepth = 4
+
+var (
+	cmakeAddExecutableRegex   = regexp.MustCompile(`(?i)add_executable\s*\(\s*([^\s)]+)`)
+	cmakeAddLibraryRegex      = regexp.MustCompile(`(?i)add_library\s*\(\s*([^\s)]+)`)
+	cmakeAddSubdirectoryRegex = regexp.MustCompile(`(?i)add_subdirectory\s*\(\s*"?([^\s")]+)`)
+	cmakeFindPackageRegex     = regexp.MustCompile(`(?i)find_package\s*\(\s*([^\s)]+)`)
+	cmakeGTestRegex           = regexp.MustCompile(`(?i)find_package\s*\(\s*GTest\b|gtest_discover_tests\s*\(|\bGTest::`)
+	cmakeCatch2Regex          = regexp.MustCompile(`(?i)catch2|catch_discover_tests\s*\(`)
+	cmakeCTestRegex           = regexp.MustCompile(`(?i)enable_testing\s*\(|add_test\s*\(`)
+	cmakeCXXStdFeatureRegex   = regexp.MustCompile(`\bcxx_std_(\d+)\b`)
+	cmakeCStdFeatureRegex     = regexp.MustCompile(`\bc_std_(\d+)\b`)
+)
+
+// cmakeTargets accumulates build targets, find_package() dependencies
+// and test signals across a CMakeLists.txt and the subdirectories it
+// pulls in
+type cmakeTargets struct {
+	executables   []string
+	libraries     []string
+	dependencies  []string
+	testFramework string
+	hasTests      bool
+
+	// Directories already scanned, so add_subdirectory() cycles such as
+	// add_subdirectory(..) are not followed again
+	visited map[string]bool
+
+	// Newest language standards requested via target_compile_features
+	cxxStandard string
+	cStandard   string
+}
+
+// scanLine records targets, dependencies and test signals from a CMake
+// line and returns the directory named by add_subdirectory(), if any
+func (t *cmakeTargets) scanLine(line string) string {
+	if matches := cmakeAddExecutableRegex.FindStringSubmatch(line); matches != nil {
+		t.executables = append(t.executables, matches[1])
+	}
+	if matches := cmakeAddLibraryRegex.FindStringSubmatch(line); matches != nil {
+		t.libraries = append(t.libraries, matches[1])
+	}
+	// The same package is often found in several subdirectories
+	if matches := cmakeFindPackageRegex.FindStringSubmatch(line); matches != nil && !slices.Contains(t.dependencies, matches[1]) {
+		t.dependencies = append(t.dependencies, matches[1])
+	}
+
+	for _, matches := range cmakeCXXStdFeatureRegex.FindAllStringSubmatch(line, -1) {
+		if newerLanguageStandard(matches[1], t.cxxStandard) {
+			t.cxxStandard = matches[1]
+		}
+	}
+	for _, matches := range cmakeCStdFeatureRegex.FindAllStringSubmatch(line, -1) {
+		if newerLanguageStandard(matches[1], t.cStandard) {
+			t.cStandard = matches[1]
+		}
+	}
+
+	// A specific framework wins over plain CTest
+	switch {
+	case cmakeGTestRegex.MatchString(line):
+		t.hasTests = true
+		t.testFramework = "googletest"
+	case cmakeCatch2Regex.MatchString(line):
+		t.hasTests = true
+		if t.testFramework == "" || t.testFramework == "ctest" {
+			t.testFramework = "catch2"
+		}
+	case cmakeCTestRegex.MatchString(line):
+		t.hasTests = true
+		if t.testFramework == "" {
+			t.testFramework = "ctest"
+		}
+	}
+
+	if matches := cmakeAddSubdirectoryRegex.FindStringSubmatch(line); matches != nil && !strings.Contains(matches[1], "${") {
+		return matches[1]
+	}
+	return ""
+}
+
+// scanSubdirectory scans the CMakeLists.txt in dir for targets,
+// dependencies and tests, following nested add_subdirectory() calls up to
+// maxCMakeSubdirectoryDepth. Missing directories are skipped.
+func (t *cmakeTargets) scanSubdirectory(dir string, depth int) {
+	dir = filepath.Clean(dir)
+	if depth > maxCMakeSubdirectoryDepth || t.visited[dir] {
+		return
+	}
+	if t.visited == nil {
+		t.visited = make(map[string]bool)
+	}
+	t.visited[dir] = true
+
+	content, err := os.ReadFile(filepath.Join(dir, "CMakeLists.txt"))
+	if err != nil {
+		return
+	}
+
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		if subdir := t.scanLine(line); subdir != "" {
+			t.scanSubdirectory(filepath.Join(dir, subdir), depth+1)
+		}
+	}
+}
+
+// newerLanguageStandard reports whether the two-digit C/C++ standard a is
+// newer than b, treating 90-99 as last century (cxx_std_98 < cxx_std_11)
+func newerLanguageStandard(a, b string) bool {
+	if b == "" {
+		return true
+	}
+	year := func(standard string) int {
+		n, _ := strconv.Atoi(standard)
+		if n >= 90 {
+			return 1900 + n
+		}
+		return 2000 + n
+	}
+	return year(a) > year(b)
+}
+
+// projectKind summarizes the targets as executable, library or mixed
+func (t *cmakeTargets) projectKind() string {
+	switch {
+	case len(t.executables) > 0 && len(t.libraries) > 0:
+		return "mixed"
+	case len(t.executables) > 0:
+		return "executable"
+	case len(t.libraries) > 0:
+		return "library"
+	}
+	return ""
+}
+
 // extractFromQmake parses .qmake.conf
 func (e *Extractor) extractFromQmake(path string, metadata *extractor.ProjectMetadata) error {
 	file, err := os.Open(path)
@@ -386,10 +569,21 @@ func (e *Extractor) extractFromAutotools(path string, metadata *extractor.Projec
 
 	scanner := bufio.NewScanner(file)
 
-	acInitRegex := regexp.MustCompile(`AC_INIT\s*\(\s*\[?([^\],]+)\]?\s*,\s*\[?([^\],]+)\]?`)
 	pkgCheckRegex := regexp.MustCompile(`PKG_CHECK_MODULES\s*\(\s*\[?[^\],]+\]?\s*,\s*\[?([^\],]+)\]?`)
 
 	var dependencies []string
+	seen := make(map[string]bool)
+	addDependency := func(dep string) {
+		if dep != "" && !seen[dep] {
+			seen[dep] = true
+			dependencies = append(dependencies, dep)
+		}
+	}
+
+	// AC_INIT may span several lines; continuation lines are joined
+	// until the closing paren of the macro invocation
+	var acInit strings.Builder
+	inACInit := false
 
 	for scanner.Scan() {
 		line := scanner.Text()
@@ -399,19 +593,31 @@ func (e *Extractor) extractFromAutotools(path string, metadata *extractor.Projec
 			continue
 		}
 
-		if matches := acInitRegex.FindStringSubmatch(line); matches != nil {
-			metadata.Name = strings.TrimSpace(matches[1])
-			if len(matches) > 2 {
-				metadata.Version = strings.TrimSpace(matches[2])
-				metadata.VersionSource = "configure.ac"
+		if !inACInit {
+			if idx := strings.Index(line, "AC_INIT"); idx >= 0 && metadata.Version == "" {
+				inACInit = true
+				acInit.Reset()
+				line = line[idx:]
+			}
+		}
+		if inACInit {
+			acInit.WriteString(line)
+			acInit.WriteString(" ")
+			if args, complete := parseACInitArgs(acInit.String()); complete {
+				inACInit = false
+				applyACInitArgs(args, metadata)
 			}
+			continue
 		}
 
 		if matches := pkgCheckRegex.FindStringSubmatch(line); matches != nil {
 			dep := strings.TrimSpace(matches[1])
 			// Remove version constraints
 			dep = strings.Split(dep, " ")[0]
-			dependencies = append(dependencies, dep)
+			addDependency(dep)
+		}
+		for _, dep := range autoconfMacroDependencies(line) {
+			addDependency(dep)
 		}
 	}
 
@@ -422,3 +628,140 @@ func (e *Extractor) extractFromAutotools(path string, metadata *extractor.Projec
 
 	return scanner.Err()
 }
+
+var (
+	acCheckLibRegex  = regexp.MustCompile(`AC_CHECK_LIB\s*\(\s*\[?([\w.+-]+)\]?`)
+	amPathMacroRegex = regexp.MustCompile(`\bAM_PATH_([A-Z0-9_]+)\b`)
+	axMacroRegex     = regexp.MustCompile(`\bAX_([A-Z0-9_]+)\b`)
+	amVersionSuffix  = regexp.MustCompile(`_(\d+)_(\d+)$`)
+)
+
+// amPathLibraries maps AM_PATH_* macros whose library name cannot be
+// derived from the macro name alone
+var amPathLibraries = map[string]string{
+	"GTK":       "gtk+",
+	"GTK_2_0":   "gtk+-2.0",
+	"GTK_3_0":   "gtk+-3.0",
+	"XML2":      "libxml-2.0",
+	"LIBGCRYPT": "libgcrypt",
+	"GPG_ERROR": "gpg-error",
+	"GPGME":     "gpgme",
+	"SDL":       "sdl",
+	"SDL2":      "sdl2",
+}
+
+// axLibraries maps autoconf-archive AX_* macros to the library they
+// check for. AX_LIB_<NAME> and AX_BOOST_<COMPONENT> are derived instead.
+var axLibraries = map[string]string{
+	"BOOST_BASE":    "boost",
+	"PTHREAD":       "pthread",
+	"CHECK_OPENSSL": "openssl",
+	"CHECK_ZLIB":    "zlib",
+	"CHECK_GL":      "gl",
+	"CHECK_GLU":     "glu",
+	"CHECK_GLUT":    "glut",
+	"PYTHON_DEVEL":  "python",
+	"PROG_LUA":      "lua",
+	"LUA_HEADERS":   "lua",
+	"LUA_LIBS":      "lua",
+}
+
+// autoconfMacroDependencies returns the libraries named by AC_CHECK_LIB,
+// AM_PATH_* and known AX_* macros on a configure.ac line
+func autoconfMacroDependencies(line string) []string {
+	var deps []string
+
+	for _, match := range acCheckLibRegex.FindAllStringSubmatch(line, -1) {
+		deps = append(deps, match[1])
+	}
+
+	// AM_PATH_GLIB_2_0 -> glib-2.0
+	for _, match := range amPathMacroRegex.FindAllStringSubmatch(line, -1) {
+		name := match[1]
+		if lib, ok := amPathLibraries[name]; ok {
+			deps = append(deps, lib)
+			continue
+		}
+		if name == "PYTHON" || name == "LISPDIR" {
+			continue // interpreters and install paths, not libraries
+		}
+		deps = append(deps, strings.ToLower(amVersionSuffix.ReplaceAllString(name, "-$1.$2")))
+	}
+
+	for _, match := range axMacroRegex.FindAllStringSubmatch(line, -1) {
+		name := match[1]
+		switch {
+		case axLibraries[name] != "":
+			deps = append(deps, axLibraries[name])
+		case strings.HasPrefix(name, "LIB_"):
+			// AX_LIB_SQLITE3 -> sqlite3
+			deps = append(deps, strings.ToLower(strings.TrimPrefix(name, "LIB_")))
+		case strings.HasPrefix(name, "BOOST_"):
+			// AX_BOOST_SYSTEM -> boost_system
+			deps = append(deps, "boost_"+strings.ToLower(strings.TrimPrefix(name, "BOOST_")))
+		}
+	}
+
+	return deps
+}
+
+// parseACInitArgs splits an AC_INIT invocation into its arguments with m4
+// quotes removed. complete is false until the closing paren is seen.
+func parseACInitArgs(invocation string) (args []string, complete bool) {
+	open := strings.Index(invocation, "(")
+	if open < 0 {
+		return nil, false
+	}
+
+	var current strings.Builder
+	quoteDepth := 0
+	parenDepth := 1
+	for _, r := range invocation[open+1:] {
+		switch {
+		case r == '[':
+			quoteDepth++
+			if quoteDepth == 1 {
+				continue
+			}
+		case r == ']':
+			quoteDepth--
+			if quoteDepth == 0 {
+				continue
+			}
+		case r == '(' && quoteDepth == 0:
+			parenDepth++
+		case r == ')' && quoteDepth == 0:
+			parenDepth--
+			if parenDepth == 0 {
+				return append(args, strings.TrimSpace(current.String())), true
+			}
+		case r == ',' && quoteDepth == 0 && parenDepth == 1:
+			args = append(args, strings.TrimSpace(current.String()))
+			current.Reset()
+			continue
+		}
+		current.WriteRune(r)
+	}
+
+	return nil, false
+}
+
+// applyACInitArgs stores AC_INIT(package, version, [bug-report], [tarname], [url])
+func applyACInitArgs(args []string, metadata *extractor.ProjectMetadata) {
+	if len(args) > 0 && args[0] != "" {
+		metadata.Name = args[0]
+	}
+	if len(args) > 1 && args[1] != "" {
+		metadata.Version = args[1]
+		metadata.VersionSource = "configure.ac"
+	}
+	if len(args) > 2 && args[2] != "" {
+		metadata.LanguageSpecific["bug_report"] = args[2]
+	}
+	if len(args) > 3 && args[3] != "" {
+		metadata.LanguageSpecific["tarname"] = args[3]
+	}
+	if len(args) > 4 && args[4] != "" && metadata.Homepage == "" {
+		metadata.Homepage = args[4]
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -158,6 +159,9 @@ add_library(shared SHARED shared.cpp)
 				assert.Len(t, libs, 2)
 				assert.Contains(t, libs, "mylib")
 				assert.Contains(t, libs, "shared")
+				assert.Equal(t, "mixed", ls["project_kind"])
+				assert.Equal(t, false, ls["has_tests"])
+				assert.Nil(t, ls["test_framework"])
 			},
 		},
 		{
@@ -209,6 +213,140 @@ find_package(Qt5 COMPONENTS Core Widgets)
 	}
 }
 
+func TestExtractFromCMake_ProjectKindAndTests(t *testing.T) {
+	tests := []struct {
+		name              string
+		files             map[string]string
+		expectedKind      string
+		expectedHasTests  bool
+		expectedFramework string
+	}{
+		{
+			name: "library with CTest",
+			files: map[string]string{
+				"CMakeLists.txt": "project(Lib)\nadd_library(lib lib.cpp)\nenable_testing()\nadd_test(NAME smoke COMMAND lib_test)\n",
+			},
+			expectedKind:      "library",
+			expectedHasTests:  true,
+			expectedFramework: "ctest",
+		},
+		{
+			name: "executable with GoogleTest",
+			files: map[string]string{
+				"CMakeLists.txt": "project(App)\nadd_executable(app main.cpp)\nenable_testing()\nfind_package(GTest REQUIRED)\n",
+			},
+			expectedKind:      "executable",
+			expectedHasTests:  true,
+			expectedFramework: "googletest",
+		},
+		{
+			name: "targets and Catch2 in subdirectories",
+			files: map[string]string{
+				"CMakeLists.txt":         "project(Nested)\nenable_testing()\nadd_subdirectory(src)\nadd_subdirectory(tests)\n",
+				"src/CMakeLists.txt":     "add_library(core core.cpp)\nadd_subdirectory(cli)\n",
+				"src/cli/CMakeLists.txt": "add_executable(nested-cli main.cpp)\n",
+				"tests/CMakeLists.txt":   "find_package(Catch2 3 REQUIRED)\ncatch_discover_tests(unit)\n",
+			},
+			expectedKind:      "mixed",
+			expectedHasTests:  true,
+			expectedFramework: "catch2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			for path, content := range tt.files {
+				fullPath := filepath.Join(tmpDir, path)
+				require.NoError(t, os.MkdirAll(filepath.Dir(fullPath), 0755))
+				require.NoError(t, os.WriteFile(fullPath, []byte(content), 0644))
+			}
+
+			e := NewExtractor()
+			metadata, err := e.Extract(tmpDir)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedKind, metadata.LanguageSpecific["project_kind"])
+			assert.Equal(t, tt.expectedHasTests, metadata.LanguageSpecific["has_tests"])
+			assert.Equal(t, tt.expectedFramework, metadata.LanguageSpecific["test_framework"])
+		})
+	}
+}
+
+func TestExtractFromCMake_Subdirectories(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"CMakeLists.txt":         "project(Tree VERSION 1.0.0)\nfind_package(Threads REQUIRED)\nadd_subdirectory(src)\nadd_subdirectory(missing)\n",
+		"src/CMakeLists.txt":     "find_package(Threads REQUIRED)\nfind_package(fmt CONFIG REQUIRED)\nadd_executable(tree main.cpp)\nadd_subdirectory(lib)\n",
+		"src/lib/CMakeLists.txt": "add_library(treecore core.cpp)\n# A cycle back to the parent directory is not followed again\nadd_subdirectory(..)\n",
+	}
+	for path, content := range files {
+		fullPath := filepath.Join(tmpDir, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(fullPath), 0755))
+		require.NoError(t, os.WriteFile(fullPath, []byte(content), 0644))
+	}
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"tree"}, metadata.LanguageSpecific["executables"])
+	assert.Equal(t, []string{"treecore"}, metadata.LanguageSpecific["libraries"])
+	assert.Equal(t, []string{"Threads", "fmt"}, metadata.LanguageSpecific["dependencies"])
+	assert.Equal(t, 2, metadata.LanguageSpecific["dependency_count"])
+	assert.Equal(t, "mixed", metadata.LanguageSpecific["project_kind"])
+}
+
+func TestExtractFromCMake_CompileFeatures(t *testing.T) {
+	tests := []struct {
+		name        string
+		files       map[string]string
+		expectedCXX interface{}
+		expectedC   interface{}
+	}{
+		{
+			name: "target_compile_features",
+			files: map[string]string{
+				"CMakeLists.txt": "project(Modern)\nadd_library(core core.cpp)\ntarget_compile_features(core PUBLIC cxx_std_20)\n",
+			},
+			expectedCXX: "20",
+		},
+		{
+			name: "explicit set wins",
+			files: map[string]string{
+				"CMakeLists.txt": "project(Both)\nset(CMAKE_CXX_STANDARD 17)\nadd_library(core core.cpp)\ntarget_compile_features(core PUBLIC cxx_std_20)\n",
+			},
+			expectedCXX: "17",
+		},
+		{
+			name: "newest standard across subdirectories",
+			files: map[string]string{
+				"CMakeLists.txt":     "project(Nested)\nadd_subdirectory(src)\n",
+				"src/CMakeLists.txt": "add_library(legacy legacy.cpp)\ntarget_compile_features(legacy PRIVATE cxx_std_98 c_std_99)\nadd_library(core core.cpp)\ntarget_compile_features(core\n  PUBLIC\n    cxx_std_23\n    c_std_11)\n",
+			},
+			expectedCXX: "23",
+			expectedC:   "11",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			for path, content := range tt.files {
+				fullPath := filepath.Join(tmpDir, path)
+				require.NoError(t, os.MkdirAll(filepath.Dir(fullPath), 0755))
+				require.NoError(t, os.WriteFile(fullPath, []byte(content), 0644))
+			}
+
+			e := NewExtractor()
+			metadata, err := e.Extract(tmpDir)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedCXX, metadata.LanguageSpecific["cxx_standard"])
+			assert.Equal(t, tt.expectedC, metadata.LanguageSpecific["c_standard"])
+		})
+	}
+}
+
 func TestExtractFromMeson(t *testing.T) {
 	mesonContent := `project('myapp', 'cpp',
   version: '1.5.0',
@@ -381,6 +519,68 @@ AC_OUTPUT
 	assert.Contains(t, deps, "libxml-2.0")
 }
 
+func TestExtractFromAutotools_LibraryMacros(t *testing.T) {
+	autotoolsContent := `AC_INIT([netd], [0.9.0])
+AM_INIT_AUTOMAKE([foreign])
+AM_PATH_PYTHON([3.8])
+
+PKG_CHECK_MODULES([GLIB], [glib-2.0 >= 2.56])
+AM_PATH_GLIB_2_0([2.56.0])
+AC_CHECK_LIB([m], [cos])
+AC_CHECK_LIB(pthread, pthread_create)
+AC_CHECK_LIB([m], [sqrt])
+AX_BOOST_BASE([1.70])
+AX_BOOST_SYSTEM
+AX_LIB_SQLITE3([3.0])
+AX_CHECK_COMPILE_FLAG([-Wall])
+dnl AC_CHECK_LIB([ignored], [main])
+
+AC_OUTPUT
+`
+
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "configure.ac"), []byte(autotoolsContent), 0644))
+
+	e := NewExtractor()
+	metadata, err := e.Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"glib-2.0", "m", "pthread", "boost", "boost_system", "sqlite3"},
+		metadata.LanguageSpecific["dependencies"])
+	assert.Equal(t, 6, metadata.LanguageSpecific["dependency_count"])
+}
+
+func TestExtractFromAutotools_MultiLineACInit(t *testing.T) {
+	autotoolsContent := `dnl Process this file with autoconf to produce a configure script.
+AC_PREREQ([2.69])
+AC_INIT([GNU Hello],
+        [2.12.1],
+        [bug-hello@gnu.org],
+        [hello])
+AM_INIT_AUTOMAKE([foreign])
+
+PKG_CHECK_MODULES([GLIB], [glib-2.0])
+
+AC_OUTPUT
+`
+
+	tmpDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmpDir, "configure.ac"), []byte(autotoolsContent), 0644)
+	require.NoError(t, err)
+
+	e := NewExtractor()
+	metadata, err := e.Extract(tmpDir)
+	require.NoError(t, err)
+	require.NotNil(t, metadata)
+
+	assert.Equal(t, "GNU Hello", metadata.Name)
+	assert.Equal(t, "2.12.1", metadata.Version)
+	assert.Equal(t, "configure.ac", metadata.VersionSource)
+	assert.Equal(t, "bug-hello@gnu.org", metadata.LanguageSpecific["bug_report"])
+	assert.Equal(t, "hello", metadata.LanguageSpecific["tarname"])
+	assert.Equal(t, []string{"glib-2.0"}, metadata.LanguageSpecific["dependencies"])
+}
+
 func TestExtractFromQmake(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -449,3 +649,165 @@ func TestExtractNoBuildSystem(t *testing.T) {
 	// Should fall back to Makefile
 	assert.Equal(t, "Makefile", metadata.LanguageSpecific["build_system"])
 }
+
+func TestExtractConanfileTxt(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	cmake := "project(app VERSION 2.1.0)\n"
+	conanfile := `[requires]
+zlib/1.3.1
+fmt/10.2.1 # formatting
+# boost/1.84.0
+
+[generators]
+CMakeDeps
+CMakeToolchain
+`
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "CMakeLists.txt"), []byte(cmake), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "conanfile.txt"), []byte(conanfile), 0644))
+
+	e := NewExtractor()
+	metadata, err := e.Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "app", metadata.Name)
+	assert.Equal(t, "2.1.0", metadata.Version)
+	assert.Equal(t, "CMake", metadata.LanguageSpecific["build_system"])
+	assert.Equal(t, "conan", metadata.LanguageSpecific["package_manager"])
+	assert.Equal(t, "conanfile.txt", metadata.LanguageSpecific["conan_file"])
+	assert.Equal(t, []string{"zlib/1.3.1", "fmt/10.2.1"}, metadata.LanguageSpecific["conan_dependencies"])
+	assert.Equal(t, 2, metadata.LanguageSpecific["conan_dependency_count"])
+}
+
+func TestExtractConanfilePy(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	conanfile := `from conan import ConanFile
+
+class HelloConan(ConanFile):
+    name = "hello"
+    version = "0.4.0"
+    settings = "os", "compiler", "build_type", "arch"
+    requires = [
+        "zlib/1.3.1",
+        "openssl/3.2.1",
+    ]
+    # requires = "legacy/1.0"
+
+    def requirements(self):
+        self.requires("fmt/10.2.1")
+        self.requires("zlib/1.3.1")
+`
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "conanfile.py"), []byte(conanfile), 0644))
+
+	e := NewExtractor()
+	assert.True(t, e.Detect(tmpDir))
+
+	metadata, err := e.Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "hello", metadata.Name)
+	assert.Equal(t, "0.4.0", metadata.Version)
+	assert.Equal(t, "conanfile.py", metadata.VersionSource)
+	assert.Equal(t, "conan", metadata.LanguageSpecific["package_manager"])
+	assert.Equal(t, []string{"zlib/1.3.1", "openssl/3.2.1", "fmt/10.2.1"}, metadata.LanguageSpecific["conan_dependencies"])
+	assert.Equal(t, 3, metadata.LanguageSpecific["conan_dependency_count"])
+}
+
+func TestParseConanfilePy_TupleRequires(t *testing.T) {
+	tmpDir := t.TempDir()
+	path := filepath.Join(tmpDir, "conanfile.py")
+	content := "class App(ConanFile):\n    requires = \"zlib/1.3.1\", \"fmt/10.2.1\"\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+
+	conan, err := parseConanfilePy(path)
+	require.NoError(t, err)
+	assert.Empty(t, conan.Name)
+	assert.Equal(t, []string{"zlib/1.3.1", "fmt/10.2.1"}, conan.Requires)
+}
+
+func TestExtractFromMakefile(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		cxxStandard interface{}
+		cStandard   interface{}
+		executables interface{}
+	}{
+		{
+			name: "CXXFLAGS with TARGET",
+			content: `CXX = g++
+CXXFLAGS = -Wall -O2 -std=c++20
+TARGET := server
+
+all: $(TARGET)
+
+$(TARGET): main.o
+	$(CXX) $(CXXFLAGS) -o $@ $^
+`,
+			cxxStandard: "20",
+			executables: []string{"server"},
+		},
+		{
+			name: "CFLAGS with all rule",
+			content: `# -std=c++17 in a comment is ignored
+CFLAGS += -std=gnu11 -pedantic
+
+all: hello
+
+hello: hello.c
+	$(CC) $(CFLAGS) -o hello hello.c
+`,
+			cStandard:   "11",
+			executables: []string{"hello"},
+		},
+		{
+			name: "provisional standard name",
+			content: `CXXFLAGS ?= -std=c++2a
+BIN = tool
+`,
+			cxxStandard: "20",
+			executables: []string{"tool"},
+		},
+		{
+			name: "unexpanded prerequisite",
+			content: `all: $(PROGRAMS)
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "Makefile"), []byte(tt.content), 0644))
+
+			metadata, err := NewExtractor().Extract(tmpDir)
+			require.NoError(t, err)
+
+			assert.Equal(t, "Makefile", metadata.LanguageSpecific["build_system"])
+			assert.Equal(t, tt.cxxStandard, metadata.LanguageSpecific["cxx_standard"])
+			assert.Equal(t, tt.cStandard, metadata.LanguageSpecific["c_standard"])
+			assert.Equal(t, tt.executables, metadata.LanguageSpecific["executables"])
+		})
+	}
+}
+
+func TestExtract_UnreadableCMakeStrictMode(t *testing.T) {
+	// A directory named CMakeLists.txt is detected but cannot be read
+	tmpDir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(tmpDir, "CMakeLists.txt"), 0755))
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, "Makefile", metadata.LanguageSpecific["build_system"])
+	require.Len(t, metadata.Warnings, 1)
+	assert.Contains(t, metadata.Warnings[0], "failed to parse CMakeLists.txt")
+
+	extractor.SetStrictMode(true)
+	t.Cleanup(func() { extractor.SetStrictMode(false) })
+
+	_, err = NewExtractor().Extract(tmpDir)
+	var parseErr *extractor.ManifestParseError
+	require.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, "CMakeLists.txt", parseErr.Manifest)
+}
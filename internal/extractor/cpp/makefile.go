@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package cpp
+
+import (
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+)
+
+var (
+	// -std=c++17, -std=gnu++20 or -std=c++2a
+	makeCxxStdRegex = regexp.MustCompile(`-std=(?:c|gnu)\+\+(\w+)`)
+	// -std=c11 or -std=gnu99
+	makeCStdRegex = regexp.MustCompile(`-std=(?:c|gnu)(\d+)\b`)
+	// TARGET = app, BIN := app, TARGET ?= app
+	makeTargetVarRegex = regexp.MustCompile(`^(TARGET|BIN)\s*[:?]?=\s*(\S+)`)
+	// all: app other
+	makeAllRuleRegex = regexp.MustCompile(`^all\s*:\s*(\S+)`)
+)
+
+// makeStandardAliases maps the provisional names compilers accept before
+// a standard is published to its final number
+var makeStandardAliases = map[string]string{
+	"0x": "11",
+	"1y": "14",
+	"1z": "17",
+	"2a": "20",
+	"2b": "23",
+	"2c": "26",
+}
+
+// extractFromMakefile reads the C/C++ standards from -std= compile flags
+// and the executable from TARGET, BIN or the first prerequisite of the
+// all rule
+func extractFromMakefile(path string, metadata *extractor.ProjectMetadata) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	variables := make(map[string]string)
+	var allTarget string
+	for _, line := range strings.Split(string(content), "\n") {
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if matches := makeCxxStdRegex.FindStringSubmatch(line); matches != nil {
+			if _, ok := metadata.LanguageSpecific["cxx_standard"]; !ok {
+				metadata.LanguageSpecific["cxx_standard"] = normalizeMakeStandard(matches[1])
+			}
+		}
+		if matches := makeCStdRegex.FindStringSubmatch(line); matches != nil {
+			if _, ok := metadata.LanguageSpecific["c_standard"]; !ok {
+				metadata.LanguageSpecific["c_standard"] = normalizeMakeStandard(matches[1])
+			}
+		}
+
+		if matches := makeTargetVarRegex.FindStringSubmatch(line); matches != nil {
+			if _, ok := variables[matches[1]]; !ok {
+				variables[matches[1]] = matches[2]
+			}
+		}
+		if matches := makeAllRuleRegex.FindStringSubmatch(line); matches != nil && allTarget == "" {
+			allTarget = matches[1]
+		}
+	}
+
+	executable := variables["TARGET"]
+	if executable == "" {
+		executable = variables["BIN"]
+	}
+	if executable == "" {
+		executable = allTarget
+	}
+	// Prerequisites naming other variables, e.g. all: $(PROGRAMS), are
+	// not expanded
+	if executable != "" && !strings.Contains(executable, "$") {
+		metadata.LanguageSpecific["executables"] = []string{executable}
+	}
+
+	return nil
+}
+
+// normalizeMakeStandard maps a -std= suffix such as "2a" to "20"
+func normalizeMakeStandard(standard string) string {
+	if alias, ok := makeStandardAliases[standard]; ok {
+		return alias
+	}
+	return standard
+}
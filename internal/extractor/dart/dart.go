@@ -112,6 +112,7 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 	if err := e.extractFromPubspec(pubspecPath, metadata); err != nil {
 		return nil, err
 	}
+	extractor.RecordManifest(metadata, pubspecPath)
 
 	return metadata, nil
 }
@@ -125,7 +126,7 @@ func (e *Extractor) extractFromPubspec(path string, metadata *extractor.ProjectM
 
 	var pubspec PubspecYAML
 	if err := yaml.Unmarshal(content, &pubspec); err != nil {
-		return fmt.Errorf("failed to parse pubspec.yaml: %w", err)
+		return &extractor.ManifestParseError{Manifest: "pubspec.yaml", Err: err}
 	}
 
 	// Extract common metadata
@@ -140,10 +141,11 @@ func (e *Extractor) extractFromPubspec(path string, metadata *extractor.ProjectM
 	metadata.LanguageSpecific["package_name"] = pubspec.Name
 	metadata.LanguageSpecific["metadata_source"] = "pubspec.yaml"
 
-	// Detect if this is a Flutter project
-	isFlutter := false
-	if _, hasFlutterDep := pubspec.Dependencies["flutter"]; hasFlutterDep {
-		isFlutter = true
+	// Detect if this is a Flutter project: either a flutter SDK dependency
+	// or a Flutter SDK constraint in the environment section
+	_, hasFlutterDep := pubspec.Dependencies["flutter"]
+	isFlutter := hasFlutterDep || pubspec.Environment.Flutter != ""
+	if isFlutter {
 		metadata.LanguageSpecific["is_flutter"] = true
 		metadata.LanguageSpecific["framework"] = "Flutter"
 	} else {
@@ -154,14 +156,17 @@ func (e *Extractor) extractFromPubspec(path string, metadata *extractor.ProjectM
 	// Extract SDK constraints
 	if pubspec.Environment.SDK != "" {
 		metadata.LanguageSpecific["dart_sdk"] = pubspec.Environment.SDK
+		metadata.LanguageSpecific["sdk_constraint"] = pubspec.Environment.SDK
 
 		// Generate Dart version matrix
 		matrix := generateDartVersionMatrix(pubspec.Environment.SDK)
 		if len(matrix) > 0 {
 			metadata.LanguageSpecific["dart_version_matrix"] = matrix
+			metadata.LanguageSpecific["dart_sdk_matrix"] = matrix
 			matrixJSON := fmt.Sprintf(`{"dart-version": [%s]}`,
 				strings.Join(quoteStrings(matrix), ", "))
 			metadata.LanguageSpecific["matrix_json"] = matrixJSON
+			extractor.ApplyOSMatrix(metadata, "dart-version", matrix)
 		}
 	}
 
@@ -353,8 +358,7 @@ func (e *Extractor) extractFlutterMetadata(pubspec *PubspecYAML, metadata *extra
 // Detect checks if this extractor can handle the project
 func (e *Extractor) Detect(projectPath string) bool {
 	// Check for pubspec.yaml
-	pubspecPath := filepath.Join(projectPath, "pubspec.yaml")
-	if _, err := os.Stat(pubspecPath); err == nil {
+	if extractor.FileExists(projectPath, "pubspec.yaml") {
 		return true
 	}
 
@@ -246,6 +246,31 @@ environment:
 	assert.Contains(t, matrixJSON, "3.1")
 }
 
+func TestExtractor_Extract_SDKConstraint(t *testing.T) {
+	dir := t.TempDir()
+	pubspecPath := filepath.Join(dir, "pubspec.yaml")
+
+	pubspecContent := `name: flutter_env_only
+version: 1.0.0
+
+environment:
+  sdk: '>=3.2.0 <4.0.0'
+  flutter: '>=3.16.0'
+`
+
+	err := os.WriteFile(pubspecPath, []byte(pubspecContent), 0644)
+	require.NoError(t, err)
+
+	e := NewExtractor()
+	metadata, err := e.Extract(dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, ">=3.2.0 <4.0.0", metadata.LanguageSpecific["sdk_constraint"])
+	assert.Equal(t, true, metadata.LanguageSpecific["is_flutter"])
+	assert.Equal(t, ">=3.16.0", metadata.LanguageSpecific["flutter_sdk"])
+	assert.Equal(t, []string{"3.2", "3.3"}, metadata.LanguageSpecific["dart_sdk_matrix"])
+}
+
 func TestExtractor_Extract_FlutterPlugin(t *testing.T) {
 	dir := t.TempDir()
 	pubspecPath := filepath.Join(dir, "pubspec.yaml")
@@ -537,7 +537,7 @@ func extractVersionFromProject(projectPath string) string {
 // Detect checks if this extractor can handle the project
 func (e *Extractor) Detect(projectPath string) bool {
 	// Check for go.mod
-	if _, err := os.Stat(filepath.Join(projectPath, "go.mod")); err == nil {
+	if extractor.FileExists(projectPath, "go.mod") {
 		return true
 	}
 
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// readDir lists a directory; tests replace it to count listings
+var readDir = os.ReadDir
+
+// dirCache memoizes directory listings for the duration of a registry
+// scan, so that the Detect methods of every registered extractor share a
+// single os.ReadDir per directory instead of each running its own
+// os.Stat and filepath.Glob calls
+type dirCache struct {
+	mu       sync.Mutex
+	listings map[string][]string // Sorted entry names; nil when unreadable
+}
+
+var (
+	scanMu     sync.Mutex
+	scanDepth  int
+	activeScan *dirCache
+)
+
+// beginScan enables the directory cache until the matching endScan.
+// Nested and concurrent scans share one cache.
+func beginScan() {
+	scanMu.Lock()
+	defer scanMu.Unlock()
+	if scanDepth == 0 {
+		activeScan = &dirCache{listings: make(map[string][]string)}
+	}
+	scanDepth++
+}
+
+// endScan discards the directory cache once the last scan has finished,
+// so that later lookups see files created since
+func endScan() {
+	scanMu.Lock()
+	defer scanMu.Unlock()
+	scanDepth--
+	if scanDepth == 0 {
+		activeScan = nil
+	}
+}
+
+// currentScan returns the active directory cache, or nil outside a scan
+func currentScan() *dirCache {
+	scanMu.Lock()
+	defer scanMu.Unlock()
+	return activeScan
+}
+
+// names returns the sorted entry names of dir, listing it on first use
+func (c *dirCache) names(dir string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if names, ok := c.listings[dir]; ok {
+		return names
+	}
+
+	var names []string
+	if entries, err := readDir(dir); err == nil {
+		names = make([]string, 0, len(entries))
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+	}
+	c.listings[dir] = names
+	return names
+}
+
+// FileExists reports whether name, which may contain a path separator,
+// exists below dir. Extractors should use it in Detect so that registry
+// scans can answer from a cached directory listing.
+func FileExists(dir, name string) bool {
+	fullPath := filepath.Join(dir, name)
+
+	cache := currentScan()
+	if cache == nil {
+		_, err := os.Stat(fullPath)
+		return err == nil
+	}
+
+	base := filepath.Base(fullPath)
+	for _, entry := range cache.names(filepath.Dir(fullPath)) {
+		if entry == base {
+			return true
+		}
+	}
+	return false
+}
+
+// GlobFiles returns the paths of the entries in dir whose names match
+// pattern, in filepath.Match syntax, sorted as filepath.Glob would. Like
+// FileExists it uses the cached directory listing during registry scans.
+func GlobFiles(dir, pattern string) []string {
+	cache := currentScan()
+	if cache == nil {
+		matches, _ := filepath.Glob(filepath.Join(dir, pattern))
+		return matches
+	}
+
+	var matches []string
+	for _, entry := range cache.names(filepath.Clean(dir)) {
+		if matched, err := filepath.Match(pattern, entry); err == nil && matched {
+			matches = append(matches, filepath.Join(dir, entry))
+		}
+	}
+	return matches
+}
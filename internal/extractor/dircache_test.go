@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// listingExtractor detects directories through FileExists and GlobFiles,
+// as the language extractors do
+type listingExtractor struct {
+	BaseExtractor
+	manifest string
+	pattern  string
+}
+
+func (l *listingExtractor) Extract(projectPath string) (*ProjectMetadata, error) {
+	return &ProjectMetadata{}, nil
+}
+
+func (l *listingExtractor) Detect(projectPath string) bool {
+	if l.pattern != "" {
+		return len(GlobFiles(projectPath, l.pattern)) > 0
+	}
+	return FileExists(projectPath, l.manifest)
+}
+
+// writeTree creates files below root, failing the test or benchmark on error
+func writeTree(tb testing.TB, root string, files []string) {
+	tb.Helper()
+	for _, file := range files {
+		path := filepath.Join(root, file)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			tb.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte("{}"), 0644); err != nil {
+			tb.Fatalf("Failed to write %s: %v", file, err)
+		}
+	}
+}
+
+// listingRegistry returns a registry of count listing extractors, the
+// last two matching go.mod and *.csproj
+func listingRegistry(count int) *Registry {
+	registry := NewRegistry()
+	for i := 0; i < count-2; i++ {
+		registry.Register(&listingExtractor{
+			BaseExtractor: NewBaseExtractor(fmt.Sprintf("absent-%02d", i), 3),
+			manifest:      fmt.Sprintf("absent-%02d.toml", i),
+		})
+	}
+	registry.Register(&listingExtractor{BaseExtractor: NewBaseExtractor("go-module", 2), manifest: "go.mod"})
+	registry.Register(&listingExtractor{BaseExtractor: NewBaseExtractor("dotnet", 1), pattern: "*.csproj"})
+	return registry
+}
+
+// TestRegistryDetectAll_DirCache tests that a scan lists each directory
+// once and finds the same projects as uncached detection
+func TestRegistryDetectAll_DirCache(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, []string{
+		"services/api/go.mod",
+		"services/billing/Billing.csproj",
+		"tools/gen/go.mod",
+		"docs/README.md",
+	})
+	registry := listingRegistry(12)
+
+	listings := 0
+	readDir = func(dir string) ([]os.DirEntry, error) {
+		listings++
+		return os.ReadDir(dir)
+	}
+	defer func() { readDir = os.ReadDir }()
+
+	got := registry.DetectAll(root, DefaultSubprojectDepth)
+	want := []DetectedProject{
+		{Path: "services/api", Extractor: "go-module"},
+		{Path: "services/billing", Extractor: "dotnet"},
+		{Path: "tools/gen", Extractor: "go-module"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DetectAll() = %+v, want %+v", got, want)
+	}
+
+	// services, services/api, services/billing, tools, tools/gen and docs
+	if listings != 6 {
+		t.Errorf("DetectAll() listed directories %d times, want 6", listings)
+	}
+
+	// Outside a scan the helpers go to the file system directly, so new
+	// files are seen immediately
+	listings = 0
+	writeTree(t, root, []string{"docs/go.mod"})
+	if !FileExists(filepath.Join(root, "docs"), "go.mod") {
+		t.Error("FileExists() outside a scan should see a new file")
+	}
+	if matches := GlobFiles(filepath.Join(root, "services", "billing"), "*.csproj"); len(matches) != 1 {
+		t.Errorf("GlobFiles() outside a scan = %v, want one match", matches)
+	}
+	if listings != 0 {
+		t.Errorf("Lookups outside a scan used the cache %d times", listings)
+	}
+}
+
+// TestDirCache_MatchesFileSystem tests cached lookups against os.Stat and
+// filepath.Glob
+func TestDirCache_MatchesFileSystem(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, []string{"go.mod", "a.csproj", "b.csproj", "project/build.properties"})
+
+	checks := func() []interface{} {
+		return []interface{}{
+			FileExists(root, "go.mod"),
+			FileExists(root, "missing.toml"),
+			FileExists(root, filepath.Join("project", "build.properties")),
+			FileExists(filepath.Join(root, "nonexistent"), "go.mod"),
+			GlobFiles(root, "*.csproj"),
+			GlobFiles(root, "*.sln"),
+		}
+	}
+
+	uncached := checks()
+	beginScan()
+	cached := checks()
+	endScan()
+
+	if !reflect.DeepEqual(cached, uncached) {
+		t.Errorf("Cached lookups = %v, want %v", cached, uncached)
+	}
+}
+
+// BenchmarkRegistryDetectAll scans a monorepo-like tree with as many
+// extractors as the action registers
+func BenchmarkRegistryDetectAll(b *testing.B) {
+	root := b.TempDir()
+	var files []string
+	for i := 0; i < 20; i++ {
+		files = append(files,
+			fmt.Sprintf("services/svc%02d/go.mod", i),
+			fmt.Sprintf("services/svc%02d/internal/handler.go", i),
+			fmt.Sprintf("libs/lib%02d/Lib.csproj", i))
+	}
+	writeTree(b, root, files)
+	registry := listingRegistry(25)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		registry.DetectAll(root, DefaultSubprojectDepth)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
@@ -19,10 +20,12 @@ type Extractor struct {
 	extractor.BaseExtractor
 }
 
-// NewExtractor creates a new Docker extractor
+// NewExtractor creates a new Docker extractor. It is registered at the
+// lowest priority so that language projects that also ship a Dockerfile
+// are still reported as their language.
 func NewExtractor() *Extractor {
 	return &Extractor{
-		BaseExtractor: extractor.NewBaseExtractor("docker", 1),
+		BaseExtractor: extractor.NewBaseExtractor("docker", 0),
 	}
 }
 
@@ -41,17 +44,27 @@ type DockerfileMetadata struct {
 	HealthCheck  string
 	Stages       []string
 	CopyFrom     []string
+
+	// StageImages maps each named stage to the image it is built FROM
+	StageImages map[string]string
+
+	// UnresolvedLabels maps labels whose value references a build
+	// argument without a default to the names of those arguments
+	UnresolvedLabels map[string][]string
 }
 
+// argReferenceRegex matches $NAME and ${NAME} variable references
+var argReferenceRegex = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}|\$([A-Za-z_][A-Za-z0-9_]*)`)
+
 // Extract retrieves metadata from a Docker project
 func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, error) {
 	metadata := &extractor.ProjectMetadata{
 		LanguageSpecific: make(map[string]interface{}),
 	}
 
-	// Look for Dockerfile
-	dockerfilePath := filepath.Join(projectPath, "Dockerfile")
-	if _, err := os.Stat(dockerfilePath); err != nil {
+	// Look for Dockerfile or *.dockerfile
+	dockerfilePath := findDockerfile(projectPath)
+	if dockerfilePath == "" {
 		return nil, fmt.Errorf("Dockerfile not found in %s", projectPath)
 	}
 
@@ -61,6 +74,8 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 	}
 
 	e.populateMetadata(dockerMeta, metadata, projectPath)
+	metadata.LanguageSpecific["metadata_source"] = filepath.Base(dockerfilePath)
+	extractor.RecordManifest(metadata, dockerfilePath)
 
 	return metadata, nil
 }
@@ -84,6 +99,7 @@ func (e *Extractor) parseDockerfile(path string) (*DockerfileMetadata, error) {
 		Args:         make(map[string]string),
 		Stages:       make([]string, 0),
 		CopyFrom:     make([]string, 0),
+		StageImages:  make(map[string]string),
 	}
 
 	scanner := bufio.NewScanner(file)
@@ -185,8 +201,11 @@ func (e *Extractor) parseInstruction(line string, meta *DockerfileMetadata) {
 
 // parseFrom extracts base image and stage information
 func (e *Extractor) parseFrom(args string, meta *DockerfileMetadata) {
-	// Pattern: FROM image[:tag] [AS stage]
+	// Pattern: FROM [--platform=<platform>] image[:tag] [AS stage]
 	parts := strings.Fields(args)
+	for len(parts) > 0 && strings.HasPrefix(parts[0], "--") {
+		parts = parts[1:]
+	}
 	if len(parts) > 0 {
 		baseImage := parts[0]
 		meta.BaseImages = append(meta.BaseImages, baseImage)
@@ -195,6 +214,7 @@ func (e *Extractor) parseFrom(args string, meta *DockerfileMetadata) {
 		for i, part := range parts {
 			if strings.ToUpper(part) == "AS" && i+1 < len(parts) {
 				meta.Stages = append(meta.Stages, parts[i+1])
+				meta.StageImages[strings.ToLower(parts[i+1])] = baseImage
 				break
 			}
 		}
@@ -226,11 +246,46 @@ func (e *Extractor) parseLabel(args string, meta *DockerfileMetadata) {
 		}
 
 		if key != "" {
-			meta.Labels[key] = value
+			resolved, unresolved := resolveArgReferences(value, meta)
+			meta.Labels[key] = resolved
+			if len(unresolved) > 0 {
+				if meta.UnresolvedLabels == nil {
+					meta.UnresolvedLabels = make(map[string][]string)
+				}
+				meta.UnresolvedLabels[key] = unresolved
+			} else {
+				delete(meta.UnresolvedLabels, key)
+			}
 		}
 	}
 }
 
+// resolveArgReferences substitutes $NAME and ${NAME} in a LABEL value
+// with the values of ENV or ARG instructions declared earlier in the
+// Dockerfile. As in Docker, an ENV takes precedence over an ARG of the
+// same name. References without a known value are left as written and
+// returned so they can be flagged.
+func resolveArgReferences(value string, meta *DockerfileMetadata) (string, []string) {
+	var unresolved []string
+	resolved := argReferenceRegex.ReplaceAllStringFunc(value, func(ref string) string {
+		match := argReferenceRegex.FindStringSubmatch(ref)
+		name := match[1]
+		if name == "" {
+			name = match[2]
+		}
+
+		if env, ok := meta.Env[name]; ok && !argReferenceRegex.MatchString(env) {
+			return env
+		}
+		if def, ok := meta.Args[name]; ok && def != "" {
+			return strings.Trim(def, `"'`)
+		}
+		unresolved = append(unresolved, name)
+		return ref
+	})
+	return resolved, unresolved
+}
+
 // parseEnv extracts environment variables
 func (e *Extractor) parseEnv(args string, meta *DockerfileMetadata) {
 	// Handle: ENV KEY=value or ENV KEY value
@@ -249,14 +304,14 @@ func (e *Extractor) parseEnv(args string, meta *DockerfileMetadata) {
 				value = match[4]
 			}
 			if key != "" {
-				meta.Env[key] = value
+				meta.Env[key], _ = resolveArgReferences(value, meta)
 			}
 		}
 	} else {
 		// KEY value format (single variable)
 		parts := strings.SplitN(args, " ", 2)
 		if len(parts) == 2 {
-			meta.Env[parts[0]] = strings.Trim(parts[1], `"`)
+			meta.Env[parts[0]], _ = resolveArgReferences(strings.Trim(parts[1], `"`), meta)
 		}
 	}
 }
@@ -308,13 +363,20 @@ func (e *Extractor) populateMetadata(dockerMeta *DockerfileMetadata, metadata *e
 	// Extract name from directory
 	metadata.Name = filepath.Base(projectPath)
 
-	// Extract version from labels
-	if version, ok := dockerMeta.Labels["version"]; ok {
-		metadata.Version = version
-		metadata.VersionSource = "Dockerfile LABEL version"
-	} else if version, ok := dockerMeta.Labels["org.opencontainers.image.version"]; ok {
+	// Extract version from labels, preferring the OCI annotation. Labels
+	// with unresolved references are skipped so the version file, git tag
+	// and changelog fallbacks still apply.
+	for _, label := range []string{"org.opencontainers.image.version", "version"} {
+		version, ok := dockerMeta.Labels[label]
+		if !ok {
+			continue
+		}
+		if _, unresolved := dockerMeta.UnresolvedLabels[label]; unresolved {
+			continue
+		}
 		metadata.Version = version
-		metadata.VersionSource = "Dockerfile LABEL org.opencontainers.image.version"
+		metadata.VersionSource = "Dockerfile LABEL " + label
+		break
 	}
 
 	// Extract description
@@ -358,6 +420,7 @@ func (e *Extractor) populateMetadata(dockerMeta *DockerfileMetadata, metadata *e
 
 	if len(dockerMeta.BaseImages) > 0 {
 		metadata.LanguageSpecific["primary_base_image"] = dockerMeta.BaseImages[0]
+		metadata.LanguageSpecific["final_base_image"] = finalBaseImage(dockerMeta)
 		metadata.LanguageSpecific["base_image_count"] = len(dockerMeta.BaseImages)
 	}
 
@@ -366,6 +429,21 @@ func (e *Extractor) populateMetadata(dockerMeta *DockerfileMetadata, metadata *e
 		metadata.LanguageSpecific["label_count"] = len(dockerMeta.Labels)
 	}
 
+	// Labels that depend on build arguments only known at build time
+	if len(dockerMeta.UnresolvedLabels) > 0 {
+		labels := make([]string, 0, len(dockerMeta.UnresolvedLabels))
+		for label := range dockerMeta.UnresolvedLabels {
+			labels = append(labels, label)
+		}
+		sort.Strings(labels)
+		metadata.LanguageSpecific["unresolved_labels"] = labels
+		for _, label := range labels {
+			metadata.Warnings = append(metadata.Warnings, fmt.Sprintf(
+				"LABEL %s references build argument %s without a default",
+				label, strings.Join(dockerMeta.UnresolvedLabels[label], ", ")))
+		}
+	}
+
 	if len(dockerMeta.ExposedPorts) > 0 {
 		metadata.LanguageSpecific["exposed_ports"] = dockerMeta.ExposedPorts
 	}
@@ -392,10 +470,12 @@ func (e *Extractor) populateMetadata(dockerMeta *DockerfileMetadata, metadata *e
 
 	if len(dockerMeta.Env) > 0 {
 		metadata.LanguageSpecific["env"] = dockerMeta.Env
+		metadata.LanguageSpecific["env_names"] = sortedKeys(dockerMeta.Env)
 	}
 
 	if len(dockerMeta.Args) > 0 {
 		metadata.LanguageSpecific["build_args"] = dockerMeta.Args
+		metadata.LanguageSpecific["arg_names"] = sortedKeys(dockerMeta.Args)
 	}
 
 	if dockerMeta.HealthCheck != "" {
@@ -432,15 +512,47 @@ func (e *Extractor) populateMetadata(dockerMeta *DockerfileMetadata, metadata *e
 	metadata.LanguageSpecific["oci_compliant"] = ociCompliant
 }
 
-// Detect checks if this extractor can handle the project
-func (e *Extractor) Detect(projectPath string) bool {
-	// Check for Dockerfile
-	dockerfilePath := filepath.Join(projectPath, "Dockerfile")
-	if _, err := os.Stat(dockerfilePath); err == nil {
-		return true
+// finalBaseImage returns the image the final stage is built from,
+// following references to earlier named stages
+func finalBaseImage(meta *DockerfileMetadata) string {
+	image := meta.BaseImages[len(meta.BaseImages)-1]
+	for i := 0; i < len(meta.StageImages); i++ {
+		parent, ok := meta.StageImages[strings.ToLower(image)]
+		if !ok {
+			break
+		}
+		image = parent
+	}
+	return image
+}
+
+// sortedKeys returns the keys of m in sorted order
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// findDockerfile returns the path of the project Dockerfile, falling back
+// to the first *.dockerfile, or an empty string when none exists
+func findDockerfile(projectPath string) string {
+	if extractor.FileExists(projectPath, "Dockerfile") {
+		return filepath.Join(projectPath, "Dockerfile")
+	}
+
+	if matches := extractor.GlobFiles(projectPath, "*.dockerfile"); len(matches) > 0 {
+		return matches[0]
 	}
 
-	return false
+	return ""
+}
+
+// Detect checks if this extractor can handle the project
+func (e *Extractor) Detect(projectPath string) bool {
+	return findDockerfile(projectPath) != ""
 }
 
 // init registers the Docker extractor
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -19,7 +20,7 @@ func TestExtractor_Name(t *testing.T) {
 
 func TestExtractor_Priority(t *testing.T) {
 	e := NewExtractor()
-	assert.Equal(t, 1, e.Priority())
+	assert.Equal(t, 0, e.Priority())
 }
 
 func TestExtractor_Detect(t *testing.T) {
@@ -172,6 +173,43 @@ CMD ["node", "dist/index.js"]`
 	baseImageList, ok := baseImages.([]string)
 	require.True(t, ok)
 	assert.Len(t, baseImageList, 2)
+	assert.Equal(t, "node:18-alpine", metadata.LanguageSpecific["final_base_image"])
+}
+
+func TestExtractor_Extract_StageAliasAndNamedDockerfile(t *testing.T) {
+	dir := t.TempDir()
+
+	dockerfileContent := `ARG GO_VERSION=1.22
+FROM --platform=$BUILDPLATFORM golang:${GO_VERSION} AS build
+ENV CGO_ENABLED=0
+RUN go build -o /out/app ./cmd/app
+
+FROM gcr.io/distroless/static:nonroot AS base
+LABEL org.opencontainers.image.version="3.4.5"
+LABEL version="0.0.1"
+
+FROM base
+ARG TARGETARCH
+COPY --from=build /out/app /app
+EXPOSE 8080 9090/udp
+ENTRYPOINT ["/app"]`
+
+	err := os.WriteFile(filepath.Join(dir, "service.dockerfile"), []byte(dockerfileContent), 0644)
+	require.NoError(t, err)
+
+	e := NewExtractor()
+	require.True(t, e.Detect(dir))
+
+	metadata, err := e.Extract(dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "3.4.5", metadata.Version)
+	assert.Equal(t, "service.dockerfile", metadata.LanguageSpecific["metadata_source"])
+	assert.Equal(t, []string{"golang:${GO_VERSION}", "gcr.io/distroless/static:nonroot", "base"}, metadata.LanguageSpecific["base_images"])
+	assert.Equal(t, "gcr.io/distroless/static:nonroot", metadata.LanguageSpecific["final_base_image"])
+	assert.Equal(t, []string{"8080", "9090/udp"}, metadata.LanguageSpecific["exposed_ports"])
+	assert.Equal(t, []string{"GO_VERSION", "TARGETARCH"}, metadata.LanguageSpecific["arg_names"])
+	assert.Equal(t, []string{"CGO_ENABLED"}, metadata.LanguageSpecific["env_names"])
 }
 
 func TestExtractor_Extract_Labels(t *testing.T) {
@@ -204,6 +242,111 @@ LABEL "key.with.dots"="value with spaces"`
 	assert.Equal(t, "custom-value", labelsMap["custom.label"])
 }
 
+func TestExtractor_Extract_LabelArgSubstitution(t *testing.T) {
+	dir := t.TempDir()
+	dockerfileContent := `FROM alpine:3.18
+
+ARG VERSION=1.4.2
+ARG REVISION="abc1234"
+LABEL org.opencontainers.image.version=$VERSION
+LABEL org.opencontainers.image.revision="${REVISION}"
+LABEL org.opencontainers.image.title="app-${VERSION}"`
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Dockerfile"), []byte(dockerfileContent), 0644))
+
+	e := NewExtractor()
+	metadata, err := e.Extract(dir)
+	require.NoError(t, err)
+
+	labels, ok := metadata.LanguageSpecific["labels"].(map[string]string)
+	require.True(t, ok)
+	assert.Equal(t, "1.4.2", labels["org.opencontainers.image.version"])
+	assert.Equal(t, "abc1234", labels["org.opencontainers.image.revision"])
+	assert.Equal(t, "app-1.4.2", labels["org.opencontainers.image.title"])
+
+	assert.Equal(t, "1.4.2", metadata.Version)
+	assert.NotContains(t, metadata.LanguageSpecific, "unresolved_labels")
+	assert.Empty(t, metadata.Warnings)
+}
+
+func TestExtractor_Extract_LabelEnvOverridesArg(t *testing.T) {
+	dir := t.TempDir()
+	dockerfileContent := `FROM alpine:3.18
+
+ARG VERSION=1.4.2
+ARG BUILD=7
+ENV VERSION=2.0.0
+ENV BUILD_ID=build-$BUILD
+LABEL org.opencontainers.image.version=$VERSION
+LABEL build="${BUILD_ID}"`
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Dockerfile"), []byte(dockerfileContent), 0644))
+
+	e := NewExtractor()
+	metadata, err := e.Extract(dir)
+	require.NoError(t, err)
+
+	labels, ok := metadata.LanguageSpecific["labels"].(map[string]string)
+	require.True(t, ok)
+	assert.Equal(t, "2.0.0", labels["org.opencontainers.image.version"])
+	assert.Equal(t, "build-7", labels["build"])
+	assert.Equal(t, "2.0.0", metadata.Version)
+	assert.Empty(t, metadata.Warnings)
+}
+
+func TestExtractor_Extract_LabelArgUnresolved(t *testing.T) {
+	dir := t.TempDir()
+	dockerfileContent := `FROM alpine:3.18
+
+ARG VERSION
+LABEL org.opencontainers.image.version=$VERSION
+LABEL org.opencontainers.image.source="${SOURCE_URL}"
+LABEL description="static"`
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Dockerfile"), []byte(dockerfileContent), 0644))
+
+	e := NewExtractor()
+	metadata, err := e.Extract(dir)
+	require.NoError(t, err)
+
+	labels, ok := metadata.LanguageSpecific["labels"].(map[string]string)
+	require.True(t, ok)
+	assert.Equal(t, "$VERSION", labels["org.opencontainers.image.version"])
+	assert.Equal(t, "${SOURCE_URL}", labels["org.opencontainers.image.source"])
+	assert.Equal(t, "static", labels["description"])
+
+	assert.Equal(t, []string{
+		"org.opencontainers.image.source",
+		"org.opencontainers.image.version",
+	}, metadata.LanguageSpecific["unresolved_labels"])
+	assert.Len(t, metadata.Warnings, 2)
+	assert.Contains(t, metadata.Warnings[1], "VERSION")
+
+	// The unresolved label is not used as the version
+	assert.Empty(t, metadata.Version)
+	assert.Empty(t, metadata.VersionSource)
+}
+
+func TestExtractor_Extract_UnresolvedVersionLabelFallsBack(t *testing.T) {
+	dir := t.TempDir()
+	dockerfileContent := `FROM alpine:3.18
+
+ARG VERSION
+LABEL org.opencontainers.image.version=$VERSION`
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Dockerfile"), []byte(dockerfileContent), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "VERSION"), []byte("3.1.0\n"), 0644))
+
+	e := NewExtractor()
+	metadata, err := e.Extract(dir)
+	require.NoError(t, err)
+	require.Empty(t, metadata.Version)
+
+	assert.True(t, extractor.ApplyVersionFile(dir, metadata))
+	assert.Equal(t, "3.1.0", metadata.Version)
+	assert.Len(t, metadata.Warnings, 1)
+}
+
 func TestExtractor_Extract_ExposedPorts(t *testing.T) {
 	dir := t.TempDir()
 	dockerfilePath := filepath.Join(dir, "Dockerfile")
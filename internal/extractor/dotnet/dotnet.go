@@ -113,20 +113,17 @@ func init() {
 // Detect checks if this extractor can handle the project
 func (e *Extractor) Detect(projectPath string) bool {
 	// Check for .csproj files
-	csprojMatches, _ := filepath.Glob(filepath.Join(projectPath, "*.csproj"))
-	if len(csprojMatches) > 0 {
+	if len(extractor.GlobFiles(projectPath, "*.csproj")) > 0 {
 		return true
 	}
 
 	// Check for .sln files
-	slnMatches, _ := filepath.Glob(filepath.Join(projectPath, "*.sln"))
-	if len(slnMatches) > 0 {
+	if len(extractor.GlobFiles(projectPath, "*.sln")) > 0 {
 		return true
 	}
 
 	// Check for .props files
-	propsMatches, _ := filepath.Glob(filepath.Join(projectPath, "*.props"))
-	if len(propsMatches) > 0 {
+	if len(extractor.GlobFiles(projectPath, "*.props")) > 0 {
 		return true
 	}
 
@@ -145,6 +142,7 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 		if err := e.extractFromProjectFile(csprojPath, metadata); err != nil {
 			return nil, err
 		}
+		extractor.RecordManifest(metadata, csprojPath)
 	} else {
 		// Try .sln file
 		slnPath, err := e.findProjectFile(projectPath, "*.sln")
@@ -152,6 +150,7 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 			if err := e.extractFromSolution(projectPath, slnPath, metadata); err != nil {
 				return nil, err
 			}
+			extractor.RecordManifest(metadata, slnPath)
 		} else {
 			// Try .props file
 			propsPath, err := e.findProjectFile(projectPath, "*.props")
@@ -159,6 +158,7 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 				if err := e.extractFromPropsFile(propsPath, metadata); err != nil {
 					return nil, err
 				}
+				extractor.RecordManifest(metadata, propsPath)
 			} else {
 				return nil, fmt.Errorf("no .NET project files found")
 			}
@@ -177,7 +177,7 @@ func (e *Extractor) extractFromProjectFile(csprojPath string, metadata *extracto
 	// Parse the project file
 	project, err := e.parseProjectFile(csprojPath)
 	if err != nil {
-		return fmt.Errorf("failed to parse project file: %w", err)
+		return &extractor.ManifestParseError{Manifest: filepath.Base(csprojPath), Err: err}
 	}
 
 	// Extract metadata from property groups
@@ -208,7 +208,7 @@ func (e *Extractor) extractFromSolution(projectPath string, slnPath string, meta
 	// Parse the solution file
 	solution, err := e.parseSolutionFile(slnPath)
 	if err != nil {
-		return fmt.Errorf("failed to parse solution file: %w", err)
+		return &extractor.ManifestParseError{Manifest: filepath.Base(slnPath), Err: err}
 	}
 
 	// Store solution metadata
@@ -242,7 +242,7 @@ func (e *Extractor) extractFromPropsFile(propsPath string, metadata *extractor.P
 	// Parse as project file (same XML structure)
 	project, err := e.parseProjectFile(propsPath)
 	if err != nil {
-		return fmt.Errorf("failed to parse props file: %w", err)
+		return &extractor.ManifestParseError{Manifest: filepath.Base(propsPath), Err: err}
 	}
 
 	metadata.Name = strings.TrimSuffix(filepath.Base(propsPath), ".props")
@@ -662,6 +662,7 @@ func (e *Extractor) generateVersionMatrix(metadata *extractor.ProjectMetadata) {
 	// Create matrix JSON for GitHub Actions
 	matrixJSON := fmt.Sprintf(`{"dotnet-version":["%s"]}`, strings.Join(versions, `","`))
 	metadata.LanguageSpecific["matrix_json"] = matrixJSON
+	extractor.ApplyOSMatrix(metadata, "dotnet-version", versions)
 }
 
 // getNetVersion extracts .NET version from target framework
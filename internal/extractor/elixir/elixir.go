@@ -9,9 +9,13 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor/versions"
 )
 
 // Extractor extracts metadata from Elixir projects
@@ -33,15 +37,14 @@ func init() {
 // Detect checks if this is an Elixir project
 func (e *Extractor) Detect(projectPath string) bool {
 	// Check for mix.exs
-	if _, err := os.Stat(filepath.Join(projectPath, "mix.exs")); err == nil {
+	if extractor.FileExists(projectPath, "mix.exs") {
 		return true
 	}
 
 	// Check for lib/ directory with .ex files
 	libDir := filepath.Join(projectPath, "lib")
 	if info, err := os.Stat(libDir); err == nil && info.IsDir() {
-		matches, err := filepath.Glob(filepath.Join(libDir, "*.ex"))
-		if err == nil && len(matches) > 0 {
+		if len(extractor.GlobFiles(libDir, "*.ex")) > 0 {
 			return true
 		}
 	}
@@ -49,8 +52,7 @@ func (e *Extractor) Detect(projectPath string) bool {
 	// Check for .ex or .exs files in root
 	patterns := []string{"*.ex", "*.exs"}
 	for _, pattern := range patterns {
-		matches, err := filepath.Glob(filepath.Join(projectPath, pattern))
-		if err == nil && len(matches) > 0 {
+		if len(extractor.GlobFiles(projectPath, pattern)) > 0 {
 			return true
 		}
 	}
@@ -69,6 +71,14 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 		if err := e.extractFromMixExs(mixExsPath, metadata); err != nil {
 			return nil, err
 		}
+		extractor.RecordManifest(metadata, mixExsPath)
+	}
+	if apps := umbrellaApps(projectPath); apps != nil {
+		metadata.LanguageSpecific["is_umbrella"] = true
+		metadata.LanguageSpecific["apps"] = apps
+	}
+	if pinned := readPinnedVersions(projectPath); pinned != nil {
+		applyPinnedVersions(pinned, metadata)
 	}
 
 	metadata.LanguageSpecific["build_tool"] = "Mix"
@@ -96,8 +106,15 @@ func (e *Extractor) extractFromMixExs(path string, metadata *extractor.ProjectMe
 	linksRegex := regexp.MustCompile(`links:\s*%\{`)
 	homepageRegex := regexp.MustCompile(`"([^"]+)"\s*=>\s*"([^"]+)"`)
 	depRegex := regexp.MustCompile(`\{:(\w+),\s*"([^"]+)"`)
+	modRegex := regexp.MustCompile(`mod:\s*\{\s*([A-Z][\w.]*)`)
+	extraAppsRegex := regexp.MustCompile(`extra_applications:\s*\[([^\]]*)\]`)
+	atomRegex := regexp.MustCompile(`:(\w+)`)
+	elixircPathsRegex := regexp.MustCompile(`elixirc_paths(?::|\(.*\),\s*do:)\s*\[([^\]]*)\]`)
+	quotedRegex := regexp.MustCompile(`"([^"]+)"`)
 
 	var dependencies []string
+	var extraApplications []string
+	var elixircPaths []string
 	var inPackageBlock bool
 	var inLinksBlock bool
 	var elixirVersion string
@@ -175,6 +192,28 @@ func (e *Extractor) extractFromMixExs(path string, metadata *extractor.ProjectMe
 			dep := fmt.Sprintf("%s:%s", matches[1], matches[2])
 			dependencies = append(dependencies, dep)
 		}
+
+		// Extract the OTP application callback module from application/0
+		if matches := modRegex.FindStringSubmatch(line); matches != nil {
+			metadata.LanguageSpecific["otp_application_module"] = matches[1]
+		}
+
+		// Extract extra_applications: [:logger, :runtime_tools]
+		if matches := extraAppsRegex.FindStringSubmatch(line); matches != nil {
+			for _, atom := range atomRegex.FindAllStringSubmatch(matches[1], -1) {
+				extraApplications = append(extraApplications, atom[1])
+			}
+		}
+
+		// Extract elixirc_paths, either inline in project/0 or from the
+		// per-environment `defp elixirc_paths(:test), do: [...]` clauses
+		if matches := elixircPathsRegex.FindStringSubmatch(line); matches != nil {
+			for _, path := range quotedRegex.FindAllStringSubmatch(matches[1], -1) {
+				if !slices.Contains(elixircPaths, path[1]) {
+					elixircPaths = append(elixircPaths, path[1])
+				}
+			}
+		}
 	}
 
 	if err := scanner.Err(); err != nil {
@@ -192,6 +231,15 @@ func (e *Extractor) extractFromMixExs(path string, metadata *extractor.ProjectMe
 		}
 	}
 
+	if len(extraApplications) > 0 {
+		metadata.LanguageSpecific["extra_applications"] = extraApplications
+	}
+
+	// Only report elixirc_paths when customized beyond the default "lib"
+	if len(elixircPaths) > 0 && !(len(elixircPaths) == 1 && elixircPaths[0] == "lib") {
+		metadata.LanguageSpecific["elixirc_paths"] = elixircPaths
+	}
+
 	// Store dependencies
 	if len(dependencies) > 0 {
 		metadata.LanguageSpecific["dependencies"] = dependencies
@@ -207,39 +255,92 @@ func (e *Extractor) extractFromMixExs(path string, metadata *extractor.ProjectMe
 	return nil
 }
 
-// generateElixirVersionMatrix generates a matrix of Elixir versions
+// elixirClauseRegex matches a single requirement clause such as "~> 1.15"
+var elixirClauseRegex = regexp.MustCompile(`^(~>|>=|<=|==|>|<)?\s*v?(\d+)(?:\.(\d+))?(?:\.(\d+))?`)
+
+// generateElixirVersionMatrix returns the supported Elixir releases a
+// requirement allows. As in Mix, "~> 1.15" allows every 1.x from 1.15
+// while "~> 1.15.0" allows the 1.15 series only, and ">= 1.15" runs up
+// to the latest supported release. Clauses joined by "and" narrow the
+// range and "or" alternatives are combined. A bare version, as pinned in
+// .tool-versions, is treated as a minimum.
 func generateElixirVersionMatrix(requirement string) []string {
-	// Remove constraint operators
-	version := strings.TrimPrefix(requirement, "~> ")
-	version = strings.TrimPrefix(version, ">= ")
-	version = strings.TrimPrefix(version, "== ")
-
-	parts := strings.Split(version, ".")
-	if len(parts) < 2 {
-		return []string{"1.14", "1.15", "1.16"}
+	selected := make(map[string]bool)
+	for _, alternative := range strings.Split(requirement, " or ") {
+		for _, version := range elixirRangeVersions(alternative) {
+			selected[version] = true
+		}
+	}
+
+	matrix := make([]string, 0, len(selected))
+	for version := range selected {
+		matrix = append(matrix, version)
 	}
+	sort.Slice(matrix, func(i, j int) bool {
+		return versions.Compare(matrix[i], matrix[j]) < 0
+	})
+	return matrix
+}
 
-	major := parts[0]
-	minor := parts[1]
-
-	if major == "1" {
-		switch minor {
-		case "16":
-			return []string{"1.16", "1.17"}
-		case "15":
-			return []string{"1.15", "1.16", "1.17"}
-		case "14":
-			return []string{"1.14", "1.15", "1.16"}
-		case "13":
-			return []string{"1.13", "1.14", "1.15"}
-		case "12":
-			return []string{"1.12", "1.13", "1.14"}
-		default:
-			return []string{"1.14", "1.15", "1.16"}
+// elixirRangeVersions returns the supported releases within the range of
+// "and"-ed clauses. A range admitting only end-of-life releases yields its
+// minimum so the matrix still reflects what the project supports.
+func elixirRangeVersions(constraint string) []string {
+	lower, upper := "", ""
+	for _, clause := range strings.Split(constraint, " and ") {
+		clauseLower, clauseUpper := elixirClauseBounds(strings.TrimSpace(clause))
+		if clauseLower != "" && (lower == "" || versions.Compare(clauseLower, lower) > 0) {
+			lower = clauseLower
+		}
+		if clauseUpper != "" && (upper == "" || versions.Compare(clauseUpper, upper) < 0) {
+			upper = clauseUpper
 		}
 	}
 
-	return []string{"1.14", "1.15", "1.16"}
+	matrix := []string{}
+	for _, version := range versions.Get(versions.Elixir).From(lower) {
+		if upper == "" || versions.Compare(version, upper) < 0 {
+			matrix = append(matrix, version)
+		}
+	}
+	if len(matrix) == 0 && lower != "" {
+		matrix = append(matrix, lower)
+	}
+	return matrix
+}
+
+// elixirClauseBounds returns the inclusive lower and exclusive upper
+// major.minor bounds of a requirement clause; either may be empty
+func elixirClauseBounds(clause string) (lower, upper string) {
+	matches := elixirClauseRegex.FindStringSubmatch(clause)
+	if matches == nil {
+		return "", ""
+	}
+	op, hasPatch := matches[1], matches[4] != ""
+	major, _ := strconv.Atoi(matches[2])
+	minor, _ := strconv.Atoi(matches[3])
+	version := fmt.Sprintf("%d.%d", major, minor)
+	nextMinor := fmt.Sprintf("%d.%d", major, minor+1)
+
+	switch op {
+	case "~>":
+		if hasPatch {
+			return version, nextMinor
+		}
+		return version, fmt.Sprintf("%d.0", major+1)
+	case "==":
+		return version, nextMinor
+	case "<":
+		if patch, _ := strconv.Atoi(matches[4]); patch > 0 {
+			return "", nextMinor
+		}
+		return "", version
+	case "<=":
+		return "", nextMinor
+	default:
+		// >=, > and bare versions
+		return version, ""
+	}
 }
 
 // detectFramework detects if the project uses a framework
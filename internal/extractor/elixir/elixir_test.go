@@ -164,6 +164,63 @@ end
 	assert.Equal(t, "Mix", metadata.LanguageSpecific["build_tool"])
 }
 
+func TestExtractApplicationModule(t *testing.T) {
+	mixExsContent := `defmodule MyApp.MixProject do
+  use Mix.Project
+
+  def project do
+    [
+      app: :my_app,
+      version: "0.3.0",
+      elixirc_paths: elixirc_paths(Mix.env()),
+      package: package(),
+      deps: deps()
+    ]
+  end
+
+  def application do
+    [
+      mod: {MyApp.Application, []},
+      extra_applications: [:logger, :runtime_tools]
+    ]
+  end
+
+  defp elixirc_paths(:test), do: ["lib", "test/support"]
+  defp elixirc_paths(_), do: ["lib"]
+
+  defp package do
+    [
+      licenses: ["MIT"],
+      links: %{"GitHub" => "https://github.com/example/my_app"}
+    ]
+  end
+
+  defp deps do
+    [
+      {:plug, "~> 1.15"}
+    ]
+  end
+end
+`
+
+	tmpDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmpDir, "mix.exs"), []byte(mixExsContent), 0644)
+	require.NoError(t, err)
+
+	e := NewExtractor()
+	metadata, err := e.Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "MyApp.Application", metadata.LanguageSpecific["otp_application_module"])
+	assert.Equal(t, []string{"logger", "runtime_tools"}, metadata.LanguageSpecific["extra_applications"])
+	assert.Equal(t, []string{"lib", "test/support"}, metadata.LanguageSpecific["elixirc_paths"])
+
+	// Package and links blocks are still parsed
+	assert.Equal(t, "MIT", metadata.License)
+	assert.Equal(t, "https://github.com/example/my_app", metadata.Homepage)
+	assert.Equal(t, []string{"plug:~> 1.15"}, metadata.LanguageSpecific["dependencies"])
+}
+
 func TestGenerateElixirVersionMatrix(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -171,24 +228,49 @@ func TestGenerateElixirVersionMatrix(t *testing.T) {
 		expected    []string
 	}{
 		{
-			name:        "Elixir 1.16",
+			name:        "~> major.minor allows later minors",
 			requirement: "~> 1.16",
-			expected:    []string{"1.16", "1.17"},
+			expected:    []string{"1.16", "1.17", "1.18"},
+		},
+		{
+			name:        "~> major.minor.patch pins the minor",
+			requirement: "~> 1.15.0",
+			expected:    []string{"1.15"},
 		},
 		{
-			name:        "Elixir 1.15",
-			requirement: "~> 1.15",
-			expected:    []string{"1.15", "1.16", "1.17"},
+			name:        ">= runs to the latest release",
+			requirement: ">= 1.15",
+			expected:    []string{"1.15", "1.16", "1.17", "1.18"},
 		},
 		{
-			name:        "Elixir 1.14",
-			requirement: ">= 1.14",
-			expected:    []string{"1.14", "1.15", "1.16"},
+			name:        "older minimum selects every supported release",
+			requirement: "~> 1.12",
+			expected:    []string{"1.14", "1.15", "1.16", "1.17", "1.18"},
 		},
 		{
-			name:        "Elixir 1.13",
+			name:        "end-of-life series only",
 			requirement: "~> 1.13.0",
-			expected:    []string{"1.13", "1.14", "1.15"},
+			expected:    []string{"1.13"},
+		},
+		{
+			name:        "and narrows the range",
+			requirement: ">= 1.15.0 and < 1.17.0",
+			expected:    []string{"1.15", "1.16"},
+		},
+		{
+			name:        "or combines ranges",
+			requirement: "~> 1.14.0 or ~> 1.17.0",
+			expected:    []string{"1.14", "1.17"},
+		},
+		{
+			name:        "newer release required",
+			requirement: ">= 1.19.0",
+			expected:    []string{"1.19"},
+		},
+		{
+			name:        "unparsable requirement",
+			requirement: "latest",
+			expected:    []string{"1.16", "1.17", "1.18"},
 		},
 	}
 
@@ -200,6 +282,64 @@ func TestGenerateElixirVersionMatrix(t *testing.T) {
 	}
 }
 
+func TestExtractUmbrella(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"mix.exs": `defmodule Platform.MixProject do
+  use Mix.Project
+
+  def project do
+    [
+      apps_path: "apps",
+      version: "0.3.0",
+      start_permanent: Mix.env() == :prod,
+      deps: deps()
+    ]
+  end
+end
+`,
+		"apps/web/mix.exs":  "defmodule Web.MixProject do\nend\n",
+		"apps/core/mix.exs": "defmodule Core.MixProject do\nend\n",
+		"apps/notes.txt":    "not an app\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(tmpDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, true, metadata.LanguageSpecific["is_umbrella"])
+	assert.Equal(t, []string{"core", "web"}, metadata.LanguageSpecific["apps"])
+	assert.Equal(t, "0.3.0", metadata.Version)
+}
+
+func TestUmbrellaApps(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    []string
+		expected []string
+	}{
+		{name: "no apps directory", files: []string{"mix.exs"}, expected: nil},
+		{name: "single app without apps_path", files: []string{"mix.exs", "apps/one/mix.exs"}, expected: nil},
+		{name: "several apps", files: []string{"mix.exs", "apps/b/mix.exs", "apps/a/mix.exs"}, expected: []string{"a", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			for _, name := range tt.files {
+				path := filepath.Join(tmpDir, name)
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+				require.NoError(t, os.WriteFile(path, []byte("defmodule X.MixProject do\nend\n"), 0644))
+			}
+			assert.Equal(t, tt.expected, umbrellaApps(tmpDir))
+		})
+	}
+}
+
 func TestDetectFramework(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -235,3 +375,75 @@ func TestDetectFramework(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractToolVersions(t *testing.T) {
+	mixExsContent := `defmodule Pinned.MixProject do
+  use Mix.Project
+
+  def project do
+    [
+      app: :pinned,
+      version: "0.1.0",
+      elixir: "~> 1.14"
+    ]
+  end
+end
+`
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "mix.exs"), []byte(mixExsContent), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, ".tool-versions"),
+		[]byte("# asdf\nelixir 1.16.0-otp-26\nnodejs 20.11.0\n"), 0644))
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.16.0", metadata.LanguageSpecific["elixir_version"])
+	assert.Equal(t, "26", metadata.LanguageSpecific["otp_version"])
+	assert.Equal(t, ".tool-versions", metadata.LanguageSpecific["elixir_version_source"])
+	assert.Equal(t, "~> 1.14", metadata.LanguageSpecific["elixir_requirement"])
+	assert.Equal(t, []string{"1.16", "1.17", "1.18"}, metadata.LanguageSpecific["elixir_version_matrix"])
+}
+
+func TestReadPinnedVersions(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expected *pinnedVersions
+	}{
+		{
+			name:     "erlang version wins over the otp suffix",
+			files:    map[string]string{".tool-versions": "elixir 1.16.0-otp-26\nerlang 26.2\n"},
+			expected: &pinnedVersions{Elixir: "1.16.0", OTP: "26.2", Source: ".tool-versions"},
+		},
+		{
+			name:     "plain elixir version",
+			files:    map[string]string{".tool-versions": "elixir 1.15.7 1.15.6\n"},
+			expected: &pinnedVersions{Elixir: "1.15.7", Source: ".tool-versions"},
+		},
+		{
+			name:     "mise tools",
+			files:    map[string]string{".mise.toml": "[tools]\nelixir = \"1.17.2-otp-27\"\nerlang = [\"27.0\"]\n"},
+			expected: &pinnedVersions{Elixir: "1.17.2", OTP: "27.0", Source: ".mise.toml"},
+		},
+		{
+			name:     "mise version table",
+			files:    map[string]string{".mise.toml": "[tools]\nerlang = { version = \"26.2.5\" }\n"},
+			expected: &pinnedVersions{OTP: "26.2.5", Source: ".mise.toml"},
+		},
+		{
+			name:     "no elixir or erlang",
+			files:    map[string]string{".tool-versions": "nodejs 20.11.0\n"},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			for name, content := range tt.files {
+				require.NoError(t, os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644))
+			}
+			assert.Equal(t, tt.expected, readPinnedVersions(tmpDir))
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package elixir
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+)
+
+// Version manager files pinning the Elixir and Erlang/OTP releases, in
+// the order they are consulted
+const (
+	toolVersionsFile = ".tool-versions"
+	miseFile         = ".mise.toml"
+)
+
+// pinnedVersions holds the Elixir and OTP releases pinned by asdf or mise
+type pinnedVersions struct {
+	Elixir string
+	OTP    string
+	Source string // File the versions were read from
+}
+
+// readPinnedVersions returns the versions pinned in .tool-versions or
+// .mise.toml, or nil when neither pins Elixir or Erlang
+func readPinnedVersions(projectPath string) *pinnedVersions {
+	for _, read := range []func(string) *pinnedVersions{readToolVersions, readMiseToml} {
+		if pinned := read(projectPath); pinned != nil {
+			return pinned
+		}
+	}
+	return nil
+}
+
+// readToolVersions parses asdf's .tool-versions, e.g.
+//
+//	elixir 1.16.0-otp-26
+//	erlang 26.2
+func readToolVersions(projectPath string) *pinnedVersions {
+	file, err := os.Open(filepath.Join(projectPath, toolVersionsFile))
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	tools := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		fields := strings.Fields(line)
+		// Further fields are fallback versions; the first one is used
+		if len(fields) >= 2 {
+			tools[fields[0]] = fields[1]
+		}
+	}
+	return newPinnedVersions(tools["elixir"], tools["erlang"], toolVersionsFile)
+}
+
+// readMiseToml parses the [tools] table of .mise.toml, where a tool maps
+// to a version, a list of versions, or a table with a version key
+func readMiseToml(projectPath string) *pinnedVersions {
+	var config struct {
+		Tools map[string]interface{} `toml:"tools"`
+	}
+	if _, err := toml.DecodeFile(filepath.Join(projectPath, miseFile), &config); err != nil {
+		return nil
+	}
+	return newPinnedVersions(miseToolVersion(config.Tools["elixir"]), miseToolVersion(config.Tools["erlang"]), miseFile)
+}
+
+// miseToolVersion returns the first version of a mise tool entry
+func miseToolVersion(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []interface{}:
+		if len(v) > 0 {
+			return miseToolVersion(v[0])
+		}
+	case map[string]interface{}:
+		return miseToolVersion(v["version"])
+	}
+	return ""
+}
+
+// newPinnedVersions splits the OTP release off precompiled Elixir builds
+// such as 1.16.0-otp-26. A pinned Erlang version is more precise and wins.
+func newPinnedVersions(elixir, erlang, source string) *pinnedVersions {
+	if elixir == "" && erlang == "" {
+		return nil
+	}
+	pinned := &pinnedVersions{Elixir: elixir, OTP: erlang, Source: source}
+	if version, otp, found := strings.Cut(elixir, "-otp-"); found {
+		pinned.Elixir = version
+		if pinned.OTP == "" {
+			pinned.OTP = otp
+		}
+	}
+	return pinned
+}
+
+// applyPinnedVersions reports the pinned releases. A pinned Elixir
+// version replaces the mix.exs requirement as elixir_version and as the
+// base of the version matrix; the requirement stays in elixir_requirement.
+func applyPinnedVersions(pinned *pinnedVersions, metadata *extractor.ProjectMetadata) {
+	if pinned.Elixir != "" {
+		if requirement, ok := metadata.LanguageSpecific["elixir_version"]; ok {
+			metadata.LanguageSpecific["elixir_requirement"] = requirement
+		}
+		metadata.LanguageSpecific["elixir_version"] = pinned.Elixir
+		metadata.LanguageSpecific["elixir_version_source"] = pinned.Source
+		if matrix := generateElixirVersionMatrix(pinned.Elixir); len(matrix) > 0 {
+			metadata.LanguageSpecific["elixir_version_matrix"] = matrix
+		}
+	}
+	if pinned.OTP != "" {
+		metadata.LanguageSpecific["otp_version"] = pinned.OTP
+	}
+}
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package elixir
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+)
+
+// defaultAppsPath is where umbrella projects keep their child applications
+const defaultAppsPath = "apps"
+
+// appsPathRegex matches the apps_path option of an umbrella mix.exs
+var appsPathRegex = regexp.MustCompile(`apps_path:\s*"([^"]+)"`)
+
+// umbrellaApps returns the child applications of an umbrella project,
+// sorted by directory name. A project is an umbrella when its mix.exs
+// declares apps_path, or when the apps directory holds several Mix
+// projects. Other projects return nil.
+func umbrellaApps(projectPath string) []string {
+	appsPath := defaultAppsPath
+	declared := false
+	if content, err := os.ReadFile(filepath.Join(projectPath, "mix.exs")); err == nil {
+		if matches := appsPathRegex.FindSubmatch(content); matches != nil {
+			appsPath = string(matches[1])
+			declared = true
+		}
+	}
+
+	mixFiles, _ := filepath.Glob(filepath.Join(projectPath, appsPath, "*", "mix.exs"))
+	var apps []string
+	for _, mixExs := range mixFiles {
+		apps = append(apps, filepath.Base(filepath.Dir(mixExs)))
+	}
+	if len(apps) == 0 || (!declared && len(apps) < 2) {
+		return nil
+	}
+	sort.Strings(apps)
+	return apps
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"context"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/git"
+)
+
+// DefaultBranch returns the default branch of the origin remote, as
+// recorded by origin/HEAD when the repository was cloned. Returns an
+// empty string without a remote or when origin/HEAD is not set, as in
+// shallow CI checkouts.
+func DefaultBranch(ctx context.Context, projectPath string) string {
+	return git.Default().Info(ctx, projectPath).DefaultBranch
+}
+
+// CurrentBranch returns the branch checked out at projectPath, or an
+// empty string for a detached HEAD or outside a git repository
+func CurrentBranch(ctx context.Context, projectPath string) string {
+	return git.Default().Info(ctx, projectPath).Branch
+}
+
+// IsDefaultBranch compares branch with defaultBranch, returning nil when
+// either is unknown so that callers can omit the result
+func IsDefaultBranch(branch, defaultBranch string) *bool {
+	if branch == "" || defaultBranch == "" {
+		return nil
+	}
+	isDefault := branch == defaultBranch
+	return &isDefault
+}
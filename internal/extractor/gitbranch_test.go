@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestDefaultBranch tests reading origin/HEAD from a clone whose remote
+// default branch is main
+func TestDefaultBranch(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	upstream := t.TempDir()
+	runGit(t, upstream, "init", "-q", "-b", "main")
+	runGit(t, upstream, "commit", "-q", "--allow-empty", "-m", "initial")
+
+	clone := filepath.Join(t.TempDir(), "clone")
+	runGit(t, upstream, "clone", "-q", upstream, clone)
+	runGit(t, clone, "checkout", "-q", "-b", "feature")
+
+	defaultBranch := DefaultBranch(context.Background(), clone)
+	if defaultBranch != "main" {
+		t.Fatalf("DefaultBranch() = %q, want main", defaultBranch)
+	}
+	branch := CurrentBranch(context.Background(), clone)
+	if branch != "feature" {
+		t.Errorf("CurrentBranch() = %q, want feature", branch)
+	}
+
+	if isDefault := IsDefaultBranch(branch, defaultBranch); isDefault == nil || *isDefault {
+		t.Errorf("IsDefaultBranch(%q, %q) = %v, want false", branch, defaultBranch, isDefault)
+	}
+	if isDefault := IsDefaultBranch("main", defaultBranch); isDefault == nil || !*isDefault {
+		t.Errorf("IsDefaultBranch(main, %q) = %v, want true", defaultBranch, isDefault)
+	}
+}
+
+// TestDefaultBranch_NoRemote tests the no-op for a repository without a remote
+func TestDefaultBranch_NoRemote(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := t.TempDir()
+	runGit(t, dir, "init", "-q", "-b", "main")
+	runGit(t, dir, "commit", "-q", "--allow-empty", "-m", "initial")
+
+	defaultBranch := DefaultBranch(context.Background(), dir)
+	if defaultBranch != "" {
+		t.Errorf("DefaultBranch() = %q, want empty without a remote", defaultBranch)
+	}
+	if isDefault := IsDefaultBranch(CurrentBranch(context.Background(), dir), defaultBranch); isDefault != nil {
+		t.Errorf("IsDefaultBranch() = %v, want nil without a remote", *isDefault)
+	}
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"strings"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/git"
+)
+
+// TagChanges summarizes what changed in a project since the latest tag
+type TagChanges struct {
+	Tag             string
+	FilesChanged    int
+	ManifestChanged bool
+}
+
+// ChangesSinceTag compares HEAD with the latest tag reachable from it and
+// reports how many files below projectPath changed and whether
+// manifestPath was among them. Returns nil outside a git repository, when
+// there are no tags, or when git fails. An empty manifestPath is never
+// reported as changed.
+func ChangesSinceTag(ctx context.Context, projectPath, manifestPath string) *TagChanges {
+	tag := LatestGitTagContext(ctx, projectPath)
+	if tag == "" {
+		return nil
+	}
+
+	// The "." pathspec is relative to -C, limiting the diff to the project
+	output, err := git.Default().Run(ctx, projectPath, "diff", "--name-only", tag, "HEAD", "--", ".")
+	if err != nil {
+		return nil
+	}
+
+	changes := &TagChanges{Tag: tag}
+	for _, line := range strings.Split(output, "\n") {
+		if strings.TrimSpace(line) != "" {
+			changes.FilesChanged++
+		}
+	}
+
+	if manifestPath != "" && changes.FilesChanged > 0 {
+		// --quiet exits 1 when the manifest differs
+		_, err := git.Default().Run(ctx, projectPath, "diff", "--quiet", tag, "HEAD", "--", manifestPath)
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+			changes.ManifestChanged = true
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// writeFile writes content to name below dir, failing the test on error
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write %s: %v", name, err)
+	}
+}
+
+// TestChangesSinceTag tests counting changes between the latest tag and HEAD
+func TestChangesSinceTag(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	manifest := filepath.Join(dir, "go.mod")
+	runGit(t, dir, "init", "-q")
+	writeFile(t, dir, "go.mod", "module example.com/app\n")
+	writeFile(t, dir, "main.go", "package main\n")
+	runGit(t, dir, "add", ".")
+	runGit(t, dir, "commit", "-q", "-m", "initial")
+	runGit(t, dir, "tag", "v1.0.0")
+
+	changes := ChangesSinceTag(context.Background(), dir, manifest)
+	if changes == nil || changes.Tag != "v1.0.0" || changes.FilesChanged != 0 || changes.ManifestChanged {
+		t.Fatalf("Changes at the tag = %+v, want none", changes)
+	}
+
+	writeFile(t, dir, "main.go", "package main\n\nfunc main() {}\n")
+	runGit(t, dir, "commit", "-q", "-am", "code change")
+
+	changes = ChangesSinceTag(context.Background(), dir, manifest)
+	if changes == nil || changes.FilesChanged != 1 || changes.ManifestChanged {
+		t.Errorf("Changes after a code change = %+v, want 1 file and no manifest change", changes)
+	}
+
+	writeFile(t, dir, "go.mod", "module example.com/app\n\ngo 1.22\n")
+	runGit(t, dir, "commit", "-q", "-am", "manifest change")
+
+	changes = ChangesSinceTag(context.Background(), dir, manifest)
+	if changes == nil || changes.FilesChanged != 2 || !changes.ManifestChanged {
+		t.Errorf("Changes after a manifest change = %+v, want 2 files and a manifest change", changes)
+	}
+}
+
+// TestChangesSinceTag_NoTags tests the no-op for a repository without tags
+func TestChangesSinceTag_NoTags(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	runGit(t, dir, "init", "-q")
+	runGit(t, dir, "commit", "-q", "--allow-empty", "-m", "initial")
+
+	if changes := ChangesSinceTag(context.Background(), dir, ""); changes != nil {
+		t.Errorf("ChangesSinceTag() = %+v, want nil without tags", changes)
+	}
+	if changes := ChangesSinceTag(context.Background(), t.TempDir(), ""); changes != nil {
+		t.Errorf("ChangesSinceTag() = %+v, want nil outside a repository", changes)
+	}
+}
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"context"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/git"
+)
+
+// CommitInfo identifies who made a commit and when
+type CommitInfo struct {
+	Author string
+	Email  string
+	Date   string // Committer date, RFC3339
+}
+
+// HeadCommit returns the author and commit date of HEAD, or nil when the
+// path is not inside a git repository, the repository has no commits, or
+// git is unavailable
+func HeadCommit(ctx context.Context, projectPath string) *CommitInfo {
+	repo := git.Default().Info(ctx, projectPath)
+	if repo.HeadSHA == "" {
+		return nil
+	}
+	return &CommitInfo{Author: repo.Author, Email: repo.Email, Date: repo.CommitDate}
+}
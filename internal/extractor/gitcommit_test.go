@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"testing"
+)
+
+// TestHeadCommit tests reading the author and date of HEAD
+func TestHeadCommit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	runGit(t, dir, "init", "-q")
+	t.Setenv("GIT_COMMITTER_DATE", "2025-03-14T09:26:53+02:00")
+	runGit(t, dir, "-c", "user.name=Ada Lovelace", "-c", "user.email=ada@example.com",
+		"commit", "-q", "--allow-empty", "-m", "initial")
+
+	info := HeadCommit(context.Background(), dir)
+	if info == nil {
+		t.Fatal("HeadCommit returned nil inside a git repository")
+	}
+	if info.Author != "Ada Lovelace" {
+		t.Errorf("Author = %q, want Ada Lovelace", info.Author)
+	}
+	if info.Email != "ada@example.com" {
+		t.Errorf("Email = %q, want ada@example.com", info.Email)
+	}
+	if info.Date != "2025-03-14T09:26:53+02:00" {
+		t.Errorf("Date = %q, want 2025-03-14T09:26:53+02:00", info.Date)
+	}
+}
+
+// TestHeadCommit_NoRepository tests the no-op outside a git repository
+func TestHeadCommit_NoRepository(t *testing.T) {
+	// Keep git from discovering a repository above the temp directory
+	t.Setenv("GIT_CEILING_DIRECTORIES", os.TempDir())
+	if info := HeadCommit(context.Background(), t.TempDir()); info != nil {
+		t.Errorf("HeadCommit = %+v, want nil", info)
+	}
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/git"
+)
+
+// GitTopLevel returns the root of the git working tree containing
+// projectPath, or an empty string outside a git repository
+func GitTopLevel(ctx context.Context, projectPath string) string {
+	return git.Default().Info(ctx, projectPath).TopLevel
+}
+
+// RelativeProjectPath returns projectPath relative to the git toplevel,
+// using forward slashes, or "." when it is the repository root. Returns
+// an empty string outside a git repository.
+func RelativeProjectPath(ctx context.Context, projectPath string) string {
+	root := GitTopLevel(ctx, projectPath)
+	if root == "" {
+		return ""
+	}
+
+	// git reports the toplevel with symlinks resolved, e.g. /private/var
+	// for /var on macOS, so compare resolved paths
+	absPath, err := filepath.Abs(projectPath)
+	if err != nil {
+		return ""
+	}
+	if resolved, err := filepath.EvalSymlinks(absPath); err == nil {
+		absPath = resolved
+	}
+	if resolved, err := filepath.EvalSymlinks(root); err == nil {
+		root = resolved
+	}
+
+	rel, err := filepath.Rel(root, absPath)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return ""
+	}
+	return filepath.ToSlash(rel)
+}
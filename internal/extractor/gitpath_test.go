@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestRelativeProjectPath tests paths at and below the git toplevel
+func TestRelativeProjectPath(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	runGit(t, dir, "init", "-q")
+	nested := filepath.Join(dir, "services", "api")
+	if err := os.MkdirAll(nested, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected string
+	}{
+		{"repository root", dir, "."},
+		{"nested project", nested, "services/api"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RelativeProjectPath(context.Background(), tt.path); got != tt.expected {
+				t.Errorf("RelativeProjectPath(%q) = %q, want %q", tt.path, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestRelativeProjectPath_NoRepository tests the empty result outside git
+func TestRelativeProjectPath_NoRepository(t *testing.T) {
+	t.Setenv("GIT_CEILING_DIRECTORIES", os.TempDir())
+	if got := RelativeProjectPath(context.Background(), t.TempDir()); got != "" {
+		t.Errorf("RelativeProjectPath = %q, want empty", got)
+	}
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"context"
+	"strings"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/git"
+)
+
+// VersionSourceGitTag is the VersionSource recorded for versions derived from git tags
+const VersionSourceGitTag = "git-tag"
+
+// LatestGitTag returns the latest tag reachable from HEAD, or an empty
+// string when the path is not inside a git repository or has no tags
+func LatestGitTag(projectPath string) string {
+	return LatestGitTagContext(context.Background(), projectPath)
+}
+
+// LatestGitTagContext is LatestGitTag with the git command bound to ctx
+func LatestGitTagContext(ctx context.Context, projectPath string) string {
+	return git.Default().Info(ctx, projectPath).LatestTag
+}
+
+// ApplyGitTagVersion fills in an empty Version from the latest reachable
+// git tag, stripping any leading "v". It is a no-op when the extractor
+// already found a version, outside a git repository, or when no tags
+// exist. Returns true when the version was set.
+func ApplyGitTagVersion(projectPath string, metadata *ProjectMetadata) bool {
+	return ApplyGitTagVersionContext(context.Background(), projectPath, metadata)
+}
+
+// ApplyGitTagVersionContext is ApplyGitTagVersion with the git lookup
+// bound to ctx
+func ApplyGitTagVersionContext(ctx context.Context, projectPath string, metadata *ProjectMetadata) bool {
+	if metadata == nil || metadata.Version != "" {
+		return false
+	}
+
+	tag := LatestGitTagContext(ctx, projectPath)
+	if tag == "" {
+		return false
+	}
+
+	metadata.Version = strings.TrimPrefix(tag, "v")
+	metadata.VersionSource = VersionSourceGitTag
+	return true
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"os/exec"
+	"testing"
+)
+
+// runGit runs a git command in dir, failing the test on error
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	base := []string{"-C", dir, "-c", "user.name=Test", "-c", "user.email=test@example.com", "-c", "commit.gpgsign=false", "-c", "tag.gpgsign=false"}
+	cmd := exec.Command("git", append(base, args...)...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %v failed: %v (%s)", args, err, output)
+	}
+}
+
+// TestApplyGitTagVersion tests deriving the version from the latest git tag
+func TestApplyGitTagVersion(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	runGit(t, dir, "init", "-q")
+	runGit(t, dir, "commit", "-q", "--allow-empty", "-m", "initial")
+	runGit(t, dir, "tag", "v1.4.2")
+
+	metadata := &ProjectMetadata{}
+	if !ApplyGitTagVersion(dir, metadata) {
+		t.Fatal("ApplyGitTagVersion should set the version")
+	}
+	if metadata.Version != "1.4.2" {
+		t.Errorf("Version = %v, want 1.4.2", metadata.Version)
+	}
+	if metadata.VersionSource != VersionSourceGitTag {
+		t.Errorf("VersionSource = %v, want %v", metadata.VersionSource, VersionSourceGitTag)
+	}
+}
+
+// TestApplyGitTagVersion_KeepsManifestVersion tests that existing versions are kept
+func TestApplyGitTagVersion_KeepsManifestVersion(t *testing.T) {
+	metadata := &ProjectMetadata{Version: "2.0.0", VersionSource: "Cargo.toml"}
+	if ApplyGitTagVersion(t.TempDir(), metadata) {
+		t.Error("ApplyGitTagVersion should not override a manifest version")
+	}
+	if metadata.Version != "2.0.0" || metadata.VersionSource != "Cargo.toml" {
+		t.Errorf("Metadata changed: %+v", metadata)
+	}
+}
+
+// TestApplyGitTagVersion_NoRepository tests the no-op outside a git repository
+func TestApplyGitTagVersion_NoRepository(t *testing.T) {
+	metadata := &ProjectMetadata{}
+	if ApplyGitTagVersion(t.TempDir(), metadata) {
+		t.Error("ApplyGitTagVersion should be a no-op outside a git repository")
+	}
+	if metadata.Version != "" || metadata.VersionSource != "" {
+		t.Errorf("Metadata changed: %+v", metadata)
+	}
+}
+
+// TestApplyGitTagVersion_NoTags tests the no-op for a repository without tags
+func TestApplyGitTagVersion_NoTags(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	runGit(t, dir, "init", "-q")
+	runGit(t, dir, "commit", "-q", "--allow-empty", "-m", "initial")
+
+	metadata := &ProjectMetadata{}
+	if ApplyGitTagVersion(dir, metadata) {
+		t.Error("ApplyGitTagVersion should be a no-op without tags")
+	}
+}
@@ -5,6 +5,7 @@ package golang
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -36,9 +37,17 @@ type GoMod struct {
 	Exclude      []string
 	Retract      []string
 	Toolchain    string
+	Tools        []string          // tool directives (Go 1.24+)
 	Dependencies map[string]string // module -> version
 }
 
+// GoWork represents the structure of a go.work file
+type GoWork struct {
+	GoVersion string
+	Toolchain string
+	Use       []string // module directories relative to go.work
+}
+
 // Dependency represents a Go module dependency
 type Dependency struct {
 	Module   string
@@ -54,27 +63,81 @@ type Replace struct {
 
 // Extract retrieves metadata from a Go project
 func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, error) {
+	return e.ExtractContext(context.Background(), projectPath)
+}
+
+// ExtractContext is Extract with the source tree walk bound to ctx
+func (e *Extractor) ExtractContext(ctx context.Context, projectPath string) (*extractor.ProjectMetadata, error) {
 	metadata := &extractor.ProjectMetadata{
 		LanguageSpecific: make(map[string]interface{}),
 	}
 
-	// Try go.mod file
 	goModPath := filepath.Join(projectPath, "go.mod")
-	if _, err := os.Stat(goModPath); err == nil {
-		if err := e.extractFromGoMod(goModPath, metadata); err != nil {
+	_, goModErr := os.Stat(goModPath)
+	goWorkPath := filepath.Join(projectPath, "go.work")
+	_, goWorkErr := os.Stat(goWorkPath)
+
+	if goModErr != nil && goWorkErr != nil {
+		return nil, fmt.Errorf("no go.mod file found in %s", projectPath)
+	}
+
+	// Try go.mod file
+	if goModErr == nil {
+		if err := e.extractFromGoMod(ctx, goModPath, metadata); err != nil {
 			return nil, err
 		}
-		return metadata, nil
+		extractor.RecordManifest(metadata, goModPath)
 	}
 
-	return nil, fmt.Errorf("no go.mod file found in %s", projectPath)
+	// A go.work file takes precedence: it decides the Go version and the
+	// set of modules built together. The local module path, if any, is
+	// kept in module_path.
+	if goWorkErr == nil {
+		if err := e.extractFromGoWork(goWorkPath, metadata); err != nil {
+			return nil, err
+		}
+		if goModErr != nil {
+			extractor.RecordManifest(metadata, goWorkPath)
+		}
+	}
+
+	return metadata, nil
+}
+
+// extractFromGoWork extracts workspace metadata from a go.work file
+func (e *Extractor) extractFromGoWork(path string, metadata *extractor.ProjectMetadata) error {
+	goWork, err := parseGoWork(path)
+	if err != nil {
+		return &extractor.ManifestParseError{Manifest: "go.work", Err: err}
+	}
+
+	// A workspace without a local module is named after its directory
+	if metadata.Name == "" {
+		metadata.Name = filepath.Base(filepath.Dir(path))
+	}
+
+	metadata.LanguageSpecific["is_workspace"] = true
+	metadata.LanguageSpecific["workspace_modules"] = goWork.Use
+	metadata.LanguageSpecific["workspace_module_count"] = len(goWork.Use)
+	metadata.LanguageSpecific["metadata_source"] = "go.work"
+
+	if goWork.Toolchain != "" {
+		metadata.LanguageSpecific["toolchain"] = goWork.Toolchain
+	}
+
+	if goWork.GoVersion != "" {
+		metadata.LanguageSpecific["go_version"] = goWork.GoVersion
+		applyGoVersionMatrix(metadata, goWork.GoVersion)
+	}
+
+	return nil
 }
 
 // extractFromGoMod extracts metadata from go.mod file
-func (e *Extractor) extractFromGoMod(path string, metadata *extractor.ProjectMetadata) error {
+func (e *Extractor) extractFromGoMod(ctx context.Context, path string, metadata *extractor.ProjectMetadata) error {
 	goMod, err := parseGoMod(path)
 	if err != nil {
-		return fmt.Errorf("failed to parse go.mod: %w", err)
+		return &extractor.ManifestParseError{Manifest: "go.mod", Err: err}
 	}
 
 	// Extract module path (this is the project name/import path)
@@ -151,6 +214,19 @@ func (e *Extractor) extractFromGoMod(path string, metadata *extractor.ProjectMet
 		metadata.LanguageSpecific["retract_count"] = len(goMod.Retract)
 	}
 
+	// Extract tool directives (Go 1.24+)
+	if len(goMod.Tools) > 0 {
+		metadata.LanguageSpecific["tools"] = goMod.Tools
+		metadata.LanguageSpecific["tool_count"] = len(goMod.Tools)
+	}
+
+	// Detect go:generate directives (codegen steps)
+	hasGenerate, err := hasGoGenerate(ctx, filepath.Dir(path))
+	if err != nil {
+		return err
+	}
+	metadata.LanguageSpecific["has_generate"] = hasGenerate
+
 	// Detect common Go frameworks and tools from dependencies
 	frameworks := detectGoFrameworks(goMod.Require)
 	if len(frameworks) > 0 {
@@ -159,15 +235,7 @@ func (e *Extractor) extractFromGoMod(path string, metadata *extractor.ProjectMet
 
 	// Generate Go version matrix
 	if goMod.GoVersion != "" {
-		matrix := generateGoVersionMatrix(goMod.GoVersion)
-		if len(matrix) > 0 {
-			metadata.LanguageSpecific["go_version_matrix"] = matrix
-
-			// Convert to JSON for easy use in GitHub Actions
-			matrixJSON := fmt.Sprintf(`{"go-version": [%s]}`,
-				strings.Join(quoteStrings(matrix), ", "))
-			metadata.LanguageSpecific["matrix_json"] = matrixJSON
-		}
+		applyGoVersionMatrix(metadata, goMod.GoVersion)
 	}
 
 	// Try to extract version from common patterns
@@ -192,6 +260,84 @@ func (e *Extractor) extractFromGoMod(path string, metadata *extractor.ProjectMet
 	return nil
 }
 
+// applyGoVersionMatrix sets go_version_matrix and matrix_json from goVersion
+func applyGoVersionMatrix(metadata *extractor.ProjectMetadata, goVersion string) {
+	matrix := generateGoVersionMatrix(goVersion)
+	if len(matrix) == 0 {
+		return
+	}
+	metadata.LanguageSpecific["go_version_matrix"] = matrix
+
+	// Convert to JSON for easy use in GitHub Actions
+	matrixJSON := fmt.Sprintf(`{"go-version": [%s]}`,
+		strings.Join(quoteStrings(matrix), ", "))
+	metadata.LanguageSpecific["matrix_json"] = matrixJSON
+	extractor.ApplyOSMatrix(metadata, "go-version", matrix)
+}
+
+// parseGoWork parses a go.work file and returns its structure. Module
+// directories are returned as written, minus quotes and comments.
+func parseGoWork(path string) (*GoWork, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	goWork := &GoWork{Use: []string{}}
+
+	goVersionRe := regexp.MustCompile(`^go\s+(\d+\.\d+(?:\.\d+)?)$`)
+	toolchainRe := regexp.MustCompile(`^toolchain\s+(.+)$`)
+	useRe := regexp.MustCompile(`^use\s+(.+)$`)
+
+	scanner := bufio.NewScanner(file)
+	inUse := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		if idx := strings.Index(line, "//"); idx != -1 {
+			line = line[:idx]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if inUse {
+			if line == ")" {
+				inUse = false
+			} else {
+				goWork.Use = append(goWork.Use, strings.Trim(line, `"`+"`"))
+			}
+			continue
+		}
+
+		if matches := goVersionRe.FindStringSubmatch(line); len(matches) > 1 {
+			goWork.GoVersion = matches[1]
+			continue
+		}
+
+		if matches := toolchainRe.FindStringSubmatch(line); len(matches) > 1 {
+			goWork.Toolchain = strings.TrimSpace(matches[1])
+			continue
+		}
+
+		if matches := useRe.FindStringSubmatch(line); len(matches) > 1 {
+			rest := strings.TrimSpace(matches[1])
+			if rest == "(" {
+				inUse = true
+			} else {
+				goWork.Use = append(goWork.Use, strings.Trim(rest, `"`+"`"))
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return goWork, nil
+}
+
 // parseGoMod parses a go.mod file and returns its structure
 func parseGoMod(path string) (*GoMod, error) {
 	file, err := os.Open(path)
@@ -215,6 +361,7 @@ func parseGoMod(path string) (*GoMod, error) {
 	replaceRe := regexp.MustCompile(`^replace\s+(.+)$`)
 	excludeRe := regexp.MustCompile(`^exclude\s+(.+)$`)
 	retractRe := regexp.MustCompile(`^retract\s+(.+)$`)
+	toolRe := regexp.MustCompile(`^tool\s+(.+)$`)
 
 	for scanner.Scan() {
 		line := strings.TrimSpace(scanner.Text())
@@ -236,6 +383,8 @@ func parseGoMod(path string) (*GoMod, error) {
 				goMod.Exclude = append(goMod.Exclude, parseExcludeBlock(blockLines)...)
 			case "retract":
 				goMod.Retract = append(goMod.Retract, parseRetractBlock(blockLines)...)
+			case "tool":
+				goMod.Tools = append(goMod.Tools, parseToolBlock(blockLines)...)
 			}
 			inBlock = ""
 			blockLines = nil
@@ -312,6 +461,18 @@ func parseGoMod(path string) (*GoMod, error) {
 			}
 			continue
 		}
+
+		if matches := toolRe.FindStringSubmatch(line); len(matches) > 1 {
+			rest := strings.TrimSpace(matches[1])
+			if rest == "(" {
+				inBlock = "tool"
+				blockLines = []string{}
+			} else {
+				// Single-line tool
+				goMod.Tools = append(goMod.Tools, parseToolBlock([]string{rest})...)
+			}
+			continue
+		}
 	}
 
 	if err := scanner.Err(); err != nil {
@@ -414,6 +575,67 @@ func parseRetractBlock(lines []string) []string {
 	return retracts
 }
 
+// parseToolBlock parses a block of tool statements
+func parseToolBlock(lines []string) []string {
+	tools := []string{}
+	for _, line := range lines {
+		if idx := strings.Index(line, "//"); idx != -1 {
+			line = line[:idx]
+		}
+		if trimmed := strings.TrimSpace(line); trimmed != "" {
+			tools = append(tools, trimmed)
+		}
+	}
+	return tools
+}
+
+// hasGoGenerate reports whether any Go source file in the project
+// contains a //go:generate directive. Vendored, testdata and hidden
+// directories are skipped. The walk stops with ctx's error once ctx is
+// done.
+func hasGoGenerate(ctx context.Context, projectPath string) (bool, error) {
+	found := false
+
+	err := filepath.WalkDir(projectPath, func(path string, d os.DirEntry, err error) error {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if err != nil {
+			return nil
+		}
+
+		if d.IsDir() {
+			name := d.Name()
+			if path != projectPath && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return nil
+		}
+		defer file.Close()
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			if strings.HasPrefix(scanner.Text(), "//go:generate ") {
+				found = true
+				return filepath.SkipAll
+			}
+		}
+
+		return nil
+	})
+
+	return found, err
+}
+
 // detectGoFrameworks detects common Go frameworks from dependencies
 func detectGoFrameworks(deps []Dependency) []string {
 	frameworks := []string{}
@@ -523,9 +745,11 @@ func extractVersionFromProject(projectPath string) string {
 
 // Detect checks if this extractor can handle the project
 func (e *Extractor) Detect(projectPath string) bool {
-	// Check for go.mod
-	if _, err := os.Stat(filepath.Join(projectPath, "go.mod")); err == nil {
-		return true
+	// Check for go.mod or a go.work workspace
+	for _, file := range []string{"go.mod", "go.work"} {
+		if extractor.FileExists(projectPath, file) {
+			return true
+		}
 	}
 
 	return false
@@ -4,6 +4,8 @@
 package golang
 
 import (
+	"context"
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -573,6 +575,202 @@ toolchain go1.21.5
 	}
 }
 
+// TestToolDirectives tests parsing of Go 1.24 tool directives
+func TestToolDirectives(t *testing.T) {
+	goModContent := `module github.com/example/project
+
+go 1.24
+
+tool golang.org/x/tools/cmd/stringer
+
+tool (
+	github.com/golangci/golangci-lint/cmd/golangci-lint
+	golang.org/x/vuln/cmd/govulncheck // security scanning
+)
+`
+
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "go.mod"), []byte(goModContent), 0644); err != nil {
+		t.Fatalf("Failed to write go.mod: %v", err)
+	}
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	tools, ok := metadata.LanguageSpecific["tools"].([]string)
+	if !ok {
+		t.Fatalf("tools not set: %v", metadata.LanguageSpecific["tools"])
+	}
+
+	expected := []string{
+		"golang.org/x/tools/cmd/stringer",
+		"github.com/golangci/golangci-lint/cmd/golangci-lint",
+		"golang.org/x/vuln/cmd/govulncheck",
+	}
+	if len(tools) != len(expected) {
+		t.Fatalf("tools = %v, expected %v", tools, expected)
+	}
+	for i := range expected {
+		if tools[i] != expected[i] {
+			t.Errorf("tools[%d] = %s, expected %s", i, tools[i], expected[i])
+		}
+	}
+
+	if metadata.LanguageSpecific["has_generate"] != false {
+		t.Errorf("has_generate = %v, expected false", metadata.LanguageSpecific["has_generate"])
+	}
+}
+
+// TestGoGenerate tests detection of //go:generate directives
+func TestGoGenerate(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "go.mod"), []byte("module example.com/gen\n\ngo 1.22\n"), 0644); err != nil {
+		t.Fatalf("Failed to write go.mod: %v", err)
+	}
+
+	pkgDir := filepath.Join(tmpDir, "internal", "color")
+	if err := os.MkdirAll(pkgDir, 0755); err != nil {
+		t.Fatalf("Failed to create package dir: %v", err)
+	}
+	source := `package color
+
+//go:generate stringer -type=Color
+
+type Color int
+`
+	if err := os.WriteFile(filepath.Join(pkgDir, "color.go"), []byte(source), 0644); err != nil {
+		t.Fatalf("Failed to write color.go: %v", err)
+	}
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	if metadata.LanguageSpecific["has_generate"] != true {
+		t.Errorf("has_generate = %v, expected true", metadata.LanguageSpecific["has_generate"])
+	}
+}
+
+// TestExtractContextCancelled verifies the source tree walk stops once
+// the context is cancelled
+func TestExtractContextCancelled(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "go.mod"), []byte("module example.com/slow\n\ngo 1.22\n"), 0644); err != nil {
+		t.Fatalf("Failed to write go.mod: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := NewExtractor().ExtractContext(ctx, tmpDir); !errors.Is(err, context.Canceled) {
+		t.Errorf("ExtractContext() error = %v, expected context.Canceled", err)
+	}
+}
+
+// TestGoWorkspace verifies go.work parsing with two modules
+func TestGoWorkspace(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"go.work": `go 1.22
+
+toolchain go1.23.4
+
+use (
+	./services/api // HTTP API
+	"./services/worker"
+)
+
+use ./tools
+`,
+		"go.mod":                    "module github.com/example/monorepo\n\ngo 1.21\n",
+		"services/api/go.mod":       "module github.com/example/monorepo/services/api\n\ngo 1.22\n",
+		"services/worker/go.mod":    "module github.com/example/monorepo/services/worker\n\ngo 1.22\n",
+		"tools/go.mod":              "module github.com/example/monorepo/tools\n\ngo 1.22\n",
+		"services/api/main.go":      "package main\n",
+		"services/worker/worker.go": "package worker\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	e := NewExtractor()
+	metadata, err := e.Extract(tmpDir)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	if metadata.LanguageSpecific["is_workspace"] != true {
+		t.Errorf("is_workspace = %v, want true", metadata.LanguageSpecific["is_workspace"])
+	}
+
+	modules, ok := metadata.LanguageSpecific["workspace_modules"].([]string)
+	expected := []string{"./services/api", "./services/worker", "./tools"}
+	if !ok || len(modules) != len(expected) {
+		t.Fatalf("workspace_modules = %v, want %v", metadata.LanguageSpecific["workspace_modules"], expected)
+	}
+	for i := range expected {
+		if modules[i] != expected[i] {
+			t.Errorf("workspace_modules[%d] = %v, want %v", i, modules[i], expected[i])
+		}
+	}
+
+	if goVersion := metadata.LanguageSpecific["go_version"]; goVersion != "1.22" {
+		t.Errorf("go_version = %v, want 1.22 from go.work", goVersion)
+	}
+	if toolchain := metadata.LanguageSpecific["toolchain"]; toolchain != "go1.23.4" {
+		t.Errorf("toolchain = %v, want go1.23.4", toolchain)
+	}
+	if modulePath := metadata.LanguageSpecific["module_path"]; modulePath != "github.com/example/monorepo" {
+		t.Errorf("module_path = %v, want github.com/example/monorepo", modulePath)
+	}
+	if source := metadata.LanguageSpecific["metadata_source"]; source != "go.work" {
+		t.Errorf("metadata_source = %v, want go.work", source)
+	}
+
+	matrix, ok := metadata.LanguageSpecific["go_version_matrix"].([]string)
+	if !ok || len(matrix) == 0 || matrix[0] != "1.22" {
+		t.Errorf("go_version_matrix = %v, want it to start at 1.22", matrix)
+	}
+}
+
+// TestGoWorkspaceWithoutModule verifies a go.work-only workspace root
+func TestGoWorkspaceWithoutModule(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "go.work"), []byte("go 1.22\n\nuse ./a\nuse ./b\n"), 0644); err != nil {
+		t.Fatalf("Failed to write go.work: %v", err)
+	}
+
+	e := NewExtractor()
+	if !e.Detect(tmpDir) {
+		t.Fatal("Detect() should accept a go.work workspace")
+	}
+
+	metadata, err := e.Extract(tmpDir)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	if metadata.Name != filepath.Base(tmpDir) {
+		t.Errorf("Name = %v, want %v", metadata.Name, filepath.Base(tmpDir))
+	}
+	if count := metadata.LanguageSpecific["workspace_module_count"]; count != 2 {
+		t.Errorf("workspace_module_count = %v, want 2", count)
+	}
+	if _, ok := metadata.LanguageSpecific["module_path"]; ok {
+		t.Error("module_path should not be set without a go.mod")
+	}
+}
+
 // TestNoGoMod tests behavior when no go.mod exists
 func TestNoGoMod(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "go-extractor-test-*")
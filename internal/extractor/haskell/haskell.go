@@ -32,31 +32,29 @@ func init() {
 // Detect checks if this is a Haskell project
 func (e *Extractor) Detect(projectPath string) bool {
 	// Check for .cabal file
-	matches, err := filepath.Glob(filepath.Join(projectPath, "*.cabal"))
-	if err == nil && len(matches) > 0 {
+	if len(extractor.GlobFiles(projectPath, "*.cabal")) > 0 {
 		return true
 	}
 
 	// Check for stack.yaml
-	if _, err := os.Stat(filepath.Join(projectPath, "stack.yaml")); err == nil {
+	if extractor.FileExists(projectPath, "stack.yaml") {
 		return true
 	}
 
 	// Check for package.yaml (hpack)
-	if _, err := os.Stat(filepath.Join(projectPath, "package.yaml")); err == nil {
+	if extractor.FileExists(projectPath, "package.yaml") {
 		return true
 	}
 
 	// Check for cabal.project
-	if _, err := os.Stat(filepath.Join(projectPath, "cabal.project")); err == nil {
+	if extractor.FileExists(projectPath, "cabal.project") {
 		return true
 	}
 
 	// Check for Haskell source files
 	srcDir := filepath.Join(projectPath, "src")
 	if info, err := os.Stat(srcDir); err == nil && info.IsDir() {
-		matches, err := filepath.Glob(filepath.Join(srcDir, "*.hs"))
-		if err == nil && len(matches) > 0 {
+		if len(extractor.GlobFiles(srcDir, "*.hs")) > 0 {
 			return true
 		}
 	}
@@ -75,6 +73,7 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 	if err == nil && len(cabalFiles) > 0 {
 		if err := e.extractFromCabal(cabalFiles[0], metadata); err == nil {
 			metadata.LanguageSpecific["build_tool"] = "Cabal"
+			extractor.RecordManifest(metadata, cabalFiles[0])
 		}
 	}
 
@@ -78,6 +78,7 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 	if err := e.extractFromChartYAML(chartPath, metadata); err != nil {
 		return nil, err
 	}
+	extractor.RecordManifest(metadata, chartPath)
 
 	return metadata, nil
 }
@@ -91,7 +92,7 @@ func (e *Extractor) extractFromChartYAML(path string, metadata *extractor.Projec
 
 	var chart ChartYAML
 	if err := yaml.Unmarshal(content, &chart); err != nil {
-		return fmt.Errorf("failed to parse Chart.yaml: %w", err)
+		return &extractor.ManifestParseError{Manifest: "Chart.yaml", Err: err}
 	}
 
 	// Extract common metadata
@@ -176,6 +177,7 @@ func (e *Extractor) extractFromChartYAML(path string, metadata *extractor.Projec
 			matrixJSON := fmt.Sprintf(`{"kubernetes-version": [%s]}`,
 				strings.Join(quoteStrings(matrix), ", "))
 			metadata.LanguageSpecific["matrix_json"] = matrixJSON
+			extractor.ApplyOSMatrix(metadata, "kubernetes-version", matrix)
 		}
 	}
 
@@ -185,6 +187,7 @@ func (e *Extractor) extractFromChartYAML(path string, metadata *extractor.Projec
 		chartType = "application" // Default type
 	}
 	metadata.LanguageSpecific["is_library_chart"] = (chartType == "library")
+	metadata.LanguageSpecific["is_library"] = (chartType == "library")
 
 	return nil
 }
@@ -192,8 +195,7 @@ func (e *Extractor) extractFromChartYAML(path string, metadata *extractor.Projec
 // Detect checks if this extractor can handle the project
 func (e *Extractor) Detect(projectPath string) bool {
 	// Check for Chart.yaml
-	chartPath := filepath.Join(projectPath, "Chart.yaml")
-	if _, err := os.Stat(chartPath); err == nil {
+	if extractor.FileExists(projectPath, "Chart.yaml") {
 		return true
 	}
 
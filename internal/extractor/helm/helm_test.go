@@ -118,6 +118,7 @@ sources:
 	assert.Equal(t, "1.0", metadata.LanguageSpecific["app_version"])
 	assert.Equal(t, "application", metadata.LanguageSpecific["chart_type"])
 	assert.Equal(t, false, metadata.LanguageSpecific["is_library_chart"])
+	assert.Equal(t, false, metadata.LanguageSpecific["is_library"])
 }
 
 func TestExtractor_Extract_WithDependencies(t *testing.T) {
@@ -178,6 +179,7 @@ type: library`
 
 	assert.Equal(t, "library", metadata.LanguageSpecific["chart_type"])
 	assert.Equal(t, true, metadata.LanguageSpecific["is_library_chart"])
+	assert.Equal(t, true, metadata.LanguageSpecific["is_library"])
 }
 
 func TestExtractor_Extract_WithKubeVersion(t *testing.T) {
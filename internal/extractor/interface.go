@@ -4,9 +4,15 @@
 package extractor
 
 import (
+	"context"
 	"fmt"
+	"sort"
+	"time"
 )
 
+// DefaultExtractTimeout bounds a single extractor run
+const DefaultExtractTimeout = 30 * time.Second
+
 // ProjectMetadata contains metadata extracted from a project
 type ProjectMetadata struct {
 	// Common fields
@@ -15,6 +21,7 @@ type ProjectMetadata struct {
 	VersionSource string
 	Description   string
 	License       string
+	LicenseSource string // Empty when read from the manifest
 	Authors       []string
 	Homepage      string
 	Repository    string
@@ -22,6 +29,11 @@ type ProjectMetadata struct {
 	// Language-specific metadata stored as key-value pairs
 	// Keys should be namespaced by language (e.g., "python_requires_python")
 	LanguageSpecific map[string]interface{}
+
+	// Human-readable notes about data that may be incomplete, e.g. when a
+	// manifest could only be partially parsed. Unlike an Extract error,
+	// the metadata is still usable.
+	Warnings []string
 }
 
 // Extractor is the interface that all language-specific extractors must implement
@@ -29,6 +41,11 @@ type Extractor interface {
 	// Extract retrieves metadata from the project at the given path
 	Extract(projectPath string) (*ProjectMetadata, error)
 
+	// ExtractContext is Extract bound to ctx. Long-running work (git
+	// commands, tree walks) checks ctx and returns its error once ctx is
+	// done. BaseExtractor provides a default that calls Extract.
+	ExtractContext(ctx context.Context, projectPath string) (*ProjectMetadata, error)
+
 	// Detect checks if this extractor can handle the project at the given path
 	Detect(projectPath string) bool
 
@@ -42,17 +59,20 @@ type Extractor interface {
 // Registry maintains a collection of available extractors
 type Registry struct {
 	extractors map[string]Extractor
+	disabled   map[string]bool
 }
 
 // NewRegistry creates a new extractor registry
 func NewRegistry() *Registry {
 	return &Registry{
 		extractors: make(map[string]Extractor),
+		disabled:   make(map[string]bool),
 	}
 }
 
 // Register adds an extractor to the registry
 func (r *Registry) Register(extractor Extractor) {
+	bindBase(extractor)
 	r.extractors[extractor.Name()] = extractor
 }
 
@@ -62,14 +82,94 @@ func (r *Registry) Get(name string) (Extractor, error) {
 	if !ok {
 		return nil, fmt.Errorf("no extractor found for type: %s", name)
 	}
+	if r.disabled[name] {
+		return nil, fmt.Errorf("extractor %s is disabled", name)
+	}
 	return extractor, nil
 }
 
-// GetAll returns all registered extractors
+// Disable excludes the named extractor from Get, GetAll and Enabled until
+// Enable is called. Registering an extractor does not clear the flag.
+func (r *Registry) Disable(name string) {
+	r.disabled[name] = true
+}
+
+// Enable reverses Disable
+func (r *Registry) Enable(name string) {
+	delete(r.disabled, name)
+}
+
+// IsDisabled reports whether the named extractor has been disabled
+func (r *Registry) IsDisabled(name string) bool {
+	return r.disabled[name]
+}
+
+// Enabled returns the names of the enabled extractors in the order they
+// are tried: highest priority first, then by name
+func (r *Registry) Enabled() []string {
+	extractors := r.GetAll()
+	sort.Slice(extractors, func(i, j int) bool {
+		if extractors[i].Priority() != extractors[j].Priority() {
+			return extractors[i].Priority() > extractors[j].Priority()
+		}
+		return extractors[i].Name() < extractors[j].Name()
+	})
+
+	names := make([]string, 0, len(extractors))
+	for _, e := range extractors {
+		names = append(names, e.Name())
+	}
+	return names
+}
+
+// SafeExtract runs the extractor against projectPath, converting a panic
+// inside the extractor into an error naming the extractor and path so a
+// single malformed manifest cannot abort the whole run
+func SafeExtract(e Extractor, projectPath string) (metadata *ProjectMetadata, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			metadata = nil
+			err = fmt.Errorf("extractor %s panicked while extracting %s: %v", e.Name(), projectPath, r)
+		}
+	}()
+
+	return e.Extract(projectPath)
+}
+
+// ExtractContext runs the extractor's ExtractContext against projectPath
+// and waits for it to return, so no extraction is left running once the
+// caller moves on. Work that stops because ctx is done is reported as an
+// error naming the extractor. Panics are recovered as in SafeExtract.
+func ExtractContext(ctx context.Context, e Extractor, projectPath string) (metadata *ProjectMetadata, err error) {
+	bindBase(e)
+	defer func() {
+		if r := recover(); r != nil {
+			metadata = nil
+			err = fmt.Errorf("extractor %s panicked while extracting %s: %v", e.Name(), projectPath, r)
+		}
+	}()
+
+	metadata, err = e.ExtractContext(ctx, projectPath)
+	if err != nil && ctx.Err() != nil {
+		return nil, fmt.Errorf("extractor %s did not finish extracting %s: %w", e.Name(), projectPath, err)
+	}
+	return metadata, err
+}
+
+// ExtractWithTimeout runs ExtractContext with DefaultExtractTimeout
+func ExtractWithTimeout(e Extractor, projectPath string) (*ProjectMetadata, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultExtractTimeout)
+	defer cancel()
+	return ExtractContext(ctx, e, projectPath)
+}
+
+// GetAll returns all registered extractors that are not disabled
 func (r *Registry) GetAll() []Extractor {
 	extractors := make([]Extractor, 0, len(r.extractors))
-	for _, e := range r.extractors {
-		extractors = append(extractors, e)
+	for name, e := range r.extractors {
+		if !r.disabled[name] {
+			extractors = append(extractors, e)
+		}
 	}
 	return extractors
 }
@@ -119,7 +219,7 @@ func mapProjectTypeToExtractor(projectType string) string {
 	}
 
 	// Handle Go variants
-	if projectType == "go-module" {
+	if projectType == "go-module" || projectType == "go-workspace" {
 		return "go-module"
 	}
 
@@ -168,8 +268,28 @@ func mapProjectTypeToExtractor(projectType string) string {
 		return "julia"
 	}
 
+	// Handle R variants
+	if projectType == "r-package" {
+		return "r"
+	}
+
+	// Handle Perl variants
+	if projectType == "perl-cpan" || projectType == "perl-module-build" || projectType == "perl-cpanfile" {
+		return "perl"
+	}
+
+	// Handle Zig variants
+	if projectType == "zig-package" || projectType == "zig-build" {
+		return "zig"
+	}
+
+	// Handle Nim variants
+	if projectType == "nim-nimble" {
+		return "nim"
+	}
+
 	// Handle C/C++ variants
-	if projectType == "c-cmake" || projectType == "c-qmake" || projectType == "c-autoconf" || projectType == "c-autoconf-legacy" || projectType == "c-meson" {
+	if projectType == "c-cmake" || projectType == "c-qmake" || projectType == "c-autoconf" || projectType == "c-autoconf-legacy" || projectType == "c-meson" || projectType == "c-conan" {
 		return "cpp"
 	}
 
@@ -188,19 +308,49 @@ func mapProjectTypeToExtractor(projectType string) string {
 		return "terraform"
 	}
 
+	// Handle OpenAPI/Swagger specs
+	if projectType == "openapi-spec" {
+		return "openapi"
+	}
+
 	// Return original if no mapping found
 	return projectType
 }
 
-// GetAllExtractors returns all registered extractors
+// GetAllExtractors returns all registered extractors that are not disabled
 func GetAllExtractors() []Extractor {
 	return globalRegistry.GetAll()
 }
 
+// DisableExtractor disables an extractor in the global registry. The name
+// may be an extractor name ("cpp") or a detector project type
+// ("c-cmake"). Use EnableExtractor to undo.
+func DisableExtractor(name string) {
+	globalRegistry.Disable(mapProjectTypeToExtractor(name))
+}
+
+// EnableExtractor re-enables an extractor disabled with DisableExtractor
+func EnableExtractor(name string) {
+	globalRegistry.Enable(mapProjectTypeToExtractor(name))
+}
+
+// IsExtractorDisabled reports whether the extractor handling the given
+// extractor name or project type is disabled
+func IsExtractorDisabled(name string) bool {
+	return globalRegistry.IsDisabled(mapProjectTypeToExtractor(name))
+}
+
+// EnabledExtractors returns the names of the enabled extractors in the
+// global registry, highest priority first
+func EnabledExtractors() []string {
+	return globalRegistry.Enabled()
+}
+
 // BaseExtractor provides common functionality for all extractors
 type BaseExtractor struct {
 	name     string
 	priority int
+	self     Extractor // The embedding extractor, bound on registration
 }
 
 // NewBaseExtractor creates a new base extractor
@@ -220,3 +370,32 @@ func (b *BaseExtractor) Name() string {
 func (b *BaseExtractor) Priority() int {
 	return b.priority
 }
+
+// ExtractContext is the default context-aware extraction: it returns the
+// context's error when ctx is already done and otherwise calls the
+// embedding extractor's Extract. Extractors with long-running work
+// override it to check ctx as they go.
+func (b *BaseExtractor) ExtractContext(ctx context.Context, projectPath string) (*ProjectMetadata, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if b.self == nil {
+		return nil, fmt.Errorf("extractor %s is not registered", b.name)
+	}
+	return b.self.Extract(projectPath)
+}
+
+// base gives the registry access to an embedded BaseExtractor
+func (b *BaseExtractor) base() *BaseExtractor {
+	return b
+}
+
+// bindBase records e as the extractor embedding its BaseExtractor, so the
+// default ExtractContext can call e's own Extract
+func bindBase(e Extractor) {
+	if embedder, ok := e.(interface{ base() *BaseExtractor }); ok {
+		if b := embedder.base(); b.self == nil {
+			b.self = e
+		}
+	}
+}
@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// panickingExtractor panics from Extract to exercise SafeExtract
+type panickingExtractor struct {
+	BaseExtractor
+}
+
+func (p *panickingExtractor) Extract(projectPath string) (*ProjectMetadata, error) {
+	var languageSpecific map[string]interface{}
+	languageSpecific["boom"] = true
+	return nil, nil
+}
+
+func (p *panickingExtractor) Detect(projectPath string) bool {
+	return true
+}
+
+// TestSafeExtract_RecoversPanic tests that a panicking extractor yields an error
+func TestSafeExtract_RecoversPanic(t *testing.T) {
+	RegisterExtractor(&panickingExtractor{BaseExtractor: NewBaseExtractor("panicky", 1)})
+	t.Cleanup(func() {
+		delete(globalRegistry.extractors, "panicky")
+	})
+
+	impl, err := GetExtractor("panicky")
+	if err != nil {
+		t.Fatalf("GetExtractor failed: %v", err)
+	}
+
+	projectPath := t.TempDir()
+	metadata, err := SafeExtract(impl, projectPath)
+	if err == nil {
+		t.Fatal("SafeExtract should return an error when the extractor panics")
+	}
+	if metadata != nil {
+		t.Errorf("Metadata = %+v, want nil", metadata)
+	}
+	for _, want := range []string{"panicky", projectPath, "panicked"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Error %q should mention %q", err.Error(), want)
+		}
+	}
+}
+
+// TestSafeExtract_PassesThrough tests that normal results are returned unchanged
+func TestSafeExtract_PassesThrough(t *testing.T) {
+	impl := &manifestExtractor{BaseExtractor: NewBaseExtractor("manifest", 1)}
+
+	metadata, err := SafeExtract(impl, t.TempDir())
+	if err != nil {
+		t.Fatalf("SafeExtract failed: %v", err)
+	}
+	if metadata == nil {
+		t.Error("Metadata should not be nil")
+	}
+}
+
+// countingExtractor counts its Extract calls
+type countingExtractor struct {
+	BaseExtractor
+	calls int
+}
+
+func (c *countingExtractor) Extract(projectPath string) (*ProjectMetadata, error) {
+	c.calls++
+	return &ProjectMetadata{}, nil
+}
+
+func (c *countingExtractor) Detect(projectPath string) bool {
+	return true
+}
+
+// cancellableExtractor overrides ExtractContext and stops on cancellation
+type cancellableExtractor struct {
+	BaseExtractor
+	cancelled chan struct{}
+}
+
+func (c *cancellableExtractor) Extract(projectPath string) (*ProjectMetadata, error) {
+	return c.ExtractContext(context.Background(), projectPath)
+}
+
+func (c *cancellableExtractor) ExtractContext(ctx context.Context, projectPath string) (*ProjectMetadata, error) {
+	<-ctx.Done()
+	close(c.cancelled)
+	return nil, ctx.Err()
+}
+
+func (c *cancellableExtractor) Detect(projectPath string) bool {
+	return true
+}
+
+// TestExtractContext_DefaultCallsExtract tests that the BaseExtractor
+// default forwards to the embedding extractor's Extract
+func TestExtractContext_DefaultCallsExtract(t *testing.T) {
+	impl := &countingExtractor{BaseExtractor: NewBaseExtractor("counting", 1)}
+
+	metadata, err := ExtractContext(context.Background(), impl, t.TempDir())
+	if err != nil {
+		t.Fatalf("ExtractContext() error = %v", err)
+	}
+	if metadata == nil {
+		t.Error("Metadata should not be nil")
+	}
+	if impl.calls != 1 {
+		t.Errorf("Extract calls = %d, want 1", impl.calls)
+	}
+}
+
+// TestExtractContext_DefaultSkipsWhenDone tests that the default does not
+// start extracting once the context is done
+func TestExtractContext_DefaultSkipsWhenDone(t *testing.T) {
+	impl := &countingExtractor{BaseExtractor: NewBaseExtractor("counting", 1)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	<-ctx.Done()
+
+	metadata, err := ExtractContext(ctx, impl, t.TempDir())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("ExtractContext() error = %v, want deadline exceeded", err)
+	}
+	if metadata != nil {
+		t.Errorf("Metadata = %+v, want nil", metadata)
+	}
+	if !strings.Contains(err.Error(), "counting") {
+		t.Errorf("Error %q should name the extractor", err.Error())
+	}
+	if impl.calls != 0 {
+		t.Errorf("Extract calls = %d, want 0", impl.calls)
+	}
+}
+
+// TestExtractContext_WaitsForCancelledExtractor tests that context-aware
+// extractors see the cancellation and are waited for
+func TestExtractContext_WaitsForCancelledExtractor(t *testing.T) {
+	impl := &cancellableExtractor{BaseExtractor: NewBaseExtractor("cancellable", 1), cancelled: make(chan struct{})}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := ExtractContext(ctx, impl, t.TempDir()); !errors.Is(err, context.Canceled) {
+		t.Fatalf("ExtractContext() error = %v, want context canceled", err)
+	}
+
+	select {
+	case <-impl.cancelled:
+	default:
+		t.Error("ExtractContext should return only after the extractor has stopped")
+	}
+}
+
+// TestExtractWithTimeout_Completes tests the default-timeout path
+func TestExtractWithTimeout_Completes(t *testing.T) {
+	impl := &manifestExtractor{BaseExtractor: NewBaseExtractor("manifest", 1)}
+
+	metadata, err := ExtractWithTimeout(impl, t.TempDir())
+	if err != nil {
+		t.Fatalf("ExtractWithTimeout() error = %v", err)
+	}
+	if metadata == nil {
+		t.Error("Metadata should not be nil")
+	}
+}
+
+// TestDisableExtractor tests that disabled extractors are skipped and can be re-enabled
+func TestDisableExtractor(t *testing.T) {
+	RegisterExtractor(&manifestExtractor{BaseExtractor: NewBaseExtractor("toggle", 1)})
+	t.Cleanup(func() {
+		EnableExtractor("toggle")
+		delete(globalRegistry.extractors, "toggle")
+	})
+
+	DisableExtractor("toggle")
+	if !IsExtractorDisabled("toggle") {
+		t.Error("IsExtractorDisabled should report a disabled extractor")
+	}
+	if _, err := GetExtractor("toggle"); err == nil || !strings.Contains(err.Error(), "disabled") {
+		t.Errorf("GetExtractor error = %v, want disabled error", err)
+	}
+	for _, name := range EnabledExtractors() {
+		if name == "toggle" {
+			t.Error("EnabledExtractors should not list a disabled extractor")
+		}
+	}
+	for _, e := range GetAllExtractors() {
+		if e.Name() == "toggle" {
+			t.Error("GetAllExtractors should not return a disabled extractor")
+		}
+	}
+
+	EnableExtractor("toggle")
+	if _, err := GetExtractor("toggle"); err != nil {
+		t.Errorf("GetExtractor after EnableExtractor failed: %v", err)
+	}
+}
+
+// TestDisableExtractor_ProjectType tests disabling by detector project type
+func TestDisableExtractor_ProjectType(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(&manifestExtractor{BaseExtractor: NewBaseExtractor("cpp", 1)})
+
+	registry.Disable(mapProjectTypeToExtractor("c-cmake"))
+	if !registry.IsDisabled("cpp") {
+		t.Error("Disabling c-cmake should disable the cpp extractor")
+	}
+	if got := mapProjectTypeToExtractor("c-conan"); got != "cpp" {
+		t.Errorf("mapProjectTypeToExtractor(c-conan) = %q, want cpp", got)
+	}
+	registry.Enable("cpp")
+	if registry.IsDisabled("cpp") {
+		t.Error("Enable should clear the disabled flag")
+	}
+}
+
+// TestRegistryEnabled_Order tests that enabled extractors are ordered by priority, then name
+func TestRegistryEnabled_Order(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(&manifestExtractor{BaseExtractor: NewBaseExtractor("low", 1)})
+	registry.Register(&manifestExtractor{BaseExtractor: NewBaseExtractor("beta", 5)})
+	registry.Register(&manifestExtractor{BaseExtractor: NewBaseExtractor("alpha", 5)})
+	registry.Register(&manifestExtractor{BaseExtractor: NewBaseExtractor("high", 9)})
+	registry.Disable("beta")
+
+	got := strings.Join(registry.Enabled(), ",")
+	if want := "high,alpha,low"; got != want {
+		t.Errorf("Enabled() = %v, want %v", got, want)
+	}
+}
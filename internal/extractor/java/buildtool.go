@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package java
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+)
+
+// Build tools accepted by SetPreferredBuildTool
+const (
+	BuildToolMaven  = "maven"
+	BuildToolGradle = "gradle"
+)
+
+// buildFileNames are the Java build files, in detector order
+var buildFileNames = []string{"pom.xml", "build.gradle", "build.gradle.kts"}
+
+// preferredBuildTool selects between Maven and Gradle when a project has
+// both build files. It is package-scoped because the Extractor.Extract
+// signature is fixed; cmd/build-metadata/main.go sets it from the
+// preferred_build_tool input. Empty selects the most recently modified
+// build file.
+var preferredBuildTool string
+
+// SetPreferredBuildTool sets the build tool used when both Maven and
+// Gradle build files are present: "maven", "gradle", or "" for the most
+// recently modified build file. Other values are rejected and clear the
+// preference.
+func SetPreferredBuildTool(tool string) error {
+	tool = strings.ToLower(strings.TrimSpace(tool))
+	switch tool {
+	case "", BuildToolMaven, BuildToolGradle:
+		preferredBuildTool = tool
+		return nil
+	}
+	preferredBuildTool = ""
+	return fmt.Errorf("unknown build tool %q, expected %s or %s", tool, BuildToolMaven, BuildToolGradle)
+}
+
+// BuildFiles returns the Java build files present in projectPath
+func BuildFiles(projectPath string) []string {
+	files := make([]string, 0, len(buildFileNames))
+	for _, name := range buildFileNames {
+		if info, err := os.Stat(filepath.Join(projectPath, name)); err == nil && !info.IsDir() {
+			files = append(files, name)
+		}
+	}
+	return files
+}
+
+// Reasons reported as build_tool_reason when both Maven and Gradle build
+// files are present
+const (
+	buildToolReasonPreferred = "preferred_build_tool"
+	buildToolReasonModified  = "most_recently_modified"
+	buildToolReasonTie       = "modification_time_tie"
+)
+
+// modTimeTolerance is the smallest modification time difference that
+// counts as one build file being newer; a fresh checkout writes both files
+// within moments of each other
+const modTimeTolerance = time.Second
+
+// buildToolChoice is the build tool picked for a project that has both
+// Maven and Gradle build files
+type buildToolChoice struct {
+	projectType string
+	reason      string
+	confidence  string // high, medium or low
+}
+
+// chooseBuildTool picks between Maven and Gradle when projectPath has both
+// a pom.xml and a Gradle build file, as during a migration. The preferred
+// build tool wins with high confidence; otherwise the most recently
+// modified build file does with medium confidence. When the modification
+// times are too close to tell apart, Maven, the first build file in
+// detector order, is picked with low confidence. ok is false unless both
+// build tools are present.
+func chooseBuildTool(projectPath string) (choice buildToolChoice, ok bool) {
+	var mavenFile string
+	var gradleFiles []string
+	for _, name := range BuildFiles(projectPath) {
+		if name == "pom.xml" {
+			mavenFile = name
+		} else {
+			gradleFiles = append(gradleFiles, name)
+		}
+	}
+	if mavenFile == "" || len(gradleFiles) == 0 {
+		return buildToolChoice{}, false
+	}
+
+	// The Gradle extractor prefers the Kotlin DSL when both exist
+	gradleType := "java-gradle"
+	gradleFile := gradleFiles[0]
+	if len(gradleFiles) > 1 || gradleFile == "build.gradle.kts" {
+		gradleType = "java-gradle-kts"
+		gradleFile = "build.gradle.kts"
+	}
+
+	switch preferredBuildTool {
+	case BuildToolMaven:
+		return buildToolChoice{"java-maven", buildToolReasonPreferred, "high"}, true
+	case BuildToolGradle:
+		return buildToolChoice{gradleType, buildToolReasonPreferred, "high"}, true
+	}
+
+	diff := modTime(filepath.Join(projectPath, gradleFile)).Sub(modTime(filepath.Join(projectPath, mavenFile)))
+	switch {
+	case diff >= modTimeTolerance:
+		return buildToolChoice{gradleType, buildToolReasonModified, "medium"}, true
+	case diff <= -modTimeTolerance:
+		return buildToolChoice{"java-maven", buildToolReasonModified, "medium"}, true
+	}
+	return buildToolChoice{"java-maven", buildToolReasonTie, "low"}, true
+}
+
+// ResolveProjectType picks between java-maven and a Gradle project type
+// when projectPath has both a pom.xml and a Gradle build file, as
+// described for chooseBuildTool. Other project types are returned
+// unchanged.
+func ResolveProjectType(projectPath, projectType string) string {
+	if projectType != "java-maven" && projectType != "java-gradle" && projectType != "java-gradle-kts" {
+		return projectType
+	}
+	if choice, ok := chooseBuildTool(projectPath); ok {
+		return choice.projectType
+	}
+	return projectType
+}
+
+// applyBuildFiles records every Java build file found, so that projects
+// carrying both Maven and Gradle builds are visible in the metadata, with
+// how and how confidently the build tool was picked
+func applyBuildFiles(projectPath string, metadata *extractor.ProjectMetadata) {
+	if files := BuildFiles(projectPath); len(files) > 0 {
+		metadata.LanguageSpecific["build_files"] = files
+	}
+	if choice, ok := chooseBuildTool(projectPath); ok {
+		metadata.LanguageSpecific["build_tool_reason"] = choice.reason
+		metadata.LanguageSpecific["build_tool_confidence"] = choice.confidence
+	}
+}
+
+// modTime returns the modification time of path, or the zero time
+func modTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package java
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// writeMigratingProject creates a project with both Maven and Gradle builds
+func writeMigratingProject(t *testing.T) string {
+	t.Helper()
+	tmpDir := t.TempDir()
+
+	pom := `<?xml version="1.0" encoding="UTF-8"?>
+<project>
+  <modelVersion>4.0.0</modelVersion>
+  <groupId>com.example</groupId>
+  <artifactId>migrating-app</artifactId>
+  <version>1.0.0</version>
+</project>`
+	gradle := `group = 'com.example'
+version = '1.0.0'
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "pom.xml"), []byte(pom), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "build.gradle"), []byte(gradle), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return tmpDir
+}
+
+// setModTime sets the modification time of name in dir
+func setModTime(t *testing.T, dir, name string, modTime time.Time) {
+	t.Helper()
+	if err := os.Chtimes(filepath.Join(dir, name), modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResolveProjectType_PreferredBuildTool(t *testing.T) {
+	tmpDir := writeMigratingProject(t)
+	defer SetPreferredBuildTool("")
+
+	// Maven is newer, but the preference wins
+	now := time.Now()
+	setModTime(t, tmpDir, "build.gradle", now.Add(-time.Hour))
+	setModTime(t, tmpDir, "pom.xml", now)
+
+	if err := SetPreferredBuildTool("gradle"); err != nil {
+		t.Fatalf("SetPreferredBuildTool() error = %v", err)
+	}
+	if got := ResolveProjectType(tmpDir, "java-maven"); got != "java-gradle" {
+		t.Errorf("ResolveProjectType() = %q, want java-gradle", got)
+	}
+
+	if err := SetPreferredBuildTool("Maven"); err != nil {
+		t.Fatalf("SetPreferredBuildTool() error = %v", err)
+	}
+	if got := ResolveProjectType(tmpDir, "java-gradle"); got != "java-maven" {
+		t.Errorf("ResolveProjectType() = %q, want java-maven", got)
+	}
+
+	metadata, err := NewGradleExtractor().Extract(tmpDir)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	expected := []string{"pom.xml", "build.gradle"}
+	if got := metadata.LanguageSpecific["build_files"]; !reflect.DeepEqual(got, expected) {
+		t.Errorf("build_files = %v, want %v", got, expected)
+	}
+	if got := metadata.LanguageSpecific["build_tool_reason"]; got != "preferred_build_tool" {
+		t.Errorf("build_tool_reason = %v, want preferred_build_tool", got)
+	}
+	if got := metadata.LanguageSpecific["build_tool_confidence"]; got != "high" {
+		t.Errorf("build_tool_confidence = %v, want high", got)
+	}
+}
+
+func TestResolveProjectType_ModTime(t *testing.T) {
+	tmpDir := writeMigratingProject(t)
+	defer SetPreferredBuildTool("")
+
+	if err := SetPreferredBuildTool(""); err != nil {
+		t.Fatalf("SetPreferredBuildTool() error = %v", err)
+	}
+
+	now := time.Now()
+	setModTime(t, tmpDir, "pom.xml", now.Add(-time.Hour))
+	setModTime(t, tmpDir, "build.gradle", now)
+	if got := ResolveProjectType(tmpDir, "java-maven"); got != "java-gradle" {
+		t.Errorf("ResolveProjectType() = %q, want java-gradle for newer build.gradle", got)
+	}
+	metadata, err := NewGradleExtractor().Extract(tmpDir)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	if got := metadata.LanguageSpecific["build_tool_reason"]; got != "most_recently_modified" {
+		t.Errorf("build_tool_reason = %v, want most_recently_modified", got)
+	}
+	if got := metadata.LanguageSpecific["build_tool_confidence"]; got != "medium" {
+		t.Errorf("build_tool_confidence = %v, want medium", got)
+	}
+
+	setModTime(t, tmpDir, "build.gradle", now.Add(-2*time.Hour))
+	if got := ResolveProjectType(tmpDir, "java-gradle"); got != "java-maven" {
+		t.Errorf("ResolveProjectType() = %q, want java-maven for newer pom.xml", got)
+	}
+
+	// Modification times too close to tell apart fall back to Maven
+	setModTime(t, tmpDir, "pom.xml", now)
+	setModTime(t, tmpDir, "build.gradle", now.Add(100*time.Millisecond))
+	if got := ResolveProjectType(tmpDir, "java-gradle"); got != "java-maven" {
+		t.Errorf("ResolveProjectType() = %q, want java-maven on a tie", got)
+	}
+	metadata, err = NewMavenExtractor().Extract(tmpDir)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	if got := metadata.LanguageSpecific["build_tool_confidence"]; got != "low" {
+		t.Errorf("build_tool_confidence = %v, want low", got)
+	}
+
+	// An invalid preference is rejected and falls back to modification times
+	setModTime(t, tmpDir, "build.gradle", now.Add(time.Hour))
+	if err := SetPreferredBuildTool("ant"); err == nil {
+		t.Error("SetPreferredBuildTool(\"ant\") error = nil, want error")
+	}
+	if got := ResolveProjectType(tmpDir, "java-maven"); got != "java-gradle" {
+		t.Errorf("ResolveProjectType() = %q, want java-gradle", got)
+	}
+
+	// Single build file projects are left alone
+	if err := os.Remove(filepath.Join(tmpDir, "pom.xml")); err != nil {
+		t.Fatal(err)
+	}
+	if got := ResolveProjectType(tmpDir, "java-gradle"); got != "java-gradle" {
+		t.Errorf("ResolveProjectType() = %q, want java-gradle", got)
+	}
+	metadata, err = NewGradleExtractor().Extract(tmpDir)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	if _, ok := metadata.LanguageSpecific["build_tool_reason"]; ok {
+		t.Error("build_tool_reason should be absent with a single build tool")
+	}
+}
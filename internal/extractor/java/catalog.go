@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package java
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// versionCatalogFile is the default Gradle version catalog location
+const versionCatalogFile = "gradle/libs.versions.toml"
+
+// VersionCatalog represents a parsed Gradle version catalog with version
+// references resolved
+type VersionCatalog struct {
+	Versions  map[string]string
+	Libraries map[string]CatalogLibrary
+	Plugins   map[string]GradlePlugin
+}
+
+// CatalogLibrary represents a [libraries] entry of a version catalog
+type CatalogLibrary struct {
+	Group   string
+	Name    string
+	Version string
+}
+
+// parseVersionCatalog reads gradle/libs.versions.toml, returning nil when
+// the project has no catalog
+func parseVersionCatalog(projectPath string) (*VersionCatalog, error) {
+	var raw struct {
+		Versions  map[string]interface{} `toml:"versions"`
+		Libraries map[string]interface{} `toml:"libraries"`
+		Plugins   map[string]interface{} `toml:"plugins"`
+	}
+	path := filepath.Join(projectPath, versionCatalogFile)
+	if _, err := os.Stat(path); err != nil {
+		return nil, nil
+	}
+	if _, err := toml.DecodeFile(path, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", versionCatalogFile, err)
+	}
+
+	catalog := &VersionCatalog{
+		Versions:  make(map[string]string),
+		Libraries: make(map[string]CatalogLibrary),
+		Plugins:   make(map[string]GradlePlugin),
+	}
+
+	// Versions are plain strings or rich versions such as { strictly = "1.0" }
+	for alias, value := range raw.Versions {
+		catalog.Versions[alias] = richVersion(value)
+	}
+
+	for alias, value := range raw.Libraries {
+		var lib CatalogLibrary
+		switch v := value.(type) {
+		case string:
+			// "group:name:version"
+			parts := strings.SplitN(v, ":", 3)
+			if len(parts) < 2 {
+				continue
+			}
+			lib.Group, lib.Name = parts[0], parts[1]
+			if len(parts) == 3 {
+				lib.Version = parts[2]
+			}
+		case map[string]interface{}:
+			if module, ok := v["module"].(string); ok {
+				lib.Group, lib.Name, _ = strings.Cut(module, ":")
+			} else {
+				lib.Group, _ = v["group"].(string)
+				lib.Name, _ = v["name"].(string)
+			}
+			lib.Version = catalog.resolveVersion(v["version"])
+		default:
+			continue
+		}
+		catalog.Libraries[alias] = lib
+	}
+
+	for alias, value := range raw.Plugins {
+		var plugin GradlePlugin
+		switch v := value.(type) {
+		case string:
+			// "id:version"
+			plugin.ID, plugin.Version, _ = strings.Cut(v, ":")
+		case map[string]interface{}:
+			plugin.ID, _ = v["id"].(string)
+			plugin.Version = catalog.resolveVersion(v["version"])
+		default:
+			continue
+		}
+		catalog.Plugins[alias] = plugin
+	}
+
+	return catalog, nil
+}
+
+// resolveVersion returns the version for a library or plugin version
+// field: a literal, a rich version, or a { ref = "alias" } reference into
+// [versions]. The dotted key form version.ref decodes to the same table.
+func (c *VersionCatalog) resolveVersion(value interface{}) string {
+	if table, ok := value.(map[string]interface{}); ok {
+		if ref, ok := table["ref"].(string); ok {
+			return c.Versions[ref]
+		}
+	}
+	return richVersion(value)
+}
+
+// richVersion returns the most specific version of a plain or rich version
+// declaration
+func richVersion(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case map[string]interface{}:
+		for _, key := range []string{"strictly", "require", "prefer"} {
+			if version, ok := v[key].(string); ok {
+				return version
+			}
+		}
+	}
+	return ""
+}
+
+// Dependencies returns the catalog libraries sorted by alias, using the
+// "catalog" configuration since the catalog only declares coordinates
+func (c *VersionCatalog) Dependencies() []GradleDependency {
+	aliases := make([]string, 0, len(c.Libraries))
+	for alias := range c.Libraries {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	deps := make([]GradleDependency, 0, len(aliases))
+	for _, alias := range aliases {
+		lib := c.Libraries[alias]
+		notation := lib.Group + ":" + lib.Name
+		if lib.Version != "" {
+			notation += ":" + lib.Version
+		}
+		deps = append(deps, GradleDependency{
+			Configuration: "catalog",
+			Group:         lib.Group,
+			Name:          lib.Name,
+			Version:       lib.Version,
+			Notation:      notation,
+		})
+	}
+	return deps
+}
+
+// toMap converts the catalog into the version_catalog output structure
+func (c *VersionCatalog) toMap() map[string]interface{} {
+	libraries := make(map[string]map[string]string, len(c.Libraries))
+	for alias, lib := range c.Libraries {
+		libraries[alias] = map[string]string{
+			"group":   lib.Group,
+			"name":    lib.Name,
+			"version": lib.Version,
+		}
+	}
+	plugins := make(map[string]map[string]string, len(c.Plugins))
+	for alias, plugin := range c.Plugins {
+		plugins[alias] = map[string]string{
+			"id":      plugin.ID,
+			"version": plugin.Version,
+		}
+	}
+	return map[string]interface{}{
+		"versions":  c.Versions,
+		"libraries": libraries,
+		"plugins":   plugins,
+	}
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package java
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestGradleExtractVersionCatalog tests libs.versions.toml parsing with
+// version references
+func TestGradleExtractVersionCatalog(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	buildGradle := `plugins {
+    id("java")
+}
+group = "com.example"
+version = "1.0.0"
+dependencies {
+    implementation(libs.guava)
+}
+`
+	catalog := `[versions]
+junit = "5.10.2"
+kotlin = { strictly = "1.9.22" }
+
+[libraries]
+guava = "com.google.guava:guava:33.0.0-jre"
+junit-api = { module = "org.junit.jupiter:junit-jupiter-api", version.ref = "junit" }
+junit-engine = { group = "org.junit.jupiter", name = "junit-jupiter-engine", version = { ref = "junit" } }
+kotlin-stdlib = { module = "org.jetbrains.kotlin:kotlin-stdlib", version.ref = "kotlin" }
+
+[plugins]
+kotlin-jvm = { id = "org.jetbrains.kotlin.jvm", version.ref = "kotlin" }
+versions = "com.github.ben-manes.versions:0.51.0"
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "build.gradle.kts"), []byte(buildGradle), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(tmpDir, "gradle"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "gradle", "libs.versions.toml"), []byte(catalog), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	metadata, err := NewGradleExtractor().Extract(tmpDir)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	versionCatalog, ok := metadata.LanguageSpecific["version_catalog"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("version_catalog not found or wrong type: %T", metadata.LanguageSpecific["version_catalog"])
+	}
+
+	expectedVersions := map[string]string{"junit": "5.10.2", "kotlin": "1.9.22"}
+	if !reflect.DeepEqual(versionCatalog["versions"], expectedVersions) {
+		t.Errorf("versions = %v, want %v", versionCatalog["versions"], expectedVersions)
+	}
+
+	expectedLibraries := map[string]map[string]string{
+		"guava":         {"group": "com.google.guava", "name": "guava", "version": "33.0.0-jre"},
+		"junit-api":     {"group": "org.junit.jupiter", "name": "junit-jupiter-api", "version": "5.10.2"},
+		"junit-engine":  {"group": "org.junit.jupiter", "name": "junit-jupiter-engine", "version": "5.10.2"},
+		"kotlin-stdlib": {"group": "org.jetbrains.kotlin", "name": "kotlin-stdlib", "version": "1.9.22"},
+	}
+	if !reflect.DeepEqual(versionCatalog["libraries"], expectedLibraries) {
+		t.Errorf("libraries = %v, want %v", versionCatalog["libraries"], expectedLibraries)
+	}
+
+	expectedPlugins := map[string]map[string]string{
+		"kotlin-jvm": {"id": "org.jetbrains.kotlin.jvm", "version": "1.9.22"},
+		"versions":   {"id": "com.github.ben-manes.versions", "version": "0.51.0"},
+	}
+	if !reflect.DeepEqual(versionCatalog["plugins"], expectedPlugins) {
+		t.Errorf("plugins = %v, want %v", versionCatalog["plugins"], expectedPlugins)
+	}
+
+	deps, ok := metadata.LanguageSpecific["dependencies"].([]map[string]string)
+	if !ok {
+		t.Fatalf("dependencies not found or wrong type: %T", metadata.LanguageSpecific["dependencies"])
+	}
+	if len(deps) != 4 {
+		t.Fatalf("Expected 4 dependencies from the catalog, got %d: %v", len(deps), deps)
+	}
+	expectedDep := map[string]string{
+		"configuration": "catalog",
+		"group":         "org.junit.jupiter",
+		"name":          "junit-jupiter-api",
+		"version":       "5.10.2",
+	}
+	if !reflect.DeepEqual(deps[1], expectedDep) {
+		t.Errorf("dependencies[1] = %v, want %v", deps[1], expectedDep)
+	}
+}
+
+// TestGradleExtractInvalidVersionCatalog tests that a malformed catalog is
+// reported as a warning
+func TestGradleExtractInvalidVersionCatalog(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(tmpDir, "build.gradle"), []byte("version = '1.0.0'\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(tmpDir, "gradle"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "gradle", "libs.versions.toml"), []byte("[versions\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	metadata, err := NewGradleExtractor().Extract(tmpDir)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	if _, ok := metadata.LanguageSpecific["version_catalog"]; ok {
+		t.Error("version_catalog should not be set for a malformed catalog")
+	}
+	if len(metadata.Warnings) != 1 {
+		t.Errorf("Expected one warning, got %v", metadata.Warnings)
+	}
+}
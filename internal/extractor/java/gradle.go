@@ -33,6 +33,9 @@ type GradleProject struct {
 	Version     string
 	Description string
 
+	// File the version was read from when not the build script
+	VersionSource string
+
 	// Dependencies
 	Dependencies []GradleDependency
 	Plugins      []GradlePlugin
@@ -47,6 +50,9 @@ type GradleProject struct {
 
 	// Properties
 	Properties map[string]string
+
+	// Java version from the toolchain or sourceCompatibility settings
+	JavaVersion string
 }
 
 // GradleDependency represents a Gradle dependency
@@ -81,6 +87,8 @@ func (e *GradleExtractor) Extract(projectPath string) (*extractor.ProjectMetadat
 	if err != nil {
 		return nil, err
 	}
+	extractor.RecordManifest(metadata, buildFile)
+	applyBuildFiles(projectPath, metadata)
 
 	// Parse settings.gradle if exists
 	e.parseSettings(projectPath, gradleProject, isKotlin)
@@ -88,11 +96,23 @@ func (e *GradleExtractor) Extract(projectPath string) (*extractor.ProjectMetadat
 	// Parse gradle.properties if exists
 	e.parseProperties(projectPath, gradleProject)
 
+	// Fold gradle/libs.versions.toml libraries into the dependencies
+	catalog, err := parseVersionCatalog(projectPath)
+	if err != nil {
+		metadata.Warnings = append(metadata.Warnings, err.Error())
+	} else if catalog != nil {
+		metadata.LanguageSpecific["version_catalog"] = catalog.toMap()
+		gradleProject.Dependencies = append(gradleProject.Dependencies, catalog.Dependencies()...)
+	}
+
 	// Extract common metadata
 	metadata.Name = gradleProject.Name
 	metadata.Version = gradleProject.Version
 	metadata.Description = gradleProject.Description
 	metadata.VersionSource = gradleProject.BuildFile
+	if gradleProject.VersionSource != "" {
+		metadata.VersionSource = gradleProject.VersionSource
+	}
 
 	// Gradle-specific metadata
 	metadata.LanguageSpecific["group_id"] = gradleProject.Group
@@ -166,6 +186,15 @@ func (e *GradleExtractor) Extract(projectPath string) (*extractor.ProjectMetadat
 		}
 	}
 
+	// The build script setting wins over gradle.properties
+	if gradleProject.JavaVersion != "" {
+		metadata.LanguageSpecific["java_version"] = gradleProject.JavaVersion
+	}
+
+	// Java version matrix
+	javaVersion, _ := metadata.LanguageSpecific["java_version"].(string)
+	applyJavaVersionMatrix(metadata, javaVersion)
+
 	// Check for dynamic version
 	if strings.Contains(metadata.Version, "SNAPSHOT") ||
 		strings.Contains(metadata.Version, "project.version") ||
@@ -227,9 +256,36 @@ func (e *GradleExtractor) parseGradleBuild(buildFile string, isKotlin bool) (*Gr
 	// Extract dependencies
 	project.Dependencies = e.extractDependencies(text, isKotlin)
 
+	// Extract Java version
+	project.JavaVersion = extractGradleJavaVersion(text)
+
 	return project, nil
 }
 
+// gradleJavaVersionPatterns match Java version settings in Gradle build
+// scripts, most authoritative first:
+// languageVersion = JavaLanguageVersion.of(17)
+// kotlin { jvmToolchain(17) }
+// sourceCompatibility = JavaVersion.VERSION_17 / '17' / 1.8
+// targetCompatibility = JavaVersion.VERSION_1_8
+var gradleJavaVersionPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`JavaLanguageVersion\.of\(\s*['"]?(\d+)['"]?\s*\)`),
+	regexp.MustCompile(`jvmToolchain\(\s*(\d+)\s*\)`),
+	regexp.MustCompile(`sourceCompatibility\s*=?\s*(?:JavaVersion\.VERSION_)?['"]?([\d._]+)['"]?`),
+	regexp.MustCompile(`targetCompatibility\s*=?\s*(?:JavaVersion\.VERSION_)?['"]?([\d._]+)['"]?`),
+}
+
+// extractGradleJavaVersion returns the Java version declared in a Gradle
+// build script, with JavaVersion enum underscores turned into dots
+func extractGradleJavaVersion(content string) string {
+	for _, re := range gradleJavaVersionPatterns {
+		if matches := re.FindStringSubmatch(content); len(matches) > 1 {
+			return strings.ReplaceAll(matches[1], "_", ".")
+		}
+	}
+	return ""
+}
+
 // extractGradleProperty extracts a property value from Gradle build file
 func (e *GradleExtractor) extractGradleProperty(content, property string, isKotlin bool) string {
 	if isKotlin {
@@ -445,16 +501,21 @@ func (e *GradleExtractor) parseProperties(projectPath string, project *GradlePro
 			continue
 		}
 
-		// Parse key=value
+		// Parse key=value (Java properties also allow key: value)
 		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			parts = strings.SplitN(line, ":", 2)
+		}
 		if len(parts) == 2 {
 			key := strings.TrimSpace(parts[0])
 			value := strings.TrimSpace(parts[1])
 			project.Properties[key] = value
 
-			// Override project properties if defined in gradle.properties
-			if key == "version" && project.Version == "" {
+			// Fall back to gradle.properties when the build script does
+			// not declare these inline
+			if key == "version" && project.Version == "" && value != "" {
 				project.Version = value
+				project.VersionSource = "gradle.properties"
 			}
 			if key == "group" && project.Group == "" {
 				project.Group = value
@@ -504,14 +565,12 @@ func (e *GradleExtractor) detectGradleFrameworks(plugins []GradlePlugin) []strin
 // Detect checks if this extractor can handle the project
 func (e *GradleExtractor) Detect(projectPath string) bool {
 	// Check for build.gradle.kts
-	ktsPath := filepath.Join(projectPath, "build.gradle.kts")
-	if _, err := os.Stat(ktsPath); err == nil {
+	if extractor.FileExists(projectPath, "build.gradle.kts") {
 		return true
 	}
 
 	// Check for build.gradle
-	groovyPath := filepath.Join(projectPath, "build.gradle")
-	if _, err := os.Stat(groovyPath); err == nil {
+	if extractor.FileExists(projectPath, "build.gradle") {
 		return true
 	}
 
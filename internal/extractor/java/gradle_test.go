@@ -489,6 +489,49 @@ include 'module-c'
 	}
 }
 
+// TestGradleExtractVersionFromProperties tests the gradle.properties
+// fallback when the build script declares no version or group
+func TestGradleExtractVersionFromProperties(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties string
+		version    string
+	}{
+		{name: "spaces around equals", properties: "version = 1.2.3\ngroup = com.example\n", version: "1.2.3"},
+		{name: "snapshot without spaces", properties: "group=com.example\nversion=1.2.3-SNAPSHOT\n", version: "1.2.3-SNAPSHOT"},
+		{name: "colon separator", properties: "version: 4.5.6\ngroup: com.example\n", version: "4.5.6"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			buildGradle := "plugins {\n    id 'java'\n}\n"
+			if err := os.WriteFile(filepath.Join(tmpDir, "build.gradle"), []byte(buildGradle), 0644); err != nil {
+				t.Fatalf("Failed to write build.gradle: %v", err)
+			}
+			if err := os.WriteFile(filepath.Join(tmpDir, "gradle.properties"), []byte(tt.properties), 0644); err != nil {
+				t.Fatalf("Failed to write gradle.properties: %v", err)
+			}
+
+			e := NewGradleExtractor()
+			metadata, err := e.Extract(tmpDir)
+			if err != nil {
+				t.Fatalf("Extract() error = %v", err)
+			}
+
+			if metadata.Version != tt.version {
+				t.Errorf("Version = %v, want %v", metadata.Version, tt.version)
+			}
+			if metadata.VersionSource != "gradle.properties" {
+				t.Errorf("VersionSource = %v, want gradle.properties", metadata.VersionSource)
+			}
+			if groupID := metadata.LanguageSpecific["group_id"]; groupID != "com.example" {
+				t.Errorf("group_id = %v, want com.example", groupID)
+			}
+		})
+	}
+}
+
 // TestGradleExtractProperties tests gradle.properties parsing
 func TestGradleExtractProperties(t *testing.T) {
 	buildGradle := `
@@ -544,6 +587,89 @@ app.name=MyApp
 	}
 }
 
+// TestGradleExtractJavaVersion tests reading the Java version from build scripts
+func TestGradleExtractJavaVersion(t *testing.T) {
+	tests := []struct {
+		name           string
+		buildFile      string
+		buildContent   string
+		expectedJava   string
+		expectedMatrix string
+	}{
+		{
+			name:      "toolchain in Kotlin DSL",
+			buildFile: "build.gradle.kts",
+			buildContent: `
+group = "com.example"
+version = "1.0.0"
+
+java {
+    sourceCompatibility = JavaVersion.VERSION_11
+    toolchain {
+        languageVersion = JavaLanguageVersion.of(21)
+    }
+}
+`,
+			expectedJava:   "21",
+			expectedMatrix: `{"java-version": ["21", "25"]}`,
+		},
+		{
+			name:      "sourceCompatibility enum in Groovy DSL",
+			buildFile: "build.gradle",
+			buildContent: `
+group 'com.example'
+version '1.0.0'
+sourceCompatibility = JavaVersion.VERSION_1_8
+`,
+			expectedJava:   "1.8",
+			expectedMatrix: `{"java-version": ["8", "11", "17", "21", "25"]}`,
+		},
+		{
+			name:      "targetCompatibility string",
+			buildFile: "build.gradle",
+			buildContent: `
+group 'com.example'
+version '1.0.0'
+targetCompatibility = '17'
+`,
+			expectedJava:   "17",
+			expectedMatrix: `{"java-version": ["17", "21", "25"]}`,
+		},
+		{
+			name:      "no Java version uses LTS defaults",
+			buildFile: "build.gradle",
+			buildContent: `
+group 'com.example'
+version '1.0.0'
+`,
+			expectedMatrix: `{"java-version": ["17", "21", "25"]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(tmpDir, tt.buildFile), []byte(tt.buildContent), 0644); err != nil {
+				t.Fatalf("Failed to write %s: %v", tt.buildFile, err)
+			}
+
+			e := NewGradleExtractor()
+			metadata, err := e.Extract(tmpDir)
+			if err != nil {
+				t.Fatalf("Extract() error = %v", err)
+			}
+
+			javaVersion, _ := metadata.LanguageSpecific["java_version"].(string)
+			if javaVersion != tt.expectedJava {
+				t.Errorf("java_version = %v, want %v", javaVersion, tt.expectedJava)
+			}
+			if matrixJSON := metadata.LanguageSpecific["matrix_json"]; matrixJSON != tt.expectedMatrix {
+				t.Errorf("matrix_json = %v, want %v", matrixJSON, tt.expectedMatrix)
+			}
+		})
+	}
+}
+
 // TestGradleExtractDynamicVersion tests dynamic version detection
 func TestGradleExtractDynamicVersion(t *testing.T) {
 	tests := []struct {
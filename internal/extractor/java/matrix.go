@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package java
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor/versions"
+)
+
+// applyJavaVersionMatrix sets java_version_matrix and matrix_json from the
+// declared Java version, falling back to the default Java window
+func applyJavaVersionMatrix(metadata *extractor.ProjectMetadata, javaVersion string) {
+	matrix := generateJavaVersionMatrix(javaVersion)
+	metadata.LanguageSpecific["java_version_matrix"] = matrix
+	metadata.LanguageSpecific["matrix_json"] = fmt.Sprintf(`{"java-version": [%s]}`,
+		strings.Join(quoteStrings(matrix), ", "))
+	extractor.ApplyOSMatrix(metadata, "java-version", matrix)
+}
+
+// generateJavaVersionMatrix returns the declared Java release followed by
+// every newer LTS release, e.g. "17" gives 17, 21, 25 and "22" gives 22, 25
+func generateJavaVersionMatrix(javaVersion string) []string {
+	window := versions.Get(versions.Java)
+	major := javaMajorVersion(javaVersion)
+	if major == 0 {
+		return append([]string(nil), window.Default...)
+	}
+
+	declared := strconv.Itoa(major)
+	matrix := []string{declared}
+	for _, lts := range window.Versions {
+		if versions.Compare(lts, declared) > 0 {
+			matrix = append(matrix, lts)
+		}
+	}
+	return matrix
+}
+
+// javaMajorVersion returns the feature release number of a Java version
+// such as "17", "1.8", "1_8" or "11.0.2", or 0 when it cannot be parsed
+func javaMajorVersion(javaVersion string) int {
+	version := strings.Trim(strings.TrimSpace(javaVersion), `"'`)
+	version = strings.ReplaceAll(version, "_", ".")
+	version = strings.TrimPrefix(version, "1.")
+
+	major, _, _ := strings.Cut(version, ".")
+	n, err := strconv.Atoi(major)
+	if err != nil || n <= 0 {
+		return 0
+	}
+	return n
+}
+
+// quoteStrings wraps each string in double quotes
+func quoteStrings(values []string) []string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return quoted
+}
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package java
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestGenerateJavaVersionMatrix tests building the Java version matrix
+func TestGenerateJavaVersionMatrix(t *testing.T) {
+	tests := []struct {
+		javaVersion string
+		expected    []string
+	}{
+		{"17", []string{"17", "21", "25"}},
+		{"11", []string{"11", "17", "21", "25"}},
+		{"1.8", []string{"8", "11", "17", "21", "25"}},
+		{"22", []string{"22", "25"}},
+		{"21.0.2", []string{"21", "25"}},
+		{"", []string{"17", "21", "25"}},
+		{"${java.version}", []string{"17", "21", "25"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.javaVersion, func(t *testing.T) {
+			if got := generateJavaVersionMatrix(tt.javaVersion); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("generateJavaVersionMatrix(%q) = %v, want %v", tt.javaVersion, got, tt.expected)
+			}
+		})
+	}
+}
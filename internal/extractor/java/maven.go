@@ -196,8 +196,16 @@ type Profile struct {
 
 // Activation represents profile activation conditions
 type Activation struct {
-	ActiveByDefault bool   `xml:"activeByDefault"`
-	JDK             string `xml:"jdk"`
+	ActiveByDefault bool                `xml:"activeByDefault"`
+	JDK             string              `xml:"jdk"`
+	Property        *ActivationProperty `xml:"property"`
+}
+
+// ActivationProperty activates a profile when a system property is set,
+// or is unset when the name starts with "!"
+type ActivationProperty struct {
+	Name  string `xml:"name"`
+	Value string `xml:"value"`
 }
 
 // Extract retrieves metadata from a Maven project
@@ -215,6 +223,8 @@ func (e *MavenExtractor) Extract(projectPath string) (*extractor.ProjectMetadata
 	if err := e.extractFromPOM(pomPath, projectPath, metadata); err != nil {
 		return nil, err
 	}
+	applyBuildFiles(projectPath, metadata)
+	extractor.RecordManifest(metadata, pomPath)
 
 	return metadata, nil
 }
@@ -228,7 +238,7 @@ func (e *MavenExtractor) extractFromPOM(pomPath, projectPath string, metadata *e
 
 	var pom POM
 	if err := xml.Unmarshal(content, &pom); err != nil {
-		return fmt.Errorf("failed to parse pom.xml: %w", err)
+		return &extractor.ManifestParseError{Manifest: "pom.xml", Err: err}
 	}
 
 	// Resolve properties
@@ -289,6 +299,7 @@ func (e *MavenExtractor) extractFromPOM(pomPath, projectPath string, metadata *e
 		// If version comes from parent
 		if metadata.Version == "" && resolvedPOM.Parent.Version != "" {
 			metadata.Version = resolvedPOM.Parent.Version
+			metadata.VersionSource = "pom.xml (parent)"
 			metadata.LanguageSpecific["version_from_parent"] = true
 		}
 		// If groupId comes from parent
@@ -303,11 +314,13 @@ func (e *MavenExtractor) extractFromPOM(pomPath, projectPath string, metadata *e
 		metadata.LanguageSpecific["properties"] = resolvedPOM.Properties.Entries
 		metadata.LanguageSpecific["property_count"] = len(resolvedPOM.Properties.Entries)
 
-		// Extract Java version if specified
-		if javaVersion, ok := resolvedPOM.Properties.Entries["maven.compiler.source"]; ok {
-			metadata.LanguageSpecific["java_version"] = javaVersion
-		} else if javaVersion, ok := resolvedPOM.Properties.Entries["java.version"]; ok {
-			metadata.LanguageSpecific["java_version"] = javaVersion
+		// Extract Java version if specified; release is what javac
+		// actually targets, so it wins over source
+		for _, property := range []string{"maven.compiler.release", "maven.compiler.source", "java.version", "maven.compiler.target"} {
+			if javaVersion, ok := resolvedPOM.Properties.Entries[property]; ok && javaVersion != "" {
+				metadata.LanguageSpecific["java_version"] = javaVersion
+				break
+			}
 		}
 
 		// Extract project version if dynamic
@@ -376,14 +389,18 @@ func (e *MavenExtractor) extractFromPOM(pomPath, projectPath string, metadata *e
 		metadata.LanguageSpecific["module_count"] = len(resolvedPOM.Modules.Module)
 	}
 
-	// Profiles
+	// Profiles, with their activation conditions so CI can enumerate them
 	if resolvedPOM.Profiles != nil && len(resolvedPOM.Profiles.Profile) > 0 {
-		profileIDs := make([]string, 0, len(resolvedPOM.Profiles.Profile))
+		profiles := make([]map[string]interface{}, 0, len(resolvedPOM.Profiles.Profile))
 		for _, profile := range resolvedPOM.Profiles.Profile {
-			profileIDs = append(profileIDs, profile.ID)
+			entry := map[string]interface{}{"id": profile.ID}
+			if activation := profileActivation(profile.Activation); len(activation) > 0 {
+				entry["activation"] = activation
+			}
+			profiles = append(profiles, entry)
 		}
-		metadata.LanguageSpecific["profiles"] = profileIDs
-		metadata.LanguageSpecific["profile_count"] = len(profileIDs)
+		metadata.LanguageSpecific["profiles"] = profiles
+		metadata.LanguageSpecific["profile_count"] = len(profiles)
 	}
 
 	// Organization
@@ -394,6 +411,10 @@ func (e *MavenExtractor) extractFromPOM(pomPath, projectPath string, metadata *e
 		}
 	}
 
+	// Java version matrix
+	javaVersion, _ := metadata.LanguageSpecific["java_version"].(string)
+	applyJavaVersionMatrix(metadata, javaVersion)
+
 	// Check if version uses placeholders (only set if not already set)
 	if _, alreadySet := metadata.LanguageSpecific["versioning_type"]; !alreadySet {
 		if strings.Contains(metadata.Version, "${") {
@@ -434,26 +455,62 @@ func (e *MavenExtractor) resolveProperties(pom *POM) *POM {
 	resolved.Version = resolveProperty(pom.Version, props)
 	resolved.GroupID = resolveProperty(pom.GroupID, props)
 
+	// CI-friendly builds place ${revision} in the parent reference too
+	if pom.Parent != nil {
+		parent := *pom.Parent
+		parent.Version = resolveProperty(parent.Version, props)
+		resolved.Parent = &parent
+	}
+
 	return &resolved
 }
 
-// resolveProperty resolves a single property value
-func resolveProperty(value string, props map[string]string) string {
-	if !strings.Contains(value, "${") {
-		return value
-	}
+// maxPropertyResolutionDepth bounds nested property expansion so that
+// self-referencing properties cannot loop forever
+const maxPropertyResolutionDepth = 10
 
-	// Simple property resolution
-	for key, val := range props {
-		placeholder := "${" + key + "}"
-		if strings.Contains(value, placeholder) {
-			value = strings.ReplaceAll(value, placeholder, val)
+// resolveProperty resolves a single property value, expanding properties
+// that themselves reference other properties (e.g. revision=${major}.0)
+func resolveProperty(value string, props map[string]string) string {
+	for i := 0; i < maxPropertyResolutionDepth && strings.Contains(value, "${"); i++ {
+		previous := value
+		for key, val := range props {
+			placeholder := "${" + key + "}"
+			if strings.Contains(value, placeholder) {
+				value = strings.ReplaceAll(value, placeholder, val)
+			}
+		}
+		if value == previous {
+			break
 		}
 	}
 
 	return value
 }
 
+// profileActivation describes how a profile is activated: by default,
+// by a JDK version range, or by a system property
+func profileActivation(activation *Activation) map[string]interface{} {
+	if activation == nil {
+		return nil
+	}
+	result := make(map[string]interface{})
+	if activation.ActiveByDefault {
+		result["active_by_default"] = true
+	}
+	if activation.JDK != "" {
+		result["jdk"] = strings.TrimSpace(activation.JDK)
+	}
+	if prop := activation.Property; prop != nil && prop.Name != "" {
+		property := map[string]string{"name": strings.TrimSpace(prop.Name)}
+		if prop.Value != "" {
+			property["value"] = strings.TrimSpace(prop.Value)
+		}
+		result["property"] = property
+	}
+	return result
+}
+
 // detectMavenFrameworks detects common Java frameworks and tools
 func detectMavenFrameworks(plugins []Plugin, deps *Dependencies) []string {
 	frameworks := make([]string, 0)
@@ -514,9 +571,7 @@ func detectMavenFrameworks(plugins []Plugin, deps *Dependencies) []string {
 
 // Detect checks if this extractor can handle the project
 func (e *MavenExtractor) Detect(projectPath string) bool {
-	pomPath := filepath.Join(projectPath, "pom.xml")
-	_, err := os.Stat(pomPath)
-	return err == nil
+	return extractor.FileExists(projectPath, "pom.xml")
 }
 
 // init registers the Maven extractor
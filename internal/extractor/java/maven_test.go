@@ -6,6 +6,7 @@ package java
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 )
 
@@ -582,9 +583,18 @@ func TestMavenExtractProfiles(t *testing.T) {
     <profiles>
         <profile>
             <id>dev</id>
+            <activation>
+                <activeByDefault>true</activeByDefault>
+            </activation>
         </profile>
         <profile>
-            <id>prod</id>
+            <id>release</id>
+            <activation>
+                <property>
+                    <name>env</name>
+                    <value>ci</value>
+                </property>
+            </activation>
         </profile>
         <profile>
             <id>test</id>
@@ -604,13 +614,20 @@ func TestMavenExtractProfiles(t *testing.T) {
 		t.Fatalf("Extract() error = %v", err)
 	}
 
-	profiles, ok := metadata.LanguageSpecific["profiles"].([]string)
+	profiles, ok := metadata.LanguageSpecific["profiles"].([]map[string]interface{})
 	if !ok {
 		t.Fatalf("profiles not found or wrong type")
 	}
 
-	if len(profiles) != 3 {
-		t.Errorf("len(profiles) = %v, want 3", len(profiles))
+	want := []map[string]interface{}{
+		{"id": "dev", "activation": map[string]interface{}{"active_by_default": true}},
+		{"id": "release", "activation": map[string]interface{}{
+			"property": map[string]string{"name": "env", "value": "ci"},
+		}},
+		{"id": "test"},
+	}
+	if !reflect.DeepEqual(profiles, want) {
+		t.Errorf("profiles = %v, want %v", profiles, want)
 	}
 
 	if profileCount, ok := metadata.LanguageSpecific["profile_count"].(int); !ok || profileCount != 3 {
@@ -659,6 +676,114 @@ func TestMavenExtractDynamicVersion(t *testing.T) {
 	}
 }
 
+// TestMavenExtractVersionFromParent tests inheriting the version from <parent>
+func TestMavenExtractVersionFromParent(t *testing.T) {
+	pomXML := `<?xml version="1.0" encoding="UTF-8"?>
+<project xmlns="http://maven.apache.org/POM/4.0.0">
+    <modelVersion>4.0.0</modelVersion>
+
+    <parent>
+        <groupId>com.example</groupId>
+        <artifactId>example-parent</artifactId>
+        <version>2.4.1</version>
+    </parent>
+
+    <artifactId>example-child</artifactId>
+</project>`
+
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "pom.xml"), []byte(pomXML), 0644); err != nil {
+		t.Fatalf("Failed to write pom.xml: %v", err)
+	}
+
+	e := NewMavenExtractor()
+	metadata, err := e.Extract(tmpDir)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	if metadata.Version != "2.4.1" {
+		t.Errorf("Version = %v, want 2.4.1", metadata.Version)
+	}
+	if metadata.VersionSource != "pom.xml (parent)" {
+		t.Errorf("VersionSource = %v, want pom.xml (parent)", metadata.VersionSource)
+	}
+	if fromParent, ok := metadata.LanguageSpecific["version_from_parent"].(bool); !ok || !fromParent {
+		t.Errorf("version_from_parent = %v, want true", fromParent)
+	}
+}
+
+// TestMavenExtractPropertyPlaceholders tests resolving CI-friendly version
+// placeholders, including nested properties and the parent reference
+func TestMavenExtractPropertyPlaceholders(t *testing.T) {
+	tests := []struct {
+		name           string
+		pomXML         string
+		wantVersion    string
+		wantSource     string
+		wantFromParent bool
+	}{
+		{
+			name: "revision and changelist",
+			pomXML: `<project>
+    <artifactId>app</artifactId>
+    <version>${revision}${changelist}</version>
+    <properties>
+        <revision>${major}.${minor}.0</revision>
+        <major>3</major>
+        <minor>7</minor>
+        <changelist>-SNAPSHOT</changelist>
+    </properties>
+</project>`,
+			wantVersion: "3.7.0-SNAPSHOT",
+			wantSource:  "pom.xml",
+		},
+		{
+			name: "parent version placeholder",
+			pomXML: `<project>
+    <parent>
+        <groupId>com.example</groupId>
+        <artifactId>parent</artifactId>
+        <version>${revision}</version>
+    </parent>
+    <artifactId>child</artifactId>
+    <properties>
+        <revision>5.0.2</revision>
+    </properties>
+</project>`,
+			wantVersion:    "5.0.2",
+			wantSource:     "pom.xml (parent)",
+			wantFromParent: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(tmpDir, "pom.xml"), []byte(tt.pomXML), 0644); err != nil {
+				t.Fatalf("Failed to write pom.xml: %v", err)
+			}
+
+			e := NewMavenExtractor()
+			metadata, err := e.Extract(tmpDir)
+			if err != nil {
+				t.Fatalf("Extract() error = %v", err)
+			}
+
+			if metadata.Version != tt.wantVersion {
+				t.Errorf("Version = %v, want %v", metadata.Version, tt.wantVersion)
+			}
+			if metadata.VersionSource != tt.wantSource {
+				t.Errorf("VersionSource = %v, want %v", metadata.VersionSource, tt.wantSource)
+			}
+			fromParent, _ := metadata.LanguageSpecific["version_from_parent"].(bool)
+			if fromParent != tt.wantFromParent {
+				t.Errorf("version_from_parent = %v, want %v", fromParent, tt.wantFromParent)
+			}
+		})
+	}
+}
+
 // TestMavenExtractDefaultPackaging tests default packaging type
 func TestMavenExtractDefaultPackaging(t *testing.T) {
 	pomXML := `<?xml version="1.0" encoding="UTF-8"?>
@@ -787,6 +912,14 @@ func TestMavenExtractWithJavaVersion(t *testing.T) {
             </properties>`,
 			expectedJava: "17",
 		},
+		{
+			name: "maven.compiler.release wins over source",
+			properties: `<properties>
+                <maven.compiler.source>11</maven.compiler.source>
+                <maven.compiler.release>17</maven.compiler.release>
+            </properties>`,
+			expectedJava: "17",
+		},
 	}
 
 	for _, tt := range tests {
@@ -815,6 +948,11 @@ func TestMavenExtractWithJavaVersion(t *testing.T) {
 			if javaVersion, ok := metadata.LanguageSpecific["java_version"].(string); !ok || javaVersion != tt.expectedJava {
 				t.Errorf("java_version = %v, want %v", javaVersion, tt.expectedJava)
 			}
+
+			matrix, ok := metadata.LanguageSpecific["java_version_matrix"].([]string)
+			if !ok || len(matrix) == 0 || matrix[0] != tt.expectedJava {
+				t.Errorf("java_version_matrix = %v, want it to start at %v", matrix, tt.expectedJava)
+			}
 		})
 	}
 }
@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
 	"github.com/lfreleng-actions/build-metadata-action/internal/jsonutil"
+	"gopkg.in/yaml.v3"
 )
 
 // Extractor extracts metadata from JavaScript/Node.js projects
@@ -91,6 +93,7 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 	if err := e.extractFromPackageJSON(packageJSONPath, projectPath, metadata); err != nil {
 		return nil, err
 	}
+	extractor.RecordManifest(metadata, packageJSONPath)
 
 	return metadata, nil
 }
@@ -104,7 +107,7 @@ func (e *Extractor) extractFromPackageJSON(path, projectPath string, metadata *e
 
 	var pkg PackageJSON
 	if err := json.Unmarshal(content, &pkg); err != nil {
-		return fmt.Errorf("failed to parse package.json: %w", err)
+		return &extractor.ManifestParseError{Manifest: "package.json", Err: err}
 	}
 
 	// Extract common metadata
@@ -146,6 +149,11 @@ func (e *Extractor) extractFromPackageJSON(path, projectPath string, metadata *e
 		metadata.LanguageSpecific["types_entry"] = pkg.Types
 	}
 
+	// Executables installed by the package
+	if binaries := extractBinaries(pkg.Name, pkg.Bin); len(binaries) > 0 {
+		metadata.LanguageSpecific["binaries"] = binaries
+	}
+
 	// Engines (Node.js version requirements)
 	if len(pkg.Engines) > 0 {
 		metadata.LanguageSpecific["engines"] = pkg.Engines
@@ -158,8 +166,9 @@ func (e *Extractor) extractFromPackageJSON(path, projectPath string, metadata *e
 	}
 
 	// Detect package manager
-	packageManager := detectPackageManager(projectPath, pkg.PackageManager)
+	packageManager, packageManagerSource := detectPackageManager(projectPath, pkg.PackageManager)
 	metadata.LanguageSpecific["package_manager"] = packageManager
+	metadata.LanguageSpecific["package_manager_source"] = packageManagerSource
 
 	// Lock file information
 	lockFile, lockFileExists := detectLockFile(projectPath, packageManager)
@@ -170,15 +179,26 @@ func (e *Extractor) extractFromPackageJSON(path, projectPath string, metadata *e
 		metadata.LanguageSpecific["has_lock_file"] = false
 	}
 
-	// Workspace/monorepo detection
-	if pkg.Workspaces != nil {
-		workspaces := extractWorkspaces(pkg.Workspaces)
-		if len(workspaces) > 0 {
-			metadata.LanguageSpecific["is_workspace"] = true
-			metadata.LanguageSpecific["workspaces"] = workspaces
-			metadata.LanguageSpecific["workspace_count"] = len(workspaces)
-		}
+	// Workspace/monorepo detection; pnpm keeps its workspace globs in
+	// pnpm-workspace.yaml and Lerna in lerna.json rather than package.json
+	workspaces := extractWorkspaces(pkg.Workspaces)
+	if len(workspaces) == 0 {
+		workspaces = extractPnpmWorkspaces(projectPath)
+	}
+	if len(workspaces) == 0 {
+		workspaces = extractLernaPackages(projectPath)
+	}
+	if len(workspaces) > 0 {
+		metadata.LanguageSpecific["is_workspace"] = true
+		metadata.LanguageSpecific["workspaces"] = workspaces
+		metadata.LanguageSpecific["workspace_globs"] = workspaces
+		metadata.LanguageSpecific["workspace_count"] = len(workspaces)
 	}
+	monorepoTool := detectMonorepoTool(projectPath)
+	if monorepoTool != "" {
+		metadata.LanguageSpecific["monorepo_tool"] = monorepoTool
+	}
+	metadata.LanguageSpecific["is_monorepo"] = len(workspaces) > 0 || monorepoTool != ""
 
 	// Dependencies
 	totalDeps := len(pkg.Dependencies) + len(pkg.DevDependencies) +
@@ -197,10 +217,23 @@ func (e *Extractor) extractFromPackageJSON(path, projectPath string, metadata *e
 		}
 	}
 
+	// Transitive dependencies from the npm lock file (opt-in)
+	if extractor.LockfileDependenciesEnabled() && lockFileExists && lockFile == "package-lock.json" {
+		if count, err := countNpmTransitiveDependencies(filepath.Join(projectPath, lockFile), pkg); err == nil {
+			metadata.LanguageSpecific["transitive_dependency_count"] = count
+		}
+	}
+
 	// Scripts
 	if len(pkg.Scripts) > 0 {
+		scriptNames := make([]string, 0, len(pkg.Scripts))
+		for name := range pkg.Scripts {
+			scriptNames = append(scriptNames, name)
+		}
+		sort.Strings(scriptNames)
 		metadata.LanguageSpecific["has_scripts"] = true
-		metadata.LanguageSpecific["script_count"] = len(pkg.Scripts)
+		metadata.LanguageSpecific["scripts"] = scriptNames
+		metadata.LanguageSpecific["script_count"] = len(scriptNames)
 
 		// Detect common script patterns
 		scriptPatterns := detectScriptPatterns(pkg.Scripts)
@@ -258,9 +291,7 @@ func (e *Extractor) extractFromPackageJSON(path, projectPath string, metadata *e
 
 // Detect checks if this extractor can handle the project
 func (e *Extractor) Detect(projectPath string) bool {
-	packageJSONPath := filepath.Join(projectPath, "package.json")
-	_, err := os.Stat(packageJSONPath)
-	return err == nil
+	return extractor.FileExists(projectPath, "package.json")
 }
 
 // Helper functions
@@ -283,6 +314,32 @@ func extractLicense(license interface{}) string {
 	return ""
 }
 
+// extractBinaries maps command names to files from the bin field. The
+// string form installs a single command named after the package, without
+// its scope.
+func extractBinaries(packageName string, bin interface{}) map[string]string {
+	binaries := make(map[string]string)
+
+	switch v := bin.(type) {
+	case string:
+		if v != "" && packageName != "" {
+			name := packageName
+			if idx := strings.LastIndex(name, "/"); idx >= 0 {
+				name = name[idx+1:]
+			}
+			binaries[name] = v
+		}
+	case map[string]interface{}:
+		for name, path := range v {
+			if pathStr, ok := path.(string); ok && pathStr != "" {
+				binaries[name] = pathStr
+			}
+		}
+	}
+
+	return binaries
+}
+
 // extractAuthors extracts author and contributor information
 func extractAuthors(author interface{}, contributors []interface{}) []string {
 	authors := make([]string, 0)
@@ -374,42 +431,152 @@ func extractWorkspaces(workspaces interface{}) []string {
 	return nil
 }
 
-// detectPackageManager detects which package manager is being used
-func detectPackageManager(projectPath, packageManagerField string) string {
+// extractPnpmWorkspaces reads the package globs from pnpm-workspace.yaml
+func extractPnpmWorkspaces(projectPath string) []string {
+	data, err := os.ReadFile(filepath.Join(projectPath, "pnpm-workspace.yaml"))
+	if err != nil {
+		return nil
+	}
+
+	var config struct {
+		Packages []string `yaml:"packages"`
+	}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil
+	}
+
+	return config.Packages
+}
+
+// extractLernaPackages reads the package globs from lerna.json
+func extractLernaPackages(projectPath string) []string {
+	data, err := os.ReadFile(filepath.Join(projectPath, "lerna.json"))
+	if err != nil {
+		return nil
+	}
+
+	var config struct {
+		Packages []string `json:"packages"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil
+	}
+
+	return config.Packages
+}
+
+// monorepoToolFiles maps monorepo tools to their configuration files, in
+// order of precedence. Task runners such as Turborepo and Nx sit on top
+// of package manager workspaces, so they win over pnpm.
+var monorepoToolFiles = []struct {
+	tool string
+	file string
+}{
+	{"turborepo", "turbo.json"},
+	{"nx", "nx.json"},
+	{"lerna", "lerna.json"},
+	{"pnpm", "pnpm-workspace.yaml"},
+}
+
+// detectMonorepoTool returns the monorepo tool configured in the project,
+// or "" when there is none
+func detectMonorepoTool(projectPath string) string {
+	for _, candidate := range monorepoToolFiles {
+		if extractor.FileExists(projectPath, candidate.file) {
+			return candidate.tool
+		}
+	}
+	return ""
+}
+
+// detectPackageManager detects which package manager is being used and
+// returns it along with where that answer came from. The packageManager
+// field in package.json takes precedence over any lock file, since it is
+// what Corepack enforces.
+func detectPackageManager(projectPath, packageManagerField string) (string, string) {
 	// Check packageManager field first
 	if packageManagerField != "" {
 		// Format: "pnpm@8.0.0" or "yarn@3.0.0"
 		if strings.Contains(packageManagerField, "@") {
 			parts := strings.Split(packageManagerField, "@")
-			return parts[0]
+			return parts[0], "packageManager"
 		}
-		return packageManagerField
+		return packageManagerField, "packageManager"
 	}
 
 	// Check for lock files
 	if _, err := os.Stat(filepath.Join(projectPath, "pnpm-lock.yaml")); err == nil {
-		return "pnpm"
+		return "pnpm", "pnpm-lock.yaml"
 	}
 
 	if _, err := os.Stat(filepath.Join(projectPath, "yarn.lock")); err == nil {
 		// Check if it's Yarn 2+ (berry)
 		yarnrcPath := filepath.Join(projectPath, ".yarnrc.yml")
 		if _, err := os.Stat(yarnrcPath); err == nil {
-			return "yarn-berry"
+			return "yarn-berry", "yarn.lock"
 		}
-		return "yarn"
+		return "yarn", "yarn.lock"
 	}
 
 	if _, err := os.Stat(filepath.Join(projectPath, "package-lock.json")); err == nil {
-		return "npm"
+		return "npm", "package-lock.json"
 	}
 
 	if _, err := os.Stat(filepath.Join(projectPath, "bun.lockb")); err == nil {
-		return "bun"
+		return "bun", "bun.lockb"
 	}
 
 	// Default to npm
-	return "npm"
+	return "npm", "default"
+}
+
+// npmLockFile represents the parts of package-lock.json needed to walk
+// the installed package tree
+type npmLockFile struct {
+	LockfileVersion int `json:"lockfileVersion"`
+	Packages        map[string]struct {
+		Link bool `json:"link"`
+	} `json:"packages"`
+}
+
+// countNpmTransitiveDependencies counts the packages installed by a
+// lockfileVersion 2 or 3 package-lock.json that the project does not
+// declare directly. Nested copies of a direct dependency count as
+// transitive, since another package pulled them in.
+func countNpmTransitiveDependencies(path string, pkg PackageJSON) (int, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	var lock npmLockFile
+	if err := json.Unmarshal(content, &lock); err != nil {
+		return 0, fmt.Errorf("failed to parse %s: %w", filepath.Base(path), err)
+	}
+	if lock.LockfileVersion < 2 || lock.Packages == nil {
+		return 0, fmt.Errorf("unsupported lockfileVersion %d", lock.LockfileVersion)
+	}
+
+	direct := make(map[string]bool)
+	for _, deps := range []map[string]string{
+		pkg.Dependencies, pkg.DevDependencies, pkg.PeerDependencies, pkg.OptionalDependencies,
+	} {
+		for name := range deps {
+			direct["node_modules/"+name] = true
+		}
+	}
+
+	count := 0
+	for key, entry := range lock.Packages {
+		// "" is the root project; links point at workspace packages
+		if key == "" || entry.Link || !strings.Contains(key, "node_modules/") {
+			continue
+		}
+		if !direct[key] {
+			count++
+		}
+	}
+	return count, nil
 }
 
 // detectLockFile returns the lock file name and whether it exists
@@ -4,9 +4,13 @@
 package javascript
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
 )
 
 // TestDetect verifies the extractor can detect JavaScript/Node.js projects
@@ -122,6 +126,24 @@ func TestExtractBasic(t *testing.T) {
 	}
 }
 
+// TestExtractInvalidPackageJSON verifies an unparseable package.json is
+// reported as a ManifestParseError so strict mode can fail on it
+func TestExtractInvalidPackageJSON(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "package.json"), []byte(`{"name": `), 0644); err != nil {
+		t.Fatalf("Failed to write package.json: %v", err)
+	}
+
+	_, err := NewExtractor().Extract(tmpDir)
+	var parseErr *extractor.ManifestParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("Extract() error = %v, expected a ManifestParseError", err)
+	}
+	if parseErr.Manifest != "package.json" {
+		t.Errorf("Manifest = %q, expected package.json", parseErr.Manifest)
+	}
+}
+
 // TestPackageManagerDetection tests detection of different package managers
 func TestPackageManagerDetection(t *testing.T) {
 	tests := []struct {
@@ -593,6 +615,145 @@ func TestWorkspaceDetection(t *testing.T) {
 			if isWorkspace != tt.expectedWorkspace {
 				t.Errorf("is_workspace = %v, expected %v", isWorkspace, tt.expectedWorkspace)
 			}
+
+			if isMonorepo := metadata.LanguageSpecific["is_monorepo"]; isMonorepo != tt.expectedWorkspace {
+				t.Errorf("is_monorepo = %v, expected %v", isMonorepo, tt.expectedWorkspace)
+			}
+		})
+	}
+}
+
+// TestPackageManagerFieldOverridesLockFile tests that the packageManager
+// field wins over a conflicting lock file
+func TestPackageManagerFieldOverridesLockFile(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	packageJSON := `{"name": "test", "version": "1.0.0", "packageManager": "pnpm@9.1.0"}`
+	if err := os.WriteFile(filepath.Join(tmpDir, "package.json"), []byte(packageJSON), 0644); err != nil {
+		t.Fatalf("Failed to write package.json: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "package-lock.json"), []byte(`{"lockfileVersion": 3}`), 0644); err != nil {
+		t.Fatalf("Failed to write lock file: %v", err)
+	}
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	if manager := metadata.LanguageSpecific["package_manager"]; manager != "pnpm" {
+		t.Errorf("package_manager = %v, expected pnpm", manager)
+	}
+	if source := metadata.LanguageSpecific["package_manager_source"]; source != "packageManager" {
+		t.Errorf("package_manager_source = %v, expected packageManager", source)
+	}
+}
+
+// TestPnpmWorkspaceDetection tests reading workspaces from pnpm-workspace.yaml
+func TestPnpmWorkspaceDetection(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	packageJSON := `{"name": "monorepo", "version": "1.0.0"}`
+	if err := os.WriteFile(filepath.Join(tmpDir, "package.json"), []byte(packageJSON), 0644); err != nil {
+		t.Fatalf("Failed to write package.json: %v", err)
+	}
+	pnpmWorkspace := "packages:\n  - 'packages/*'\n  - 'apps/*'\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, "pnpm-workspace.yaml"), []byte(pnpmWorkspace), 0644); err != nil {
+		t.Fatalf("Failed to write pnpm-workspace.yaml: %v", err)
+	}
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	workspaces, ok := metadata.LanguageSpecific["workspaces"].([]string)
+	if !ok || len(workspaces) != 2 || workspaces[0] != "packages/*" || workspaces[1] != "apps/*" {
+		t.Errorf("workspaces = %v, expected [packages/* apps/*]", metadata.LanguageSpecific["workspaces"])
+	}
+	if globs, ok := metadata.LanguageSpecific["workspace_globs"].([]string); !ok || len(globs) != 2 {
+		t.Errorf("workspace_globs = %v, expected [packages/* apps/*]", metadata.LanguageSpecific["workspace_globs"])
+	}
+	if tool := metadata.LanguageSpecific["monorepo_tool"]; tool != "pnpm" {
+		t.Errorf("monorepo_tool = %v, expected pnpm", tool)
+	}
+	if isMonorepo := metadata.LanguageSpecific["is_monorepo"]; isMonorepo != true {
+		t.Errorf("is_monorepo = %v, expected true", isMonorepo)
+	}
+}
+
+// TestMonorepoToolDetection tests detecting monorepo tools from their
+// configuration files
+func TestMonorepoToolDetection(t *testing.T) {
+	tests := []struct {
+		name          string
+		packageJSON   string
+		files         map[string]string
+		expectedTool  interface{}
+		expectedGlobs []string
+	}{
+		{
+			name:          "turborepo over pnpm",
+			packageJSON:   `{"name": "turbo-repo"}`,
+			files:         map[string]string{"turbo.json": `{"tasks": {"build": {}}}`, "pnpm-workspace.yaml": "packages:\n  - 'apps/*'\n"},
+			expectedTool:  "turborepo",
+			expectedGlobs: []string{"apps/*"},
+		},
+		{
+			name:          "turborepo with npm workspaces",
+			packageJSON:   `{"name": "turbo-repo", "workspaces": ["packages/*"]}`,
+			files:         map[string]string{"turbo.json": `{}`},
+			expectedTool:  "turborepo",
+			expectedGlobs: []string{"packages/*"},
+		},
+		{
+			name:         "nx",
+			packageJSON:  `{"name": "nx-repo"}`,
+			files:        map[string]string{"nx.json": `{"npmScope": "acme"}`},
+			expectedTool: "nx",
+		},
+		{
+			name:          "lerna packages",
+			packageJSON:   `{"name": "lerna-repo"}`,
+			files:         map[string]string{"lerna.json": `{"version": "independent", "packages": ["modules/*"]}`},
+			expectedTool:  "lerna",
+			expectedGlobs: []string{"modules/*"},
+		},
+		{
+			name:         "no tool",
+			packageJSON:  `{"name": "single"}`,
+			expectedTool: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			files := map[string]string{"package.json": tt.packageJSON}
+			for name, content := range tt.files {
+				files[name] = content
+			}
+			for name, content := range files {
+				if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+					t.Fatalf("Failed to write %s: %v", name, err)
+				}
+			}
+
+			metadata, err := NewExtractor().Extract(tmpDir)
+			if err != nil {
+				t.Fatalf("Extract() error = %v", err)
+			}
+
+			if tool := metadata.LanguageSpecific["monorepo_tool"]; tool != tt.expectedTool {
+				t.Errorf("monorepo_tool = %v, expected %v", tool, tt.expectedTool)
+			}
+			globs, _ := metadata.LanguageSpecific["workspace_globs"].([]string)
+			if !reflect.DeepEqual(globs, tt.expectedGlobs) {
+				t.Errorf("workspace_globs = %v, expected %v", globs, tt.expectedGlobs)
+			}
+			if isMonorepo := metadata.LanguageSpecific["is_monorepo"]; isMonorepo != (tt.expectedTool != nil) {
+				t.Errorf("is_monorepo = %v, expected %v", isMonorepo, tt.expectedTool != nil)
+			}
 		})
 	}
 }
@@ -649,6 +810,62 @@ func TestDependencyCount(t *testing.T) {
 	}
 }
 
+// TestTransitiveDependencyCount tests counting packages from a v3
+// package-lock.json when lock file parsing is enabled
+func TestTransitiveDependencyCount(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	packageJSON := `{
+  "name": "app",
+  "version": "1.0.0",
+  "dependencies": {"express": "^4.18.0"},
+  "devDependencies": {"jest": "^29.0.0"}
+}`
+	packageLock := `{
+  "name": "app",
+  "version": "1.0.0",
+  "lockfileVersion": 3,
+  "packages": {
+    "": {"name": "app", "version": "1.0.0"},
+    "node_modules/express": {"version": "4.18.2"},
+    "node_modules/jest": {"version": "29.7.0", "dev": true},
+    "node_modules/body-parser": {"version": "1.20.1"},
+    "node_modules/debug": {"version": "2.6.9"},
+    "node_modules/body-parser/node_modules/debug": {"version": "4.3.4"},
+    "node_modules/shared": {"resolved": "packages/shared", "link": true}
+  }
+}`
+	if err := os.WriteFile(filepath.Join(tmpDir, "package.json"), []byte(packageJSON), 0644); err != nil {
+		t.Fatalf("Failed to write package.json: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "package-lock.json"), []byte(packageLock), 0644); err != nil {
+		t.Fatalf("Failed to write package-lock.json: %v", err)
+	}
+
+	// Disabled by default
+	metadata, err := NewExtractor().Extract(tmpDir)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	if count, ok := metadata.LanguageSpecific["transitive_dependency_count"]; ok {
+		t.Errorf("transitive_dependency_count = %v, expected it to be absent when disabled", count)
+	}
+
+	extractor.SetLockfileDependencies(true)
+	defer extractor.SetLockfileDependencies(false)
+
+	metadata, err = NewExtractor().Extract(tmpDir)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	if count := metadata.LanguageSpecific["transitive_dependency_count"]; count != 3 {
+		t.Errorf("transitive_dependency_count = %v, expected 3", count)
+	}
+	if count := metadata.LanguageSpecific["dependency_count"]; count != 1 {
+		t.Errorf("dependency_count = %v, expected 1", count)
+	}
+}
+
 // TestScriptDetection tests script detection and categorization
 func TestScriptDetection(t *testing.T) {
 	packageJSON := `{
@@ -712,6 +929,61 @@ func TestScriptDetection(t *testing.T) {
 	}
 }
 
+// TestBinariesAndScripts tests the string and object forms of bin and the
+// script names
+func TestBinariesAndScripts(t *testing.T) {
+	tests := []struct {
+		name             string
+		packageJSON      string
+		expectedBinaries map[string]string
+	}{
+		{
+			name: "string form uses the unscoped package name",
+			packageJSON: `{
+				"name": "@acme/deploy-cli",
+				"version": "1.0.0",
+				"bin": "./bin/cli.js",
+				"scripts": {"test": "jest", "build": "tsc"}
+			}`,
+			expectedBinaries: map[string]string{"deploy-cli": "./bin/cli.js"},
+		},
+		{
+			name: "object form",
+			packageJSON: `{
+				"name": "toolkit",
+				"version": "1.0.0",
+				"bin": {"tk": "./bin/tk.js", "tk-init": "./bin/init.js"},
+				"scripts": {"test": "jest", "build": "tsc"}
+			}`,
+			expectedBinaries: map[string]string{"tk": "./bin/tk.js", "tk-init": "./bin/init.js"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(tmpDir, "package.json"), []byte(tt.packageJSON), 0644); err != nil {
+				t.Fatalf("Failed to write package.json: %v", err)
+			}
+
+			metadata, err := NewExtractor().Extract(tmpDir)
+			if err != nil {
+				t.Fatalf("Extract() error = %v", err)
+			}
+
+			if binaries := metadata.LanguageSpecific["binaries"]; !reflect.DeepEqual(binaries, tt.expectedBinaries) {
+				t.Errorf("binaries = %v, expected %v", binaries, tt.expectedBinaries)
+			}
+			if scripts := metadata.LanguageSpecific["scripts"]; !reflect.DeepEqual(scripts, []string{"build", "test"}) {
+				t.Errorf("scripts = %v, expected [build test]", scripts)
+			}
+			if count := metadata.LanguageSpecific["script_count"]; count != 2 {
+				t.Errorf("script_count = %v, expected 2", count)
+			}
+		})
+	}
+}
+
 // TestModuleTypeDetection tests module type detection (ESM vs CommonJS)
 func TestModuleTypeDetection(t *testing.T) {
 	tests := []struct {
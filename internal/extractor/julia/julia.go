@@ -42,32 +42,30 @@ type ProjectToml struct {
 // Detect checks if this is a Julia project
 func (e *Extractor) Detect(projectPath string) bool {
 	// Check for Project.toml
-	if _, err := os.Stat(filepath.Join(projectPath, "Project.toml")); err == nil {
+	if extractor.FileExists(projectPath, "Project.toml") {
 		return true
 	}
 
 	// Check for JuliaProject.toml (alternative name)
-	if _, err := os.Stat(filepath.Join(projectPath, "JuliaProject.toml")); err == nil {
+	if extractor.FileExists(projectPath, "JuliaProject.toml") {
 		return true
 	}
 
 	// Check for Manifest.toml (usually alongside Project.toml)
-	if _, err := os.Stat(filepath.Join(projectPath, "Manifest.toml")); err == nil {
+	if extractor.FileExists(projectPath, "Manifest.toml") {
 		return true
 	}
 
 	// Check for src/ directory with .jl files
 	srcDir := filepath.Join(projectPath, "src")
 	if info, err := os.Stat(srcDir); err == nil && info.IsDir() {
-		matches, err := filepath.Glob(filepath.Join(srcDir, "*.jl"))
-		if err == nil && len(matches) > 0 {
+		if len(extractor.GlobFiles(srcDir, "*.jl")) > 0 {
 			return true
 		}
 	}
 
 	// Check for .jl files in root
-	matches, err := filepath.Glob(filepath.Join(projectPath, "*.jl"))
-	if err == nil && len(matches) > 0 {
+	if len(extractor.GlobFiles(projectPath, "*.jl")) > 0 {
 		return true
 	}
 
@@ -86,6 +84,7 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 		if err := e.extractFromProjectToml(projectTomlPath, metadata); err != nil {
 			return nil, err
 		}
+		extractor.RecordManifest(metadata, projectTomlPath)
 	} else {
 		// Try JuliaProject.toml as fallback
 		juliaProjectPath := filepath.Join(projectPath, "JuliaProject.toml")
@@ -93,6 +92,7 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 			if err := e.extractFromProjectToml(juliaProjectPath, metadata); err != nil {
 				return nil, err
 			}
+			extractor.RecordManifest(metadata, juliaProjectPath)
 		}
 	}
 
@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"regexp"
+	"strings"
+)
+
+// spdxLicenseIDs maps normalized license keys (see licenseKey) to SPDX
+// identifiers
+var spdxLicenseIDs = map[string]string{
+	"mit":           "MIT",
+	"apache2":       "Apache-2.0",
+	"apache20":      "Apache-2.0",
+	"asl2":          "Apache-2.0",
+	"asl20":         "Apache-2.0",
+	"bsd":           "BSD-3-Clause",
+	"bsd3":          "BSD-3-Clause",
+	"bsd3clause":    "BSD-3-Clause",
+	"newbsd":        "BSD-3-Clause",
+	"modifiedbsd":   "BSD-3-Clause",
+	"bsd2":          "BSD-2-Clause",
+	"bsd2clause":    "BSD-2-Clause",
+	"simplifiedbsd": "BSD-2-Clause",
+	"freebsd":       "BSD-2-Clause",
+	"0bsd":          "0BSD",
+	"isc":           "ISC",
+	"gpl2":          "GPL-2.0-only",
+	"gpl20":         "GPL-2.0-only",
+	"gpl20only":     "GPL-2.0-only",
+	"gpl2+":         "GPL-2.0-or-later",
+	"gpl20+":        "GPL-2.0-or-later",
+	"gpl2orlater":   "GPL-2.0-or-later",
+	"gpl20orlater":  "GPL-2.0-or-later",
+	"gpl3":          "GPL-3.0-only",
+	"gpl30":         "GPL-3.0-only",
+	"gpl30only":     "GPL-3.0-only",
+	"gpl3+":         "GPL-3.0-or-later",
+	"gpl30+":        "GPL-3.0-or-later",
+	"gpl3orlater":   "GPL-3.0-or-later",
+	"gpl30orlater":  "GPL-3.0-or-later",
+	"lgpl21":        "LGPL-2.1-only",
+	"lgpl21only":    "LGPL-2.1-only",
+	"lgpl21+":       "LGPL-2.1-or-later",
+	"lgpl21orlater": "LGPL-2.1-or-later",
+	"lgpl3":         "LGPL-3.0-only",
+	"lgpl30":        "LGPL-3.0-only",
+	"lgpl30only":    "LGPL-3.0-only",
+	"lgpl3+":        "LGPL-3.0-or-later",
+	"lgpl30+":       "LGPL-3.0-or-later",
+	"lgpl3orlater":  "LGPL-3.0-or-later",
+	"lgpl30orlater": "LGPL-3.0-or-later",
+	"agpl3":         "AGPL-3.0-only",
+	"agpl30":        "AGPL-3.0-only",
+	"agpl30only":    "AGPL-3.0-only",
+	"agpl3+":        "AGPL-3.0-or-later",
+	"agpl30+":       "AGPL-3.0-or-later",
+	"agpl3orlater":  "AGPL-3.0-or-later",
+	"agpl30orlater": "AGPL-3.0-or-later",
+	"mpl2":          "MPL-2.0",
+	"mpl20":         "MPL-2.0",
+	"epl1":          "EPL-1.0",
+	"epl10":         "EPL-1.0",
+	"epl2":          "EPL-2.0",
+	"epl20":         "EPL-2.0",
+	"bsl1":          "BSL-1.0",
+	"bsl10":         "BSL-1.0",
+	"boost":         "BSL-1.0",
+	"artistic2":     "Artistic-2.0",
+	"artistic20":    "Artistic-2.0",
+	"cc0":           "CC0-1.0",
+	"cc010":         "CC0-1.0",
+	"unlicense":     "Unlicense",
+	"zlib":          "Zlib",
+	"wtfpl":         "WTFPL",
+	"publicdomain":  "Unlicense",
+}
+
+var (
+	// licenseNamePhrases rewrites long license names to their short form
+	licenseNamePhrases = strings.NewReplacer(
+		"lesser general public license", "lgpl",
+		"library general public license", "lgpl",
+		"affero general public license", "agpl",
+		"general public license", "gpl",
+		"mozilla public license", "mpl",
+		"eclipse public license", "epl",
+		"boost software license", "bsl",
+		"or later", "orlater",
+		"or-later", "orlater",
+	)
+
+	// licenseFillerWords carry no meaning for identifying a license
+	licenseFillerWords = regexp.MustCompile(`\b(the|gnu|license|licence|version|software)\b`)
+
+	// licenseParenthetical matches "(MIT)" style suffixes
+	licenseParenthetical = regexp.MustCompile(`\([^)]*\)`)
+
+	// licenseVersionPrefix matches the "v" in "GPLv3" or "Apache v2"
+	licenseVersionPrefix = regexp.MustCompile(`v(\d)`)
+
+	// licenseKeyStrip removes everything but letters, digits and "+"
+	licenseKeyStrip = regexp.MustCompile(`[^a-z0-9+]`)
+
+	// licenseSeparators split multi-license strings such as
+	// "MIT, Apache-2.0", "MIT/Apache-2.0" or "MIT or Apache-2.0"
+	licenseSeparators = regexp.MustCompile(`(?i)\s*(?:,|;|/|\s+or\s+)\s*`)
+)
+
+// NormalizeLicense converts a manifest license string into an SPDX
+// expression. Common variants ("Apache 2.0", "apache2", "GPLv3") are
+// mapped to SPDX identifiers and multiple licenses are joined with " OR ".
+// Unrecognized licenses are kept as written. Returns an empty string for
+// empty input or license file references ("file:LICENSE").
+func NormalizeLicense(license string) string {
+	license = strings.TrimSpace(license)
+	if license == "" || strings.HasPrefix(license, "file:") {
+		return ""
+	}
+
+	// Long names may themselves contain separators, e.g.
+	// "The Apache Software License, Version 2.0"
+	if id, ok := spdxLicenseIDs[licenseKey(license)]; ok {
+		return id
+	}
+
+	seen := make(map[string]bool)
+	ids := make([]string, 0)
+	for _, part := range licenseSeparators.Split(license, -1) {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, ok := spdxLicenseIDs[licenseKey(part)]
+		if !ok {
+			id = part
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	return strings.Join(ids, " OR ")
+}
+
+// licenseKey reduces a license name to a lookup key for spdxLicenseIDs,
+// e.g. "GNU General Public License v3" becomes "gpl3"
+func licenseKey(license string) string {
+	key := strings.ToLower(license)
+	key = licenseParenthetical.ReplaceAllString(key, "")
+	key = licenseNamePhrases.Replace(key)
+	key = licenseFillerWords.ReplaceAllString(key, "")
+	key = licenseVersionPrefix.ReplaceAllString(key, "$1")
+	return licenseKeyStrip.ReplaceAllString(key, "")
+}
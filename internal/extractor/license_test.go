@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import "testing"
+
+// TestNormalizeLicense tests mapping manifest licenses to SPDX expressions
+func TestNormalizeLicense(t *testing.T) {
+	tests := []struct {
+		license  string
+		expected string
+	}{
+		{"MIT", "MIT"},
+		{"The MIT License (MIT)", "MIT"},
+		{"Apache-2.0", "Apache-2.0"},
+		{"Apache 2.0", "Apache-2.0"},
+		{"apache2", "Apache-2.0"},
+		{"The Apache Software License, Version 2.0", "Apache-2.0"},
+		{"BSD", "BSD-3-Clause"},
+		{"BSD3", "BSD-3-Clause"},
+		{"GPLv3", "GPL-3.0-only"},
+		{"GNU General Public License v2 or later", "GPL-2.0-or-later"},
+		{"LGPL-2.1+", "LGPL-2.1-or-later"},
+		{"MIT, Apache-2.0", "MIT OR Apache-2.0"},
+		{"MIT/Apache-2.0", "MIT OR Apache-2.0"},
+		{"MIT OR Apache-2.0", "MIT OR Apache-2.0"},
+		{"MIT, MIT", "MIT"},
+		{"Proprietary", "Proprietary"},
+		{"file:LICENSE", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.license, func(t *testing.T) {
+			if got := NormalizeLicense(tt.license); got != tt.expected {
+				t.Errorf("NormalizeLicense(%q) = %q, want %q", tt.license, got, tt.expected)
+			}
+		})
+	}
+}
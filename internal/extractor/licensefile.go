@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// LicenseSourceFile is the LicenseSource recorded for licenses identified
+// from a license file rather than the manifest
+const LicenseSourceFile = "file"
+
+// licenseFiles lists license file names in lookup order
+var licenseFiles = []string{
+	"LICENSE",
+	"LICENSE.md",
+	"LICENSE.txt",
+	"LICENCE",
+	"LICENCE.md",
+	"LICENCE.txt",
+	"COPYING",
+	"COPYING.md",
+	"COPYING.txt",
+}
+
+// licenseFileReadLimit bounds how much of a license file is inspected;
+// identifying text appears well within the first few KiB
+const licenseFileReadLimit = 8192
+
+// licenseTextRule identifies a license from phrases in its text. Rules are
+// checked in order, so more specific texts (LGPL, AGPL) precede GPL.
+type licenseTextRule struct {
+	id      string
+	pattern *regexp.Regexp
+}
+
+var licenseTextRules = []licenseTextRule{
+	{"Apache-2.0", regexp.MustCompile(`apache license,? version 2\.0`)},
+	{"AGPL-3.0-only", regexp.MustCompile(`gnu affero general public license version 3`)},
+	{"LGPL-2.1-only", regexp.MustCompile(`gnu lesser general public license version 2\.1`)},
+	{"LGPL-3.0-only", regexp.MustCompile(`gnu lesser general public license version 3`)},
+	{"GPL-2.0-only", regexp.MustCompile(`gnu general public license version 2`)},
+	{"GPL-3.0-only", regexp.MustCompile(`gnu general public license version 3`)},
+	{"MPL-2.0", regexp.MustCompile(`mozilla public license,? (?:version|v\.?) ?2\.0`)},
+	{"EPL-2.0", regexp.MustCompile(`eclipse public license - v ?2\.0`)},
+	{"BSL-1.0", regexp.MustCompile(`boost software license - version 1\.0`)},
+	{"Unlicense", regexp.MustCompile(`this is free and unencumbered software released into the public domain`)},
+	{"MIT", regexp.MustCompile(`\bmit license\b|permission is hereby granted, free of charge, to any person obtaining a copy`)},
+	{"ISC", regexp.MustCompile(`\bisc license\b|permission to use, copy, modify, and/or distribute this software for any purpose`)},
+	// BSD-3-Clause adds the "neither the name" endorsement clause
+	{"BSD-3-Clause", regexp.MustCompile(`redistribution and use in source and binary forms(?s:.*)neither the name`)},
+	{"BSD-2-Clause", regexp.MustCompile(`redistribution and use in source and binary forms`)},
+}
+
+// licenseWhitespace collapses line breaks and indentation in license text
+var licenseWhitespace = regexp.MustCompile(`\s+`)
+
+// ReadLicenseFile identifies the SPDX license of the project's license
+// file. It returns the identifier and file name, or false when no license
+// file exists or its text is not recognized.
+func ReadLicenseFile(projectPath string) (string, string, bool) {
+	for _, name := range licenseFiles {
+		file, err := os.Open(filepath.Join(projectPath, name))
+		if err != nil {
+			continue
+		}
+		content, err := io.ReadAll(io.LimitReader(file, licenseFileReadLimit))
+		file.Close()
+		if err != nil {
+			continue
+		}
+
+		if id := IdentifyLicenseText(string(content)); id != "" {
+			return id, name, true
+		}
+	}
+
+	return "", "", false
+}
+
+// IdentifyLicenseText returns the SPDX identifier matching common license
+// headers, or an empty string when the text is not recognized
+func IdentifyLicenseText(text string) string {
+	text = licenseWhitespace.ReplaceAllString(strings.ToLower(text), " ")
+	for _, rule := range licenseTextRules {
+		if rule.pattern.MatchString(text) {
+			return rule.id
+		}
+	}
+	return ""
+}
+
+// ApplyLicenseFile fills in an empty License from the project's license
+// file and marks LicenseSource as LicenseSourceFile. It is a no-op when
+// the manifest already declares a license. Returns true when the license
+// was set.
+func ApplyLicenseFile(projectPath string, metadata *ProjectMetadata) bool {
+	if metadata == nil || metadata.License != "" {
+		return false
+	}
+
+	id, _, ok := ReadLicenseFile(projectPath)
+	if !ok {
+		return false
+	}
+
+	metadata.License = id
+	metadata.LicenseSource = LicenseSourceFile
+	return true
+}
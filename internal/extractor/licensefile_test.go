@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const apacheLicenseHeader = `
+                                 Apache License
+                           Version 2.0, January 2004
+                        http://www.apache.org/licenses/
+
+   TERMS AND CONDITIONS FOR USE, REPRODUCTION, AND DISTRIBUTION
+`
+
+const mitLicenseText = `MIT License
+
+Copyright (c) 2025 Example
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction.
+`
+
+// TestApplyLicenseFile tests identifying the license when the manifest has none
+func TestApplyLicenseFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		want    string
+	}{
+		{"Apache LICENSE", "LICENSE", apacheLicenseHeader, "Apache-2.0"},
+		{"MIT LICENSE.md", "LICENSE.md", mitLicenseText, "MIT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFile(t, dir, "go.mod", "module example.com/app\n")
+			writeFile(t, dir, tt.file, tt.content)
+
+			// As returned by an extractor for a manifest without a license
+			metadata := &ProjectMetadata{Name: "app"}
+			if !ApplyLicenseFile(dir, metadata) {
+				t.Fatal("ApplyLicenseFile should set the license")
+			}
+			if metadata.License != tt.want {
+				t.Errorf("License = %v, want %v", metadata.License, tt.want)
+			}
+			if metadata.LicenseSource != LicenseSourceFile {
+				t.Errorf("LicenseSource = %v, want %v", metadata.LicenseSource, LicenseSourceFile)
+			}
+		})
+	}
+}
+
+// TestApplyLicenseFile_KeepsManifestLicense tests that manifest licenses win
+func TestApplyLicenseFile_KeepsManifestLicense(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "LICENSE", mitLicenseText)
+
+	metadata := &ProjectMetadata{License: "BSD-3-Clause"}
+	if ApplyLicenseFile(dir, metadata) {
+		t.Error("ApplyLicenseFile should not override a manifest license")
+	}
+	if metadata.License != "BSD-3-Clause" || metadata.LicenseSource != "" {
+		t.Errorf("Metadata changed: %+v", metadata)
+	}
+}
+
+// TestApplyLicenseFile_Unrecognized tests the no-op for unknown or missing files
+func TestApplyLicenseFile_Unrecognized(t *testing.T) {
+	dir := t.TempDir()
+	metadata := &ProjectMetadata{}
+	if ApplyLicenseFile(dir, metadata) {
+		t.Error("ApplyLicenseFile should be a no-op without a license file")
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "COPYING"), []byte("All rights reserved.\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if ApplyLicenseFile(dir, metadata) {
+		t.Error("ApplyLicenseFile should be a no-op for unrecognized text")
+	}
+}
+
+// TestIdentifyLicenseText tests license header matching
+func TestIdentifyLicenseText(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"GNU GENERAL PUBLIC LICENSE\n   Version 3, 29 June 2007", "GPL-3.0-only"},
+		{"GNU LESSER GENERAL PUBLIC LICENSE\n   Version 2.1, February 1999", "LGPL-2.1-only"},
+		{"Mozilla Public License Version 2.0\n==================================", "MPL-2.0"},
+		{"Redistribution and use in source and binary forms, with or without\nmodification, are permitted.\n3. Neither the name of the copyright holder", "BSD-3-Clause"},
+		{"Redistribution and use in source and binary forms, with or without\nmodification, are permitted.", "BSD-2-Clause"},
+		{"This is free and unencumbered software released into the public domain.", "Unlicense"},
+		{"Proprietary and confidential", ""},
+	}
+
+	for _, tt := range tests {
+		if got := IdentifyLicenseText(tt.text); got != tt.want {
+			t.Errorf("IdentifyLicenseText(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+// lockfileDependencies enables counting transitive dependencies from
+// lock files. It is package-scoped for the same reason as matrixOS;
+// cmd/build-metadata/main.go sets it from the lockfile_dependencies input.
+// Parsing lock files can be slow for large projects, so it is off by
+// default.
+var lockfileDependencies bool
+
+// SetLockfileDependencies enables or disables transitive dependency
+// counting from lock files
+func SetLockfileDependencies(enabled bool) {
+	lockfileDependencies = enabled
+}
+
+// LockfileDependenciesEnabled reports whether extractors should parse
+// lock files for transitive dependency counts
+func LockfileDependenciesEnabled() bool {
+	return lockfileDependencies
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+)
+
+// RecordManifest stores the absolute path and SHA-256 of the raw bytes of
+// the manifest an extractor parsed as manifest_path and manifest_sha256.
+// Unreadable files are skipped.
+func RecordManifest(metadata *ProjectMetadata, manifestPath string) {
+	if metadata == nil || manifestPath == "" {
+		return
+	}
+
+	content, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return
+	}
+
+	absPath, err := filepath.Abs(manifestPath)
+	if err != nil {
+		absPath = manifestPath
+	}
+
+	if metadata.LanguageSpecific == nil {
+		metadata.LanguageSpecific = make(map[string]interface{})
+	}
+	sum := sha256.Sum256(content)
+	metadata.LanguageSpecific["manifest_path"] = absPath
+	metadata.LanguageSpecific["manifest_sha256"] = hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestRecordManifest tests the manifest path and fingerprint
+func TestRecordManifest(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "go.mod")
+	if err := os.WriteFile(path, []byte("module example.com/test\n"), 0644); err != nil {
+		t.Fatalf("Failed to write go.mod: %v", err)
+	}
+
+	metadata := &ProjectMetadata{}
+	RecordManifest(metadata, path)
+
+	// sha256 of "module example.com/test\n"
+	want := "80f9dbae82ed37e3248356069826e0c49345747bd16ca405380dc7f008d7abec"
+	got, _ := metadata.LanguageSpecific["manifest_sha256"].(string)
+	if got != want {
+		t.Errorf("manifest_sha256 = %v, want %v", got, want)
+	}
+	if metadata.LanguageSpecific["manifest_path"] != path {
+		t.Errorf("manifest_path = %v, want %v", metadata.LanguageSpecific["manifest_path"], path)
+	}
+
+	// Raw bytes are hashed exactly: trailing whitespace changes the hash
+	if err := os.WriteFile(path, []byte("module example.com/test\n\n"), 0644); err != nil {
+		t.Fatalf("Failed to rewrite go.mod: %v", err)
+	}
+	RecordManifest(metadata, path)
+	if metadata.LanguageSpecific["manifest_sha256"] == got {
+		t.Error("manifest_sha256 should change when the raw bytes change")
+	}
+}
@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// DefaultMatrixOS is the runner OS list used when include-os is requested
+// without naming specific runners
+var DefaultMatrixOS = []string{"ubuntu-latest", "macos-latest", "windows-latest"}
+
+// matrixOS holds the runner OS list for cross matrices. It is
+// package-scoped because the Extractor.Extract signature is fixed;
+// cmd/build-metadata/main.go configures it from the include_os input
+// before invoking Extract. A nil list disables matrix_os_json.
+var matrixOS []string
+
+// SetMatrixOS enables matrix_os_json generation for the given runner OS
+// list. An empty (non-nil) list selects DefaultMatrixOS; nil disables it.
+func SetMatrixOS(osList []string) {
+	if osList != nil && len(osList) == 0 {
+		osList = DefaultMatrixOS
+	}
+	matrixOS = osList
+}
+
+// CrossMatrix returns a GitHub Actions matrix JSON document containing the
+// cross product of versions and runner OS, e.g.
+// {"include":[{"php-version":"8.1","os":"ubuntu-latest"}]}.
+// DefaultMatrixOS is used when osList is empty.
+func CrossMatrix(versionKey string, versions []string, osList []string) string {
+	if len(osList) == 0 {
+		osList = DefaultMatrixOS
+	}
+
+	entries := make([]string, 0, len(versions)*len(osList))
+	for _, version := range versions {
+		for _, os := range osList {
+			entries = append(entries, "{"+jsonString(versionKey)+":"+jsonString(version)+
+				`,"os":`+jsonString(os)+"}")
+		}
+	}
+
+	return `{"include":[` + strings.Join(entries, ",") + "]}"
+}
+
+// ApplyOSMatrix sets matrix_os_json from the version matrix when
+// include-os was requested. It leaves matrix_json untouched.
+func ApplyOSMatrix(metadata *ProjectMetadata, versionKey string, versions []string) {
+	if matrixOS == nil || metadata == nil || len(versions) == 0 {
+		return
+	}
+	if metadata.LanguageSpecific == nil {
+		metadata.LanguageSpecific = make(map[string]interface{})
+	}
+	metadata.LanguageSpecific["matrix_os_json"] = CrossMatrix(versionKey, versions, matrixOS)
+}
+
+// matrixVersionKey is the language-independent key added by
+// NormalizeMatrix, so workflows can rely on one key across languages
+const matrixVersionKey = "version"
+
+// IsMatrixVersionKey reports whether key names the version dimension of
+// a matrix: "version" or a language-specific "<language>-version"
+func IsMatrixVersionKey(key string) bool {
+	return key == matrixVersionKey || strings.HasSuffix(key, "-version")
+}
+
+// NormalizeMetadataMatrix returns the normalized version matrix of an
+// extractor's language-specific metadata. It reads matrix_json, falling
+// back to a single "<language>_version_matrix" list for extractors that
+// publish only that, such as Elixir, Scala and Julia. It returns "" when
+// neither yields a version matrix.
+func NormalizeMetadataMatrix(langSpecific map[string]interface{}) string {
+	if matrixJSON, ok := langSpecific["matrix_json"].(string); ok {
+		if matrix := NormalizeMatrix(matrixJSON); matrix != "" {
+			return matrix
+		}
+	}
+
+	var languageKey string
+	var versions []string
+	for key, value := range langSpecific {
+		language, ok := strings.CutSuffix(key, "_version_matrix")
+		if !ok || language == "" {
+			continue
+		}
+		list := stringList(value)
+		if len(list) == 0 {
+			continue
+		}
+		if languageKey != "" {
+			// Several version lists, e.g. a language and a platform;
+			// there is no single dimension to normalize
+			return ""
+		}
+		languageKey, versions = language+"-version", list
+	}
+	if languageKey == "" {
+		return ""
+	}
+	return normalizeVersions(languageKey, versions)
+}
+
+// stringList returns value as a list of strings, accepting the []string
+// extractors store and the []interface{} of decoded JSON
+func stringList(value interface{}) []string {
+	switch list := value.(type) {
+	case []string:
+		return list
+	case []interface{}:
+		strs := make([]string, 0, len(list))
+		for _, item := range list {
+			if s, ok := item.(string); ok {
+				strs = append(strs, s)
+			}
+		}
+		return strs
+	}
+	return nil
+}
+
+// NormalizeMatrix rewrites a single-dimension matrixJSON such as
+// {"php-version":["8.2"]} to use the "version" key, e.g.
+// {"version":["8.2"],"include":[{"php-version":"8.2","version":"8.2"}]}.
+// The include entries add the language-specific key to each job without
+// multiplying the matrix, as two list keys would. It returns "" when
+// matrixJSON is not a single-dimension version matrix.
+func NormalizeMatrix(matrixJSON string) string {
+	var matrix map[string][]string
+	if err := json.Unmarshal([]byte(matrixJSON), &matrix); err != nil || len(matrix) != 1 {
+		return ""
+	}
+	var languageKey string
+	for key := range matrix {
+		languageKey = key
+	}
+	if !IsMatrixVersionKey(languageKey) {
+		return ""
+	}
+	return normalizeVersions(languageKey, matrix[languageKey])
+}
+
+// normalizeVersions builds the normalized matrix for versions listed
+// under languageKey
+func normalizeVersions(languageKey string, versions []string) string {
+	include := make([]map[string]string, 0, len(versions))
+	for _, version := range versions {
+		include = append(include, map[string]string{
+			matrixVersionKey: version,
+			languageKey:      version,
+		})
+	}
+	normalized, err := json.Marshal(struct {
+		Version []string            `json:"version"`
+		Include []map[string]string `json:"include"`
+	}{versions, include})
+	if err != nil {
+		return ""
+	}
+	return string(normalized)
+}
+
+// jsonString encodes s as a JSON string literal
+func jsonString(s string) string {
+	encoded, _ := json.Marshal(s)
+	return string(encoded)
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestCrossMatrix tests the version and OS cross product
+func TestCrossMatrix(t *testing.T) {
+	got := CrossMatrix("php-version", []string{"8.1", "8.2"}, []string{"ubuntu-latest", "macos-latest"})
+	want := `{"include":[` +
+		`{"php-version":"8.1","os":"ubuntu-latest"},` +
+		`{"php-version":"8.1","os":"macos-latest"},` +
+		`{"php-version":"8.2","os":"ubuntu-latest"},` +
+		`{"php-version":"8.2","os":"macos-latest"}]}`
+	if got != want {
+		t.Errorf("CrossMatrix() = %s, want %s", got, want)
+	}
+
+	var parsed map[string][]map[string]string
+	if err := json.Unmarshal([]byte(got), &parsed); err != nil {
+		t.Fatalf("CrossMatrix produced invalid JSON: %v", err)
+	}
+}
+
+// TestCrossMatrix_DefaultOS tests the default runner OS list
+func TestCrossMatrix_DefaultOS(t *testing.T) {
+	var parsed struct {
+		Include []map[string]string `json:"include"`
+	}
+	if err := json.Unmarshal([]byte(CrossMatrix("swift-version", []string{"5.9"}, nil)), &parsed); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if len(parsed.Include) != len(DefaultMatrixOS) {
+		t.Fatalf("Include = %d entries, want %d", len(parsed.Include), len(DefaultMatrixOS))
+	}
+	for i, os := range DefaultMatrixOS {
+		if parsed.Include[i]["os"] != os || parsed.Include[i]["swift-version"] != "5.9" {
+			t.Errorf("Entry %d = %v", i, parsed.Include[i])
+		}
+	}
+}
+
+// TestApplyOSMatrix tests that matrix_os_json is only set when requested
+func TestApplyOSMatrix(t *testing.T) {
+	defer SetMatrixOS(nil)
+
+	metadata := &ProjectMetadata{LanguageSpecific: map[string]interface{}{"matrix_json": `{"go-version": ["1.22"]}`}}
+	ApplyOSMatrix(metadata, "go-version", []string{"1.22"})
+	if _, ok := metadata.LanguageSpecific["matrix_os_json"]; ok {
+		t.Error("matrix_os_json should not be set unless include-os is requested")
+	}
+
+	SetMatrixOS([]string{"ubuntu-latest"})
+	ApplyOSMatrix(metadata, "go-version", []string{"1.22"})
+	want := `{"include":[{"go-version":"1.22","os":"ubuntu-latest"}]}`
+	if got := metadata.LanguageSpecific["matrix_os_json"]; got != want {
+		t.Errorf("matrix_os_json = %v, want %v", got, want)
+	}
+	if got := metadata.LanguageSpecific["matrix_json"]; got != `{"go-version": ["1.22"]}` {
+		t.Errorf("matrix_json changed: %v", got)
+	}
+}
+
+func TestNormalizeMetadataMatrix(t *testing.T) {
+	tests := []struct {
+		name         string
+		langSpecific map[string]interface{}
+		want         string
+	}{
+		{
+			name: "matrix_json",
+			langSpecific: map[string]interface{}{
+				"matrix_json":       `{"go-version": ["1.22"]}`,
+				"go_version_matrix": []string{"1.22"},
+			},
+			want: `{"version":["1.22"],"include":[{"go-version":"1.22","version":"1.22"}]}`,
+		},
+		{
+			name:         "version list only",
+			langSpecific: map[string]interface{}{"elixir_version_matrix": []string{"1.16", "1.17"}},
+			want: `{"version":["1.16","1.17"],"include":[` +
+				`{"elixir-version":"1.16","version":"1.16"},{"elixir-version":"1.17","version":"1.17"}]}`,
+		},
+		{
+			name:         "decoded JSON list",
+			langSpecific: map[string]interface{}{"julia_version_matrix": []interface{}{"1.10"}},
+			want:         `{"version":["1.10"],"include":[{"julia-version":"1.10","version":"1.10"}]}`,
+		},
+		{
+			name: "several version lists",
+			langSpecific: map[string]interface{}{
+				"scala_version_matrix": []string{"3.3"},
+				"java_version_matrix":  []string{"17"},
+			},
+			want: "",
+		},
+		{name: "no matrix", langSpecific: map[string]interface{}{"name": "x"}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeMetadataMatrix(tt.langSpecific); got != tt.want {
+				t.Errorf("NormalizeMetadataMatrix() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeMatrix(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix string
+		want   string
+	}{
+		{
+			name:   "language-specific key",
+			matrix: `{"php-version": ["8.2", "8.3"]}`,
+			want: `{"version":["8.2","8.3"],"include":[` +
+				`{"php-version":"8.2","version":"8.2"},{"php-version":"8.3","version":"8.3"}]}`,
+		},
+		{
+			name:   "already normalized",
+			matrix: `{"version": ["1.0"]}`,
+			want:   `{"version":["1.0"],"include":[{"version":"1.0"}]}`,
+		},
+		{name: "no version key", matrix: `{"include": [{"os": "ubuntu-latest"}]}`, want: ""},
+		{name: "several version keys", matrix: `{"go-version": ["1.22"], "node-version": ["20"]}`, want: ""},
+		{name: "invalid JSON", matrix: `{"php-version": [`, want: ""},
+		{name: "empty", matrix: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeMatrix(tt.matrix); got != tt.want {
+				t.Errorf("NormalizeMatrix(%q) = %q, want %q", tt.matrix, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ExtractMerged runs every extractor in the global registry that detects
+// projectPath and merges the results. See Registry.ExtractMerged.
+func ExtractMerged(projectPath string) (*ProjectMetadata, []string, error) {
+	return globalRegistry.ExtractMerged(projectPath)
+}
+
+// ExtractMerged runs every registered extractor that detects projectPath
+// and combines the results for polyglot repositories. The common fields
+// come from the highest priority extractor that succeeds. Language
+// specific keys from every extractor are namespaced by language, e.g.
+// "go.module" or "javascript.package_manager". The returned languages are
+// those that contributed metadata, primary language first. Extractors
+// that fail are skipped; an error is returned only when none succeed, or
+// in strict mode when any of them finds an unparseable manifest.
+func (r *Registry) ExtractMerged(projectPath string) (*ProjectMetadata, []string, error) {
+	extractors := r.GetAll()
+	sort.Slice(extractors, func(i, j int) bool {
+		if extractors[i].Priority() != extractors[j].Priority() {
+			return extractors[i].Priority() > extractors[j].Priority()
+		}
+		return extractors[i].Name() < extractors[j].Name()
+	})
+
+	// Every extractor's Detect checks the same directory; share one
+	// listing across them
+	detected := detectAll(extractors, projectPath)
+
+	var merged *ProjectMetadata
+	languages := make([]string, 0)
+	var firstErr error
+
+	for _, e := range detected {
+		metadata, err := ExtractWithTimeout(e, projectPath)
+		var parseErr *ManifestParseError
+		if strictMode && errors.As(err, &parseErr) {
+			return nil, nil, fmt.Errorf("extractor %s: %w", e.Name(), err)
+		}
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if metadata == nil {
+			continue
+		}
+
+		if merged == nil {
+			merged = &ProjectMetadata{
+				Name:             metadata.Name,
+				Version:          metadata.Version,
+				VersionSource:    metadata.VersionSource,
+				Description:      metadata.Description,
+				License:          metadata.License,
+				Authors:          metadata.Authors,
+				Homepage:         metadata.Homepage,
+				Repository:       metadata.Repository,
+				LanguageSpecific: make(map[string]interface{}),
+			}
+		}
+
+		language := mergeNamespace(e.Name(), languages)
+		languages = append(languages, language)
+		for key, value := range metadata.LanguageSpecific {
+			merged.LanguageSpecific[language+"."+key] = value
+		}
+		for _, warning := range metadata.Warnings {
+			merged.Warnings = append(merged.Warnings, language+": "+warning)
+		}
+	}
+
+	if merged == nil {
+		if firstErr != nil {
+			return nil, nil, firstErr
+		}
+		return nil, nil, fmt.Errorf("no extractor detected a project at %s", projectPath)
+	}
+
+	return merged, languages, nil
+}
+
+// mergeNamespace returns the language namespace for an extractor name,
+// dropping the build tool suffix ("go-module" becomes "go"). The full
+// extractor name is used when the short form is already taken, such as
+// a repository with both Maven and Gradle builds.
+func mergeNamespace(extractorName string, taken []string) string {
+	language, _, _ := strings.Cut(extractorName, "-")
+	for _, existing := range taken {
+		if existing == language {
+			return extractorName
+		}
+	}
+	return language
+}
+
+// detectAll returns the extractors that detect projectPath, in order
+func detectAll(extractors []Extractor, projectPath string) []Extractor {
+	beginScan()
+	defer endScan()
+
+	detected := make([]Extractor, 0, len(extractors))
+	for _, e := range extractors {
+		if e.Detect(projectPath) {
+			detected = append(detected, e)
+		}
+	}
+	return detected
+}
@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// fixedExtractor detects a manifest file and returns fixed metadata
+type fixedExtractor struct {
+	manifestExtractor
+	metadata *ProjectMetadata
+	err      error
+}
+
+func (f *fixedExtractor) Extract(projectPath string) (*ProjectMetadata, error) {
+	return f.metadata, f.err
+}
+
+// TestRegistryExtractMerged tests merging a Go backend with a JavaScript frontend
+func TestRegistryExtractMerged(t *testing.T) {
+	root := t.TempDir()
+	for _, file := range []string{"go.mod", "package.json"} {
+		if err := os.WriteFile(filepath.Join(root, file), []byte("{}"), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", file, err)
+		}
+	}
+
+	registry := NewRegistry()
+	registry.Register(&fixedExtractor{
+		manifestExtractor: manifestExtractor{BaseExtractor: NewBaseExtractor("go-module", 2), manifest: "go.mod"},
+		metadata: &ProjectMetadata{
+			Name:             "backend",
+			Version:          "1.2.0",
+			VersionSource:    "go.mod",
+			LanguageSpecific: map[string]interface{}{"module": "example.com/backend"},
+		},
+	})
+	registry.Register(&fixedExtractor{
+		manifestExtractor: manifestExtractor{BaseExtractor: NewBaseExtractor("javascript", 1), manifest: "package.json"},
+		metadata: &ProjectMetadata{
+			Name:             "frontend",
+			Version:          "0.3.0",
+			LanguageSpecific: map[string]interface{}{"package_manager": "npm"},
+		},
+	})
+	registry.Register(&manifestExtractor{BaseExtractor: NewBaseExtractor("rust-cargo", 3), manifest: "Cargo.toml"})
+
+	metadata, languages, err := registry.ExtractMerged(root)
+	if err != nil {
+		t.Fatalf("ExtractMerged() error = %v", err)
+	}
+
+	if want := []string{"go", "javascript"}; !reflect.DeepEqual(languages, want) {
+		t.Errorf("languages = %v, want %v", languages, want)
+	}
+	if metadata.Name != "backend" || metadata.Version != "1.2.0" || metadata.VersionSource != "go.mod" {
+		t.Errorf("Common fields should come from the primary extractor: %+v", metadata)
+	}
+
+	want := map[string]interface{}{
+		"go.module":                  "example.com/backend",
+		"javascript.package_manager": "npm",
+	}
+	if !reflect.DeepEqual(metadata.LanguageSpecific, want) {
+		t.Errorf("LanguageSpecific = %v, want %v", metadata.LanguageSpecific, want)
+	}
+}
+
+// TestRegistryExtractMerged_SkipsFailures tests that a failing extractor
+// does not hide the others
+func TestRegistryExtractMerged_SkipsFailures(t *testing.T) {
+	root := t.TempDir()
+	for _, file := range []string{"go.mod", "package.json"} {
+		if err := os.WriteFile(filepath.Join(root, file), []byte("{}"), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", file, err)
+		}
+	}
+
+	registry := NewRegistry()
+	registry.Register(&fixedExtractor{
+		manifestExtractor: manifestExtractor{BaseExtractor: NewBaseExtractor("go-module", 2), manifest: "go.mod"},
+		err:               errors.New("broken go.mod"),
+	})
+	registry.Register(&fixedExtractor{
+		manifestExtractor: manifestExtractor{BaseExtractor: NewBaseExtractor("javascript", 1), manifest: "package.json"},
+		metadata:          &ProjectMetadata{Name: "frontend", LanguageSpecific: map[string]interface{}{}},
+	})
+
+	metadata, languages, err := registry.ExtractMerged(root)
+	if err != nil {
+		t.Fatalf("ExtractMerged() error = %v", err)
+	}
+	if metadata.Name != "frontend" {
+		t.Errorf("Name = %v, want frontend", metadata.Name)
+	}
+	if want := []string{"javascript"}; !reflect.DeepEqual(languages, want) {
+		t.Errorf("languages = %v, want %v", languages, want)
+	}
+
+	if _, _, err := registry.ExtractMerged(t.TempDir()); err == nil {
+		t.Error("ExtractMerged() should fail when no extractor detects the project")
+	}
+}
+
+// TestRegistryExtractMerged_StrictMode tests that an unparseable manifest
+// fails the merge in strict mode instead of being skipped
+func TestRegistryExtractMerged_StrictMode(t *testing.T) {
+	root := t.TempDir()
+	for _, file := range []string{"go.mod", "package.json"} {
+		if err := os.WriteFile(filepath.Join(root, file), []byte("{}"), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", file, err)
+		}
+	}
+
+	registry := NewRegistry()
+	registry.Register(&fixedExtractor{
+		manifestExtractor: manifestExtractor{BaseExtractor: NewBaseExtractor("go-module", 2), manifest: "go.mod"},
+		err:               &ManifestParseError{Manifest: "go.mod", Err: errors.New("unexpected token")},
+	})
+	registry.Register(&fixedExtractor{
+		manifestExtractor: manifestExtractor{BaseExtractor: NewBaseExtractor("javascript", 1), manifest: "package.json"},
+		metadata:          &ProjectMetadata{Name: "frontend", LanguageSpecific: map[string]interface{}{}},
+	})
+
+	if _, _, err := registry.ExtractMerged(root); err != nil {
+		t.Fatalf("ExtractMerged() error = %v, want the failure skipped outside strict mode", err)
+	}
+
+	SetStrictMode(true)
+	defer SetStrictMode(false)
+
+	_, _, err := registry.ExtractMerged(root)
+	var parseErr *ManifestParseError
+	if !errors.As(err, &parseErr) || parseErr.Manifest != "go.mod" {
+		t.Errorf("ExtractMerged() error = %v, want the go.mod ManifestParseError", err)
+	}
+}
+
+// TestMergeNamespace tests deriving language namespaces from extractor names
+func TestMergeNamespace(t *testing.T) {
+	if got := mergeNamespace("go-module", nil); got != "go" {
+		t.Errorf("mergeNamespace(go-module) = %v, want go", got)
+	}
+	if got := mergeNamespace("java-gradle", []string{"java"}); got != "java-gradle" {
+		t.Errorf("mergeNamespace(java-gradle) = %v, want java-gradle", got)
+	}
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package nim
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+)
+
+// Extractor extracts metadata from Nim projects
+type Extractor struct {
+	extractor.BaseExtractor
+}
+
+// NewExtractor creates a new Nim extractor
+func NewExtractor() *Extractor {
+	return &Extractor{
+		BaseExtractor: extractor.NewBaseExtractor("nim", 1),
+	}
+}
+
+func init() {
+	extractor.RegisterExtractor(NewExtractor())
+}
+
+// Detect checks if this is a Nim project
+func (e *Extractor) Detect(projectPath string) bool {
+	return findNimbleFile(projectPath) != ""
+}
+
+// Extract retrieves metadata from a Nim project
+func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, error) {
+	metadata := &extractor.ProjectMetadata{
+		LanguageSpecific: make(map[string]interface{}),
+	}
+
+	nimblePath := findNimbleFile(projectPath)
+	if nimblePath == "" {
+		return nil, fmt.Errorf("no .nimble file found in %s", projectPath)
+	}
+
+	if err := e.extractFromNimble(nimblePath, metadata); err != nil {
+		return nil, err
+	}
+	extractor.RecordManifest(metadata, nimblePath)
+
+	metadata.LanguageSpecific["build_tool"] = "Nimble"
+	return metadata, nil
+}
+
+// findNimbleFile returns the first *.nimble file in the project root
+func findNimbleFile(projectPath string) string {
+	matches := extractor.GlobFiles(projectPath, "*.nimble")
+	if len(matches) == 0 {
+		return ""
+	}
+	return matches[0]
+}
+
+// extractFromNimble parses a .nimble file. Nimble files are NimScript, so
+// only the conventional top-level assignments and requires calls are read.
+func (e *Extractor) extractFromNimble(path string, metadata *extractor.ProjectMetadata) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fileName := filepath.Base(path)
+	metadata.LanguageSpecific["metadata_source"] = fileName
+
+	scanner := bufio.NewScanner(file)
+
+	assignRegex := regexp.MustCompile(`^(\w+)\s*=\s*(.+)$`)
+	requiresRegex := regexp.MustCompile(`^requires\s*\(?\s*(.+?)\s*\)?$`)
+	stringRegex := regexp.MustCompile(`"([^"]*)"`)
+
+	var dependencies []string
+	var nimVersion string
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		// Skip comments
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// requires "pkg >= 1.0", "other"
+		if matches := requiresRegex.FindStringSubmatch(line); matches != nil {
+			for _, req := range stringRegex.FindAllStringSubmatch(matches[1], -1) {
+				requirement := strings.TrimSpace(req[1])
+				if requirement == "" {
+					continue
+				}
+				if name, constraint := splitRequirement(requirement); name == "nim" {
+					nimVersion = constraint
+					continue
+				}
+				dependencies = append(dependencies, requirement)
+			}
+			continue
+		}
+
+		matches := assignRegex.FindStringSubmatch(line)
+		if matches == nil {
+			continue
+		}
+		key, value := matches[1], strings.TrimSpace(matches[2])
+
+		switch key {
+		case "packageName":
+			metadata.Name = unquote(value)
+		case "version":
+			metadata.Version = unquote(value)
+			metadata.VersionSource = fileName
+		case "author":
+			if author := unquote(value); author != "" {
+				metadata.Authors = []string{author}
+			}
+		case "description":
+			metadata.Description = unquote(value)
+		case "license":
+			metadata.License = unquote(value)
+		case "srcDir":
+			metadata.LanguageSpecific["src_dir"] = unquote(value)
+		case "bin":
+			var bins []string
+			for _, bin := range stringRegex.FindAllStringSubmatch(value, -1) {
+				bins = append(bins, bin[1])
+			}
+			if len(bins) > 0 {
+				metadata.LanguageSpecific["bin"] = bins
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	// Nimble names the package after the file unless packageName is set
+	if metadata.Name == "" {
+		metadata.Name = strings.TrimSuffix(fileName, ".nimble")
+	}
+
+	if nimVersion != "" {
+		metadata.LanguageSpecific["nim_version"] = nimVersion
+	}
+
+	if len(dependencies) > 0 {
+		metadata.LanguageSpecific["dependencies"] = dependencies
+		metadata.LanguageSpecific["dependency_count"] = len(dependencies)
+	}
+
+	return nil
+}
+
+// splitRequirement splits a requirement such as "jester >= 0.5" into the
+// package name and its version constraint
+func splitRequirement(requirement string) (string, string) {
+	idx := strings.IndexAny(requirement, " <>=~^#@")
+	if idx < 0 {
+		return requirement, ""
+	}
+	return requirement[:idx], strings.TrimSpace(requirement[idx:])
+}
+
+// unquote returns the contents of a quoted NimScript string literal
+func unquote(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
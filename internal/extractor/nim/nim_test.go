@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package nim
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExtractor(t *testing.T) {
+	e := NewExtractor()
+	assert.NotNil(t, e)
+	assert.Equal(t, "nim", e.Name())
+	assert.Equal(t, 1, e.Priority())
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expected bool
+	}{
+		{
+			name: "nimble file present",
+			files: map[string]string{
+				"mylib.nimble": `version = "0.1.0"`,
+			},
+			expected: true,
+		},
+		{
+			name: "only Nim sources",
+			files: map[string]string{
+				"src/mylib.nim": "echo 1",
+			},
+			expected: false,
+		},
+		{
+			name:     "no Nim indicators",
+			files:    map[string]string{},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+
+			for path, content := range tt.files {
+				fullPath := filepath.Join(tmpDir, path)
+				err := os.MkdirAll(filepath.Dir(fullPath), 0755)
+				require.NoError(t, err)
+				err = os.WriteFile(fullPath, []byte(content), 0644)
+				require.NoError(t, err)
+			}
+
+			e := NewExtractor()
+			assert.Equal(t, tt.expected, e.Detect(tmpDir))
+		})
+	}
+}
+
+func TestExtractFromNimble(t *testing.T) {
+	nimbleContent := `# Package
+
+version       = "1.2.3"
+author        = "Jane Doe"
+description   = "A sample Nim library"
+license       = "MIT"
+srcDir        = "src"
+bin           = @["mytool", "helper"]
+
+# Dependencies
+
+requires "nim >= 1.6.0"
+requires "jester >= 0.5.0", "karax"
+requires("regex ~= 0.20")
+`
+
+	tmpDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmpDir, "mytool.nimble"), []byte(nimbleContent), 0644)
+	require.NoError(t, err)
+
+	e := NewExtractor()
+	metadata, err := e.Extract(tmpDir)
+	require.NoError(t, err)
+	require.NotNil(t, metadata)
+
+	assert.Equal(t, "mytool", metadata.Name)
+	assert.Equal(t, "1.2.3", metadata.Version)
+	assert.Equal(t, "mytool.nimble", metadata.VersionSource)
+	assert.Equal(t, []string{"Jane Doe"}, metadata.Authors)
+	assert.Equal(t, "A sample Nim library", metadata.Description)
+	assert.Equal(t, "MIT", metadata.License)
+
+	assert.Equal(t, "Nimble", metadata.LanguageSpecific["build_tool"])
+	assert.Equal(t, "mytool.nimble", metadata.LanguageSpecific["metadata_source"])
+	assert.Equal(t, "src", metadata.LanguageSpecific["src_dir"])
+	assert.Equal(t, []string{"mytool", "helper"}, metadata.LanguageSpecific["bin"])
+	assert.Equal(t, ">= 1.6.0", metadata.LanguageSpecific["nim_version"])
+	assert.Equal(t, []string{"jester >= 0.5.0", "karax", "regex ~= 0.20"}, metadata.LanguageSpecific["dependencies"])
+	assert.Equal(t, 3, metadata.LanguageSpecific["dependency_count"])
+}
+
+func TestExtractExplicitPackageName(t *testing.T) {
+	nimbleContent := `packageName = "renamed"
+version = "0.1.0"
+`
+
+	tmpDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmpDir, "original.nimble"), []byte(nimbleContent), 0644)
+	require.NoError(t, err)
+
+	e := NewExtractor()
+	metadata, err := e.Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "renamed", metadata.Name)
+	assert.Equal(t, "0.1.0", metadata.Version)
+	assert.Nil(t, metadata.LanguageSpecific["dependencies"])
+}
+
+func TestExtractNoNimbleFile(t *testing.T) {
+	e := NewExtractor()
+	_, err := e.Extract(t.TempDir())
+	assert.Error(t, err)
+}
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package openapi
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+)
+
+// specFiles are the API definition files looked for, in order of preference
+var specFiles = []string{
+	"openapi.yaml",
+	"openapi.yml",
+	"openapi.json",
+	"swagger.yaml",
+	"swagger.yml",
+	"swagger.json",
+}
+
+// httpMethods are the operation keys of an OpenAPI path item
+var httpMethods = map[string]bool{
+	"get": true, "put": true, "post": true, "delete": true,
+	"options": true, "head": true, "patch": true, "trace": true,
+}
+
+// Extractor extracts metadata from OpenAPI/Swagger API definitions
+type Extractor struct {
+	extractor.BaseExtractor
+}
+
+// NewExtractor creates a new OpenAPI extractor. Like Docker it is
+// registered at the lowest priority, so a code repository that merely
+// includes an API spec is still reported as its language.
+func NewExtractor() *Extractor {
+	return &Extractor{
+		BaseExtractor: extractor.NewBaseExtractor("openapi", 0),
+	}
+}
+
+func init() {
+	extractor.RegisterExtractor(NewExtractor())
+}
+
+// Spec represents the top-level fields of an OpenAPI 3 or Swagger 2
+// document. JSON specs parse with the YAML decoder as JSON is valid YAML.
+type Spec struct {
+	OpenAPI string                            `yaml:"openapi"`
+	Swagger string                            `yaml:"swagger"`
+	Info    Info                              `yaml:"info"`
+	Servers []Server                          `yaml:"servers"`
+	Host    string                            `yaml:"host"`
+	Paths   map[string]map[string]interface{} `yaml:"paths"`
+}
+
+// Info is the API information object
+type Info struct {
+	Title       string  `yaml:"title"`
+	Version     string  `yaml:"version"`
+	Description string  `yaml:"description"`
+	License     License `yaml:"license"`
+	Contact     Contact `yaml:"contact"`
+}
+
+// License is the API license object
+type License struct {
+	Name       string `yaml:"name"`
+	Identifier string `yaml:"identifier"`
+	URL        string `yaml:"url"`
+}
+
+// Contact is the API contact object
+type Contact struct {
+	Name  string `yaml:"name"`
+	Email string `yaml:"email"`
+	URL   string `yaml:"url"`
+}
+
+// Server is an OpenAPI 3 server object
+type Server struct {
+	URL string `yaml:"url"`
+}
+
+// Detect checks if the project contains an OpenAPI or Swagger spec
+func (e *Extractor) Detect(projectPath string) bool {
+	return findSpecFile(projectPath) != ""
+}
+
+// Extract retrieves metadata from an OpenAPI or Swagger spec
+func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, error) {
+	specPath := findSpecFile(projectPath)
+	if specPath == "" {
+		return nil, fmt.Errorf("no OpenAPI or Swagger spec found in %s", projectPath)
+	}
+
+	content, err := os.ReadFile(specPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", filepath.Base(specPath), err)
+	}
+
+	var spec Spec
+	if err := yaml.Unmarshal(content, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filepath.Base(specPath), err)
+	}
+
+	metadata := &extractor.ProjectMetadata{
+		LanguageSpecific: make(map[string]interface{}),
+	}
+
+	metadata.Name = spec.Info.Title
+	metadata.Description = spec.Info.Description
+	if spec.Info.Version != "" {
+		metadata.Version = spec.Info.Version
+		metadata.VersionSource = filepath.Base(specPath)
+	}
+	if spec.Info.License.Identifier != "" {
+		metadata.License = spec.Info.License.Identifier
+	} else {
+		metadata.License = spec.Info.License.Name
+	}
+	if spec.Info.Contact.Name != "" {
+		author := spec.Info.Contact.Name
+		if spec.Info.Contact.Email != "" {
+			author = fmt.Sprintf("%s <%s>", author, spec.Info.Contact.Email)
+		}
+		metadata.Authors = []string{author}
+	}
+	metadata.Homepage = spec.Info.Contact.URL
+
+	metadata.LanguageSpecific["spec_file"] = filepath.Base(specPath)
+	metadata.LanguageSpecific["metadata_source"] = filepath.Base(specPath)
+	if spec.OpenAPI != "" {
+		metadata.LanguageSpecific["spec_format"] = "openapi"
+		metadata.LanguageSpecific["spec_version"] = spec.OpenAPI
+	} else if spec.Swagger != "" {
+		metadata.LanguageSpecific["spec_format"] = "swagger"
+		metadata.LanguageSpecific["spec_version"] = spec.Swagger
+	}
+
+	// Each path is an endpoint; each HTTP method under it an operation
+	operations := 0
+	for _, item := range spec.Paths {
+		for method := range item {
+			if httpMethods[strings.ToLower(method)] {
+				operations++
+			}
+		}
+	}
+	metadata.LanguageSpecific["endpoint_count"] = len(spec.Paths)
+	metadata.LanguageSpecific["operation_count"] = operations
+
+	servers := make([]string, 0, len(spec.Servers))
+	for _, server := range spec.Servers {
+		if server.URL != "" {
+			servers = append(servers, server.URL)
+		}
+	}
+	if len(servers) == 0 && spec.Host != "" {
+		servers = append(servers, spec.Host)
+	}
+	if len(servers) > 0 {
+		metadata.LanguageSpecific["servers"] = servers
+	}
+
+	extractor.RecordManifest(metadata, specPath)
+
+	return metadata, nil
+}
+
+// findSpecFile returns the path of the first spec file present in
+// projectPath, or an empty string when there is none
+func findSpecFile(projectPath string) string {
+	for _, name := range specFiles {
+		path := filepath.Join(projectPath, name)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path
+		}
+	}
+	return ""
+}
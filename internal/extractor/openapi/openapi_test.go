@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package openapi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractor_Name(t *testing.T) {
+	e := NewExtractor()
+	assert.Equal(t, "openapi", e.Name())
+	assert.Equal(t, 0, e.Priority())
+}
+
+func TestExtractor_Detect(t *testing.T) {
+	dir := t.TempDir()
+	e := NewExtractor()
+	assert.False(t, e.Detect(dir))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "swagger.json"), []byte(`{"swagger": "2.0"}`), 0644))
+	assert.True(t, e.Detect(dir))
+}
+
+func TestExtractor_Extract_OpenAPI(t *testing.T) {
+	dir := t.TempDir()
+	spec := `openapi: 3.1.0
+info:
+  title: Pet Store
+  version: 1.4.0
+  description: A sample API
+  license:
+    name: Apache 2.0
+    identifier: Apache-2.0
+  contact:
+    name: API Team
+    email: api@example.com
+servers:
+  - url: https://api.example.com/v1
+paths:
+  /pets:
+    get:
+      summary: List pets
+    post:
+      summary: Create a pet
+  /pets/{petId}:
+    parameters:
+      - name: petId
+        in: path
+    get:
+      summary: Get a pet
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "openapi.yaml"), []byte(spec), 0644))
+
+	e := NewExtractor()
+	metadata, err := e.Extract(dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Pet Store", metadata.Name)
+	assert.Equal(t, "1.4.0", metadata.Version)
+	assert.Equal(t, "openapi.yaml", metadata.VersionSource)
+	assert.Equal(t, "A sample API", metadata.Description)
+	assert.Equal(t, "Apache-2.0", metadata.License)
+	assert.Equal(t, []string{"API Team <api@example.com>"}, metadata.Authors)
+
+	assert.Equal(t, "openapi", metadata.LanguageSpecific["spec_format"])
+	assert.Equal(t, "3.1.0", metadata.LanguageSpecific["spec_version"])
+	assert.Equal(t, 2, metadata.LanguageSpecific["endpoint_count"])
+	assert.Equal(t, 3, metadata.LanguageSpecific["operation_count"])
+	assert.Equal(t, []string{"https://api.example.com/v1"}, metadata.LanguageSpecific["servers"])
+}
+
+func TestExtractor_Extract_SwaggerJSON(t *testing.T) {
+	dir := t.TempDir()
+	spec := `{
+  "swagger": "2.0",
+  "info": {"title": "Legacy API", "version": "0.9.0"},
+  "host": "legacy.example.com",
+  "paths": {"/status": {"get": {}}}
+}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "swagger.json"), []byte(spec), 0644))
+
+	e := NewExtractor()
+	metadata, err := e.Extract(dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Legacy API", metadata.Name)
+	assert.Equal(t, "0.9.0", metadata.Version)
+	assert.Equal(t, "swagger", metadata.LanguageSpecific["spec_format"])
+	assert.Equal(t, "2.0", metadata.LanguageSpecific["spec_version"])
+	assert.Equal(t, 1, metadata.LanguageSpecific["endpoint_count"])
+	assert.Equal(t, []string{"legacy.example.com"}, metadata.LanguageSpecific["servers"])
+}
+
+func TestExtractor_Extract_NoSpec(t *testing.T) {
+	e := NewExtractor()
+	_, err := e.Extract(t.TempDir())
+	assert.Error(t, err)
+}
@@ -0,0 +1,427 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package perl
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+)
+
+const (
+	makefilePLName = "Makefile.PL"
+	buildPLName    = "Build.PL"
+	cpanfileName   = "cpanfile"
+)
+
+// Extractor extracts metadata from Perl distributions
+type Extractor struct {
+	extractor.BaseExtractor
+}
+
+// NewExtractor creates a new Perl extractor
+func NewExtractor() *Extractor {
+	return &Extractor{
+		BaseExtractor: extractor.NewBaseExtractor("perl", 1),
+	}
+}
+
+func init() {
+	extractor.RegisterExtractor(NewExtractor())
+}
+
+// Distribution represents the metadata of a CPAN distribution gathered
+// from Makefile.PL, Build.PL and cpanfile
+type Distribution struct {
+	ModuleName     string
+	DistName       string
+	Version        string
+	VersionFrom    string // Module file the version is read from
+	Abstract       string
+	Authors        []string
+	License        string
+	MinPerlVersion string
+
+	// Module name to version requirement, "0" for any version
+	Requires          map[string]string
+	BuildRequires     map[string]string
+	TestRequires      map[string]string
+	ConfigureRequires map[string]string
+}
+
+// Keys naming the same field in ExtUtils::MakeMaker's WriteMakefile and
+// Module::Build's new
+type buildKeys struct {
+	moduleName, distName, version, versionFrom, abstract, author, license string
+	requires, buildRequires, testRequires, configureRequires              string
+}
+
+var (
+	makeMakerKeys = buildKeys{
+		moduleName: "NAME", distName: "DISTNAME", version: "VERSION", versionFrom: "VERSION_FROM",
+		abstract: "ABSTRACT", author: "AUTHOR", license: "LICENSE",
+		requires: "PREREQ_PM", buildRequires: "BUILD_REQUIRES", testRequires: "TEST_REQUIRES",
+		configureRequires: "CONFIGURE_REQUIRES",
+	}
+	moduleBuildKeys = buildKeys{
+		moduleName: "module_name", distName: "dist_name", version: "dist_version", versionFrom: "dist_version_from",
+		abstract: "dist_abstract", author: "dist_author", license: "license",
+		requires: "requires", buildRequires: "build_requires", testRequires: "test_requires",
+		configureRequires: "configure_requires",
+	}
+)
+
+var (
+	// 'Module::Name' => '1.02', Module::Name => 0 or "Module" => "v1.2"
+	perlPairRegex   = regexp.MustCompile(`['"]?([\w:]+)['"]?\s*=>\s*(?:'([^']*)'|"([^"]*)"|([\w.]+))`)
+	perlStringRegex = regexp.MustCompile(`'([^']*)'|"([^"]*)"`)
+	// A quoted string or bare number at the start of the input
+	perlLiteralRegex = regexp.MustCompile(`^(?:'([^']*)'|"([^"]*)"|([\w.]+))`)
+	// $VERSION = '1.02'; or our $VERSION = "1.02";
+	moduleVersionRegex = regexp.MustCompile(`\$VERSION\s*=\s*['"]?v?([\d._]+)['"]?`)
+	// package Foo::Bar 1.02; (Perl 5.12+)
+	packageVersionRegex = regexp.MustCompile(`(?m)^\s*package\s+[\w:]+\s+v?([\d._]+)`)
+	// requires 'Module', '1.0'; or test_requires 'Module' => '1.0';
+	cpanfileRequiresRegex = regexp.MustCompile(`\b(requires|test_requires|build_requires|configure_requires)\s*\(?\s*['"]([^'"]+)['"]\s*(?:(?:,|=>)\s*(?:'([^']*)'|"([^"]*)"|([\w.]+)))?`)
+	// on 'test' => sub {
+	cpanfilePhaseRegex = regexp.MustCompile(`\bon\s*\(?\s*['"]?(\w+)['"]?\s*=>\s*sub\s*{`)
+)
+
+// Detect checks if this is a Perl distribution
+func (e *Extractor) Detect(projectPath string) bool {
+	for _, name := range []string{makefilePLName, buildPLName, cpanfileName} {
+		if extractor.FileExists(projectPath, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Extract retrieves metadata from a Perl distribution
+func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, error) {
+	metadata := &extractor.ProjectMetadata{
+		LanguageSpecific: make(map[string]interface{}),
+	}
+
+	dist := &Distribution{}
+	var manifestPath, buildTool string
+	for _, candidate := range []struct {
+		name, tool string
+		keys       buildKeys
+	}{
+		{makefilePLName, "ExtUtils::MakeMaker", makeMakerKeys},
+		{buildPLName, "Module::Build", moduleBuildKeys},
+	} {
+		path := filepath.Join(projectPath, candidate.name)
+		content, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		dist = parseBuildScript(string(content), candidate.keys)
+		manifestPath, buildTool = path, candidate.tool
+		break
+	}
+
+	// cpanfile lists dependencies alongside, or instead of, a build script
+	cpanfilePath := filepath.Join(projectPath, cpanfileName)
+	if content, err := os.ReadFile(cpanfilePath); err == nil {
+		mergeRequirements(dist, parseCpanfile(string(content)))
+		if manifestPath == "" {
+			manifestPath, buildTool = cpanfilePath, "cpanfile"
+		}
+	}
+	if manifestPath == "" {
+		return nil, fmt.Errorf("no %s, %s or %s found in %s", makefilePLName, buildPLName, cpanfileName, projectPath)
+	}
+	metadataSource := filepath.Base(manifestPath)
+
+	// The distribution name replaces "::" with "-", e.g. Foo-Bar
+	name := dist.DistName
+	if name == "" && dist.ModuleName != "" {
+		name = strings.ReplaceAll(dist.ModuleName, "::", "-")
+	}
+	if name == "" {
+		name = filepath.Base(projectPath)
+	}
+	metadata.Name = name
+
+	if dist.Version != "" {
+		metadata.Version = dist.Version
+		metadata.VersionSource = metadataSource
+	} else if dist.VersionFrom != "" {
+		if version := moduleVersion(filepath.Join(projectPath, dist.VersionFrom)); version != "" {
+			metadata.Version = version
+			metadata.VersionSource = dist.VersionFrom
+		}
+	}
+	metadata.Description = dist.Abstract
+	metadata.Authors = dist.Authors
+	metadata.License = dist.License
+
+	metadata.LanguageSpecific["build_tool"] = buildTool
+	metadata.LanguageSpecific["metadata_source"] = metadataSource
+	metadata.LanguageSpecific["distribution_name"] = name
+	if dist.ModuleName != "" {
+		metadata.LanguageSpecific["module_name"] = dist.ModuleName
+	}
+	if dist.MinPerlVersion != "" {
+		metadata.LanguageSpecific["min_perl_version"] = dist.MinPerlVersion
+	}
+	if len(dist.Requires) > 0 {
+		metadata.LanguageSpecific["dependencies"] = dist.Requires
+		metadata.LanguageSpecific["dependency_count"] = len(dist.Requires)
+	}
+	// Configure-time requirements are needed to build as well
+	buildRequires := make(map[string]string)
+	for _, reqs := range []map[string]string{dist.ConfigureRequires, dist.BuildRequires} {
+		for module, version := range reqs {
+			buildRequires[module] = version
+		}
+	}
+	if len(buildRequires) > 0 {
+		metadata.LanguageSpecific["build_dependencies"] = buildRequires
+	}
+	if len(dist.TestRequires) > 0 {
+		metadata.LanguageSpecific["test_dependencies"] = dist.TestRequires
+	}
+
+	extractor.RecordManifest(metadata, manifestPath)
+
+	return metadata, nil
+}
+
+// parseBuildScript reads the WriteMakefile(...) or Module::Build->new(...)
+// arguments of a Makefile.PL or Build.PL. The scripts are Perl code, so
+// only literal values are recognized.
+func parseBuildScript(content string, keys buildKeys) *Distribution {
+	content = stripPerlComments(content)
+
+	dist := &Distribution{
+		ModuleName:        perlScalar(content, keys.moduleName),
+		DistName:          perlScalar(content, keys.distName),
+		Version:           perlScalar(content, keys.version),
+		VersionFrom:       perlScalar(content, keys.versionFrom),
+		Abstract:          perlScalar(content, keys.abstract),
+		Authors:           perlStrings(content, keys.author),
+		License:           perlScalar(content, keys.license),
+		MinPerlVersion:    perlScalar(content, "MIN_PERL_VERSION"),
+		Requires:          perlHash(content, keys.requires),
+		BuildRequires:     perlHash(content, keys.buildRequires),
+		TestRequires:      perlHash(content, keys.testRequires),
+		ConfigureRequires: perlHash(content, keys.configureRequires),
+	}
+	takeMinPerlVersion(dist)
+	return dist
+}
+
+// parseCpanfile reads the requirements of a cpanfile, grouped by the
+// phase of the enclosing on '...' => sub { ... } block
+func parseCpanfile(content string) *Distribution {
+	content = stripPerlComments(content)
+	dist := &Distribution{
+		Requires:          make(map[string]string),
+		BuildRequires:     make(map[string]string),
+		TestRequires:      make(map[string]string),
+		ConfigureRequires: make(map[string]string),
+	}
+	phaseRequires := map[string]map[string]string{
+		"runtime":   dist.Requires,
+		"build":     dist.BuildRequires,
+		"test":      dist.TestRequires,
+		"configure": dist.ConfigureRequires,
+	}
+
+	// Phase blocks open with "on 'test' => sub {" and close with "};"
+	type phaseBlock struct {
+		phase string
+		depth int
+	}
+	var blocks []phaseBlock
+	depth := 0
+	for _, line := range strings.Split(content, "\n") {
+		phase := "runtime"
+		if len(blocks) > 0 {
+			phase = blocks[len(blocks)-1].phase
+		}
+		if matches := cpanfilePhaseRegex.FindStringSubmatch(line); matches != nil {
+			phase = matches[1]
+			blocks = append(blocks, phaseBlock{phase: phase, depth: depth + 1})
+		}
+
+		for _, matches := range cpanfileRequiresRegex.FindAllStringSubmatch(line, -1) {
+			reqPhase := phase
+			if keyword := matches[1]; keyword != "requires" {
+				reqPhase = strings.TrimSuffix(keyword, "_requires")
+			}
+			// Develop requirements and other phases are not reported
+			if reqs, ok := phaseRequires[reqPhase]; ok {
+				reqs[matches[2]] = firstNonEmpty(matches[3], matches[4], matches[5], "0")
+			}
+		}
+
+		depth += strings.Count(line, "{") - strings.Count(line, "}")
+		for len(blocks) > 0 && blocks[len(blocks)-1].depth > depth {
+			blocks = blocks[:len(blocks)-1]
+		}
+	}
+
+	takeMinPerlVersion(dist)
+	return dist
+}
+
+// mergeRequirements adds the cpanfile requirements missing from dist;
+// versions already declared by the build script win
+func mergeRequirements(dist, cpanfile *Distribution) {
+	merge := func(into *map[string]string, from map[string]string) {
+		if len(from) == 0 {
+			return
+		}
+		if *into == nil {
+			*into = make(map[string]string, len(from))
+		}
+		for module, version := range from {
+			if _, ok := (*into)[module]; !ok {
+				(*into)[module] = version
+			}
+		}
+	}
+	merge(&dist.Requires, cpanfile.Requires)
+	merge(&dist.BuildRequires, cpanfile.BuildRequires)
+	merge(&dist.TestRequires, cpanfile.TestRequires)
+	merge(&dist.ConfigureRequires, cpanfile.ConfigureRequires)
+	if dist.MinPerlVersion == "" {
+		dist.MinPerlVersion = cpanfile.MinPerlVersion
+	}
+}
+
+// takeMinPerlVersion moves a runtime requirement on perl itself into
+// MinPerlVersion, as it is not a module dependency
+func takeMinPerlVersion(dist *Distribution) {
+	if version, ok := dist.Requires["perl"]; ok {
+		if dist.MinPerlVersion == "" {
+			dist.MinPerlVersion = version
+		}
+		delete(dist.Requires, "perl")
+	}
+}
+
+// moduleVersion reads $VERSION, or the package version, from a module file
+func moduleVersion(path string) string {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	source := stripPerlComments(string(content))
+	if matches := moduleVersionRegex.FindStringSubmatch(source); matches != nil {
+		return matches[1]
+	}
+	if matches := packageVersionRegex.FindStringSubmatch(source); matches != nil {
+		return matches[1]
+	}
+	return ""
+}
+
+// keyRegex matches "KEY =>" with an optionally quoted key
+func keyRegex(key string) *regexp.Regexp {
+	return regexp.MustCompile(`(?:^|[^\w:])['"]?` + regexp.QuoteMeta(key) + `['"]?\s*=>\s*`)
+}
+
+// perlScalar returns the literal string or number assigned to key
+func perlScalar(content, key string) string {
+	loc := keyRegex(key).FindStringIndex(content)
+	if loc == nil {
+		return ""
+	}
+	rest := content[loc[1]:]
+	if matches := perlLiteralRegex.FindStringSubmatch(rest); matches != nil {
+		return firstNonEmpty(matches[1], matches[2], matches[3])
+	}
+	return ""
+}
+
+// perlStrings returns the string, or the strings of the array reference,
+// assigned to key
+func perlStrings(content, key string) []string {
+	loc := keyRegex(key).FindStringIndex(content)
+	if loc == nil {
+		return nil
+	}
+	rest := content[loc[1]:]
+	if strings.HasPrefix(rest, "[") {
+		end := strings.Index(rest, "]")
+		if end < 0 {
+			return nil
+		}
+		var values []string
+		for _, matches := range perlStringRegex.FindAllStringSubmatch(rest[:end], -1) {
+			values = append(values, firstNonEmpty(matches[1], matches[2]))
+		}
+		return values
+	}
+	if value := perlScalar(content, key); value != "" {
+		return []string{value}
+	}
+	return nil
+}
+
+// perlHash returns the pairs of the hash reference assigned to key
+func perlHash(content, key string) map[string]string {
+	loc := keyRegex(key).FindStringIndex(content)
+	if loc == nil || !strings.HasPrefix(content[loc[1]:], "{") {
+		return nil
+	}
+	body := content[loc[1]+1:]
+	depth := 1
+	for i, r := range body {
+		if r == '{' {
+			depth++
+		} else if r == '}' {
+			depth--
+			if depth == 0 {
+				body = body[:i]
+				break
+			}
+		}
+	}
+
+	pairs := make(map[string]string)
+	for _, matches := range perlPairRegex.FindAllStringSubmatch(body, -1) {
+		pairs[matches[1]] = firstNonEmpty(matches[2], matches[3], matches[4], "0")
+	}
+	return pairs
+}
+
+// stripPerlComments drops full-line comments and POD sections
+func stripPerlComments(content string) string {
+	lines := strings.Split(content, "\n")
+	inPod := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "=cut"):
+			inPod = false
+			lines[i] = ""
+		case strings.HasPrefix(line, "=") && len(line) > 1 && line[1] >= 'a' && line[1] <= 'z':
+			inPod = true
+			lines[i] = ""
+		case inPod || strings.HasPrefix(trimmed, "#"):
+			lines[i] = ""
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// firstNonEmpty returns the first non-empty value
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package perl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleMakefilePL = `use 5.010;
+use strict;
+use ExtUtils::MakeMaker;
+
+# PREREQ_PM => { 'Commented::Out' => 1 },
+WriteMakefile(
+    NAME             => 'Foo::Bar',
+    AUTHOR           => ['Jane Doe <jane@example.com>', 'John Roe <john@example.com>'],
+    VERSION_FROM     => 'lib/Foo/Bar.pm',
+    ABSTRACT         => 'Frobnicate bars',
+    LICENSE          => 'perl_5',
+    MIN_PERL_VERSION => '5.010',
+    CONFIGURE_REQUIRES => {
+        'ExtUtils::MakeMaker' => '6.64',
+    },
+    TEST_REQUIRES => {
+        'Test::More' => '0.98',
+    },
+    PREREQ_PM => {
+        'Moo'        => '2.000',
+        'JSON::PP'   => 0,
+        "Try::Tiny"  => "0.30",
+    },
+);
+`
+
+const sampleModule = `package Foo::Bar;
+use strict;
+
+our $VERSION = '1.02';
+
+=head1 NAME
+
+Foo::Bar - $VERSION = '9.99' in POD is ignored
+
+=cut
+
+1;
+`
+
+const sampleCpanfile = `requires 'perl', '5.010';
+requires 'Moo', '>= 2.000';
+requires 'Path::Tiny';
+recommends 'JSON::XS';
+
+on 'test' => sub {
+    requires 'Test::More', '0.98';
+    requires 'Test::Deep';
+};
+
+on 'develop' => sub {
+    requires 'Perl::Critic';
+};
+
+on configure => sub {
+    requires 'Module::Build::Tiny', '0.039';
+};
+`
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+}
+
+func TestNewExtractor(t *testing.T) {
+	e := NewExtractor()
+	assert.NotNil(t, e)
+	assert.Equal(t, "perl", e.Name())
+	assert.Equal(t, 1, e.Priority())
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    []string
+		expected bool
+	}{
+		{name: "Makefile.PL", files: []string{"Makefile.PL"}, expected: true},
+		{name: "Build.PL", files: []string{"Build.PL"}, expected: true},
+		{name: "cpanfile only", files: []string{"cpanfile"}, expected: true},
+		{name: "no perl files", files: []string{"Makefile"}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			for _, file := range tt.files {
+				require.NoError(t, os.WriteFile(filepath.Join(tmpDir, file), []byte(""), 0644))
+			}
+			assert.Equal(t, tt.expected, NewExtractor().Detect(tmpDir))
+		})
+	}
+}
+
+func TestExtract_MakefilePL(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeFiles(t, tmpDir, map[string]string{
+		"Makefile.PL":    sampleMakefilePL,
+		"lib/Foo/Bar.pm": sampleModule,
+	})
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Foo-Bar", metadata.Name)
+	assert.Equal(t, "1.02", metadata.Version)
+	assert.Equal(t, "lib/Foo/Bar.pm", metadata.VersionSource)
+	assert.Equal(t, "Frobnicate bars", metadata.Description)
+	assert.Equal(t, "perl_5", metadata.License)
+	assert.Equal(t, []string{"Jane Doe <jane@example.com>", "John Roe <john@example.com>"}, metadata.Authors)
+	assert.Equal(t, "Makefile.PL", metadata.LanguageSpecific["metadata_source"])
+	assert.Equal(t, "ExtUtils::MakeMaker", metadata.LanguageSpecific["build_tool"])
+	assert.Equal(t, "Foo::Bar", metadata.LanguageSpecific["module_name"])
+	assert.Equal(t, "5.010", metadata.LanguageSpecific["min_perl_version"])
+	assert.Equal(t, map[string]string{
+		"Moo":       "2.000",
+		"JSON::PP":  "0",
+		"Try::Tiny": "0.30",
+	}, metadata.LanguageSpecific["dependencies"])
+	assert.Equal(t, 3, metadata.LanguageSpecific["dependency_count"])
+	assert.Equal(t, map[string]string{"Test::More": "0.98"}, metadata.LanguageSpecific["test_dependencies"])
+	assert.Equal(t, map[string]string{"ExtUtils::MakeMaker": "6.64"}, metadata.LanguageSpecific["build_dependencies"])
+}
+
+func TestExtract_LiteralVersionWithCpanfile(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeFiles(t, tmpDir, map[string]string{
+		"Makefile.PL": `WriteMakefile(NAME => 'App::Tool', VERSION => '0.5', PREREQ_PM => { 'Moo' => '2.004' });`,
+		"cpanfile":    sampleCpanfile,
+	})
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "App-Tool", metadata.Name)
+	assert.Equal(t, "0.5", metadata.Version)
+	assert.Equal(t, "Makefile.PL", metadata.VersionSource)
+	// Makefile.PL versions win over the cpanfile
+	assert.Equal(t, map[string]string{
+		"Moo":        "2.004",
+		"Path::Tiny": "0",
+	}, metadata.LanguageSpecific["dependencies"])
+	assert.Equal(t, "5.010", metadata.LanguageSpecific["min_perl_version"])
+}
+
+func TestExtract_BuildPL(t *testing.T) {
+	buildPL := `use Module::Build;
+my $build = Module::Build->new(
+    module_name  => 'Baz::Qux',
+    dist_version => '3.1.4',
+    dist_author  => 'Jane Doe <jane@example.com>',
+    license      => 'apache_2_0',
+    requires     => { 'perl' => '5.014', 'List::Util' => '1.45' },
+    build_requires => { 'Test::More' => 0 },
+);
+$build->create_build_script;
+`
+	tmpDir := t.TempDir()
+	writeFiles(t, tmpDir, map[string]string{"Build.PL": buildPL})
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Baz-Qux", metadata.Name)
+	assert.Equal(t, "3.1.4", metadata.Version)
+	assert.Equal(t, "Build.PL", metadata.VersionSource)
+	assert.Equal(t, "Module::Build", metadata.LanguageSpecific["build_tool"])
+	assert.Equal(t, []string{"Jane Doe <jane@example.com>"}, metadata.Authors)
+	assert.Equal(t, "5.014", metadata.LanguageSpecific["min_perl_version"])
+	assert.Equal(t, map[string]string{"List::Util": "1.45"}, metadata.LanguageSpecific["dependencies"])
+	assert.Equal(t, map[string]string{"Test::More": "0"}, metadata.LanguageSpecific["build_dependencies"])
+}
+
+func TestExtract_CpanfileOnly(t *testing.T) {
+	tmpDir := filepath.Join(t.TempDir(), "my-app")
+	require.NoError(t, os.Mkdir(tmpDir, 0755))
+	writeFiles(t, tmpDir, map[string]string{"cpanfile": sampleCpanfile})
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "my-app", metadata.Name)
+	assert.Empty(t, metadata.Version)
+	assert.Equal(t, "cpanfile", metadata.LanguageSpecific["metadata_source"])
+	assert.Equal(t, map[string]string{
+		"Moo":        ">= 2.000",
+		"Path::Tiny": "0",
+	}, metadata.LanguageSpecific["dependencies"])
+	assert.Equal(t, 2, metadata.LanguageSpecific["dependency_count"])
+	assert.Equal(t, map[string]string{
+		"Test::More": "0.98",
+		"Test::Deep": "0",
+	}, metadata.LanguageSpecific["test_dependencies"])
+	assert.Equal(t, map[string]string{"Module::Build::Tiny": "0.039"}, metadata.LanguageSpecific["build_dependencies"])
+}
+
+func TestModuleVersion_PackageSyntax(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeFiles(t, tmpDir, map[string]string{"Mod.pm": "package Foo::Mod 2.5;\n1;\n"})
+	assert.Equal(t, "2.5", moduleVersion(filepath.Join(tmpDir, "Mod.pm")))
+}
@@ -4,6 +4,7 @@
 package php
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -12,6 +13,8 @@ import (
 	"strings"
 
 	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor/versions"
+	"github.com/lfreleng-actions/build-metadata-action/internal/repository"
 )
 
 // Extractor extracts metadata from PHP projects
@@ -26,6 +29,18 @@ func NewExtractor() *Extractor {
 	}
 }
 
+// ComposerLock represents the package lists of a composer.lock file
+type ComposerLock struct {
+	Packages    []ComposerLockPackage `json:"packages"`
+	PackagesDev []ComposerLockPackage `json:"packages-dev"`
+}
+
+// ComposerLockPackage is a single locked package
+type ComposerLockPackage struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
 // ComposerJSON represents the structure of a composer.json file
 type ComposerJSON struct {
 	Name             string                 `json:"name"`
@@ -92,6 +107,11 @@ type Archive struct {
 
 // Extract retrieves metadata from a PHP project
 func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, error) {
+	return e.ExtractContext(context.Background(), projectPath)
+}
+
+// ExtractContext is Extract with the git remote lookup bound to ctx
+func (e *Extractor) ExtractContext(ctx context.Context, projectPath string) (*extractor.ProjectMetadata, error) {
 	metadata := &extractor.ProjectMetadata{
 		LanguageSpecific: make(map[string]interface{}),
 	}
@@ -102,15 +122,16 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 		return nil, fmt.Errorf("composer.json not found in %s", projectPath)
 	}
 
-	if err := e.extractFromComposerJSON(composerPath, metadata); err != nil {
+	if err := e.extractFromComposerJSON(ctx, composerPath, metadata); err != nil {
 		return nil, err
 	}
+	extractor.RecordManifest(metadata, composerPath)
 
 	return metadata, nil
 }
 
 // extractFromComposerJSON extracts metadata from composer.json
-func (e *Extractor) extractFromComposerJSON(path string, metadata *extractor.ProjectMetadata) error {
+func (e *Extractor) extractFromComposerJSON(ctx context.Context, path string, metadata *extractor.ProjectMetadata) error {
 	content, err := os.ReadFile(path)
 	if err != nil {
 		return fmt.Errorf("failed to read composer.json: %w", err)
@@ -118,7 +139,7 @@ func (e *Extractor) extractFromComposerJSON(path string, metadata *extractor.Pro
 
 	var composer ComposerJSON
 	if err := json.Unmarshal(content, &composer); err != nil {
-		return fmt.Errorf("failed to parse composer.json: %w", err)
+		return &extractor.ManifestParseError{Manifest: "composer.json", Err: err}
 	}
 
 	// Extract common metadata
@@ -169,21 +190,47 @@ func (e *Extractor) extractFromComposerJSON(path string, metadata *extractor.Pro
 	metadata.LanguageSpecific["package_type"] = composer.Type
 	metadata.LanguageSpecific["metadata_source"] = "composer.json"
 
+	// Split "vendor/package" so the package portion can be compared
+	// against the repository name
+	vendor, packageName := splitPackageName(composer.Name)
+	metadata.LanguageSpecific["vendor"] = vendor
+	if packageName != "" {
+		if repoInfo, err := repository.DetectRepositoryContext(ctx, filepath.Dir(path)); err == nil && repoInfo.Repository != "" {
+			repoName := filepath.Base(repoInfo.Repository)
+			metadata.LanguageSpecific["project_match_repo"] = normalizeName(packageName) == normalizeName(repoName)
+		}
+	}
+
 	if len(composer.Keywords) > 0 {
 		metadata.LanguageSpecific["keywords"] = composer.Keywords
 	}
 
-	// Extract PHP version requirement
-	if phpVersion, ok := composer.Require["php"]; ok {
+	// Extract PHP version requirement. A config.platform.php pin is the
+	// version Composer resolves dependencies against, so it takes
+	// precedence over require.php when building the matrix.
+	phpVersion, hasRequire := composer.Require["php"]
+	if hasRequire {
 		metadata.LanguageSpecific["requires_php"] = phpVersion
+	}
+	platformPHP := composerPlatformPHP(composer.Config)
+	if platformPHP != "" {
+		metadata.LanguageSpecific["platform_php"] = platformPHP
+	}
 
+	if hasRequire || platformPHP != "" {
 		// Generate PHP version matrix
-		matrix := generatePHPVersionMatrix(phpVersion)
+		var matrix []string
+		if platformPHP != "" {
+			matrix = generatePlatformPHPVersionMatrix(platformPHP, phpVersion)
+		} else {
+			matrix = generatePHPVersionMatrix(phpVersion)
+		}
 		if len(matrix) > 0 {
 			metadata.LanguageSpecific["php_version_matrix"] = matrix
 			matrixJSON := fmt.Sprintf(`{"php-version": [%s]}`,
 				strings.Join(quoteStrings(matrix), ", "))
 			metadata.LanguageSpecific["matrix_json"] = matrixJSON
+			extractor.ApplyOSMatrix(metadata, "php-version", matrix)
 		}
 	}
 
@@ -207,6 +254,14 @@ func (e *Extractor) extractFromComposerJSON(path string, metadata *extractor.Pro
 		metadata.LanguageSpecific["dev_dependency_count"] = len(composer.RequireDev)
 	}
 
+	// Transitive dependencies from composer.lock (opt-in)
+	if extractor.LockfileDependenciesEnabled() {
+		lockPath := filepath.Join(filepath.Dir(path), "composer.lock")
+		if count, err := countComposerTransitiveDependencies(lockPath, composer); err == nil {
+			metadata.LanguageSpecific["transitive_dependency_count"] = count
+		}
+	}
+
 	// Extract PHP extensions
 	extensions := make([]string, 0)
 	for pkg := range composer.Require {
@@ -300,8 +355,7 @@ func (e *Extractor) extractFromComposerJSON(path string, metadata *extractor.Pro
 // Detect checks if this extractor can handle the project
 func (e *Extractor) Detect(projectPath string) bool {
 	// Check for composer.json
-	composerPath := filepath.Join(projectPath, "composer.json")
-	if _, err := os.Stat(composerPath); err == nil {
+	if extractor.FileExists(projectPath, "composer.json") {
 		return true
 	}
 
@@ -310,69 +364,108 @@ func (e *Extractor) Detect(projectPath string) bool {
 
 // Helper functions
 
-// generatePHPVersionMatrix generates a list of PHP versions from a constraint
+// generatePHPVersionMatrix generates a list of PHP versions from a constraint.
+// OR alternatives ("^8.1 || ^8.2") are unioned and upper bounds ("<8.3",
+// "<=8.2") within a branch cap the versions it contributes.
 func generatePHPVersionMatrix(phpVersion string) []string {
-	versions := []string{}
-
 	// Clean up the version string
 	phpVersion = strings.TrimSpace(phpVersion)
+	window := versions.Get(versions.PHP)
 
-	// Extract minimum version
-	minVersion := ""
-
-	// Handle >= constraints
-	if strings.Contains(phpVersion, ">=") {
-		re := regexp.MustCompile(`>=\s*(\d+\.\d+)`)
-		if matches := re.FindStringSubmatch(phpVersion); len(matches) > 1 {
-			minVersion = matches[1]
+	branches := regexp.MustCompile(`\|\|?`).Split(phpVersion, -1)
+	selected := make(map[string]bool)
+	for _, branch := range branches {
+		for _, version := range phpBranchVersions(strings.TrimSpace(branch)) {
+			selected[version] = true
 		}
-	} else if strings.HasPrefix(phpVersion, "^") {
-		// Caret constraint (e.g., ^7.4 or ^8.0)
-		version := strings.TrimPrefix(phpVersion, "^")
-		parts := strings.Split(version, ".")
-		if len(parts) >= 2 {
-			minVersion = parts[0] + "." + parts[1]
+	}
+
+	matrix := []string{}
+	for _, version := range window.All() {
+		if selected[version] {
+			matrix = append(matrix, version)
 		}
-	} else if strings.HasPrefix(phpVersion, "~") {
-		// Tilde constraint (e.g., ~7.4 or ~8.0)
-		version := strings.TrimPrefix(phpVersion, "~")
-		parts := strings.Split(version, ".")
-		if len(parts) >= 2 {
-			minVersion = parts[0] + "." + parts[1]
+	}
+
+	// If we couldn't determine, use reasonable defaults
+	if len(matrix) == 0 {
+		matrix = append(matrix, window.Default...)
+	}
+
+	return matrix
+}
+
+// composerPlatformPHP returns the config.platform.php pin, if any
+func composerPlatformPHP(config map[string]interface{}) string {
+	platform, ok := config["platform"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	php, _ := platform["php"].(string)
+	return strings.TrimSpace(php)
+}
+
+// generatePlatformPHPVersionMatrix generates the PHP version matrix for a
+// project pinning config.platform.php. Versions allowed by the require.php
+// constraint below the pinned version are dropped; when nothing remains
+// (or no constraint is given) the matrix starts at the pinned version.
+func generatePlatformPHPVersionMatrix(platformPHP, requirePHP string) []string {
+	platform := versions.MajorMinor(platformPHP)
+
+	matrix := []string{}
+	if requirePHP != "" {
+		for _, version := range generatePHPVersionMatrix(requirePHP) {
+			if versions.Compare(version, platform) >= 0 {
+				matrix = append(matrix, version)
+			}
 		}
 	}
 
-	// Map minimum version to supported versions
-	// Only includes actively supported PHP versions (8.1+)
-	// PHP 7.2, 7.3, 7.4, and 8.0 have reached end-of-life
-	supportedVersions := map[string][]string{
-		"8.1": {"8.1", "8.2", "8.3"},
-		"8.2": {"8.2", "8.3"},
-		"8.3": {"8.3"},
-		"8.4": {"8.4"}, // Future-proofing for PHP 8.4
+	if len(matrix) == 0 {
+		matrix = generatePHPVersionMatrix(">=" + platform)
 	}
 
-	if minVersion != "" {
-		if versionList, ok := supportedVersions[minVersion]; ok {
-			versions = versionList
-		} else {
-			// Map legacy/unsupported versions to minimum supported version
-			// This handles projects still requiring PHP 7.x or 8.0
-			switch {
-			case minVersion < "8.1":
-				versions = []string{"8.1", "8.2", "8.3"}
-			default:
-				versions = []string{"8.1", "8.2", "8.3"}
+	return matrix
+}
+
+// phpBranchVersions returns the supported versions for a single AND-ed
+// constraint branch such as ">=8.1 <8.3"
+func phpBranchVersions(constraint string) []string {
+	matrix := versions.Get(versions.PHP).From(phpMinimumVersion(constraint))
+
+	upperRe := regexp.MustCompile(`<(=?)\s*(\d+\.\d+)`)
+	for _, match := range upperRe.FindAllStringSubmatch(constraint, -1) {
+		inclusive := match[1] == "="
+		capped := make([]string, 0, len(matrix))
+		for _, version := range matrix {
+			cmp := versions.Compare(version, match[2])
+			if cmp < 0 || (inclusive && cmp == 0) {
+				capped = append(capped, version)
 			}
 		}
+		matrix = capped
 	}
 
-	// If we couldn't determine, use reasonable defaults
-	if len(versions) == 0 {
-		versions = []string{"8.1", "8.2", "8.3"}
+	return matrix
+}
+
+// phpMinimumVersion extracts the minimum major.minor from a constraint
+func phpMinimumVersion(constraint string) string {
+	// Handle >= constraints
+	if strings.Contains(constraint, ">=") {
+		re := regexp.MustCompile(`>=\s*(\d+\.\d+)`)
+		if matches := re.FindStringSubmatch(constraint); len(matches) > 1 {
+			return matches[1]
+		}
+	} else if strings.HasPrefix(constraint, "^") || strings.HasPrefix(constraint, "~") {
+		// Caret/tilde constraint (e.g., ^7.4, ~8.0)
+		re := regexp.MustCompile(`^[\^~]\s*(\d+\.\d+)`)
+		if matches := re.FindStringSubmatch(constraint); len(matches) > 1 {
+			return matches[1]
+		}
 	}
 
-	return versions
+	return ""
 }
 
 // detectPHPFramework attempts to detect which PHP framework is being used
@@ -401,6 +494,21 @@ func detectPHPFramework(requirements map[string]string) string {
 	return ""
 }
 
+// splitPackageName splits a composer package name into vendor and package.
+// Names without a slash are treated as a bare package with no vendor.
+func splitPackageName(name string) (string, string) {
+	name = strings.TrimSpace(name)
+	if idx := strings.Index(name, "/"); idx >= 0 {
+		return name[:idx], name[idx+1:]
+	}
+	return "", name
+}
+
+// normalizeName lowercases a name and treats hyphens and underscores alike
+func normalizeName(name string) string {
+	return strings.ReplaceAll(strings.ToLower(name), "_", "-")
+}
+
 // quoteStrings adds quotes around each string
 func quoteStrings(strs []string) []string {
 	quoted := make([]string, len(strs))
@@ -414,3 +522,40 @@ func quoteStrings(strs []string) []string {
 func init() {
 	extractor.RegisterExtractor(NewExtractor())
 }
+
+// countComposerTransitiveDependencies counts the packages locked in
+// composer.lock, runtime and dev, that composer.json does not require
+// directly
+func countComposerTransitiveDependencies(path string, composer ComposerJSON) (int, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	var lock ComposerLock
+	if err := json.Unmarshal(content, &lock); err != nil {
+		return 0, fmt.Errorf("failed to parse composer.lock: %w", err)
+	}
+
+	// Package names are case-insensitive in Composer
+	direct := make(map[string]bool)
+	for _, deps := range []map[string]string{composer.Require, composer.RequireDev} {
+		for name := range deps {
+			direct[strings.ToLower(name)] = true
+		}
+	}
+
+	count := 0
+	seen := make(map[string]bool)
+	for _, packages := range [][]ComposerLockPackage{lock.Packages, lock.PackagesDev} {
+		for _, pkg := range packages {
+			name := strings.ToLower(pkg.Name)
+			if name == "" || seen[name] || direct[name] {
+				continue
+			}
+			seen[name] = true
+			count++
+		}
+	}
+	return count, nil
+}
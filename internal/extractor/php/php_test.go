@@ -8,6 +8,8 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor/versions"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -268,6 +270,39 @@ func TestExtractor_Extract_PHPVersionMatrix(t *testing.T) {
 	require.NotNil(t, matrixJSON)
 	assert.Contains(t, matrixJSON, "php-version")
 	assert.Contains(t, matrixJSON, "8.1")
+
+	normalized := extractor.NormalizeMatrix(matrixJSON.(string))
+	assert.Contains(t, normalized, `"php-version":`)
+	assert.Contains(t, normalized, `"version":`)
+}
+
+func TestExtractor_Extract_PlatformPHP(t *testing.T) {
+	dir := t.TempDir()
+	composerPath := filepath.Join(dir, "composer.json")
+
+	composerContent := `{
+  "name": "vendor/package",
+  "require": {
+    "php": "^8.1"
+  },
+  "config": {
+    "platform": {
+      "php": "8.2.0"
+    }
+  }
+}`
+
+	err := os.WriteFile(composerPath, []byte(composerContent), 0644)
+	require.NoError(t, err)
+
+	e := NewExtractor()
+	metadata, err := e.Extract(dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "^8.1", metadata.LanguageSpecific["requires_php"])
+	assert.Equal(t, "8.2.0", metadata.LanguageSpecific["platform_php"])
+	assert.Equal(t, []string{"8.2", "8.3"}, metadata.LanguageSpecific["php_version_matrix"])
+	assert.Equal(t, `{"php-version": ["8.2", "8.3"]}`, metadata.LanguageSpecific["matrix_json"])
 }
 
 func TestExtractor_Extract_LaravelFramework(t *testing.T) {
@@ -402,6 +437,7 @@ func TestExtractor_Extract_MultipleLicenses(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.Equal(t, "MIT, Apache-2.0", metadata.License)
+	assert.Equal(t, "MIT OR Apache-2.0", extractor.NormalizeLicense(metadata.License))
 }
 
 func TestExtractor_Extract_Binaries(t *testing.T) {
@@ -494,6 +530,71 @@ func TestExtractor_Extract_MinimalComposer(t *testing.T) {
 	assert.Equal(t, "vendor/minimal", metadata.Name)
 }
 
+func TestExtractor_Extract_ProjectMatchRepo(t *testing.T) {
+	tests := []struct {
+		name           string
+		packageName    string
+		repoDir        string
+		expectedVendor string
+		expectedMatch  bool
+	}{
+		{
+			name:           "package matches repository",
+			packageName:    "vendor/package",
+			repoDir:        "package",
+			expectedVendor: "vendor",
+			expectedMatch:  true,
+		},
+		{
+			name:           "case and separator insensitive",
+			packageName:    "Acme/My_Package",
+			repoDir:        "my-package",
+			expectedVendor: "Acme",
+			expectedMatch:  true,
+		},
+		{
+			name:           "package differs from repository",
+			packageName:    "vendor/package",
+			repoDir:        "other-repo",
+			expectedVendor: "vendor",
+			expectedMatch:  false,
+		},
+		{
+			name:           "name without vendor",
+			packageName:    "standalone",
+			repoDir:        "standalone",
+			expectedVendor: "",
+			expectedMatch:  true,
+		},
+	}
+
+	e := NewExtractor()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), tt.repoDir)
+			require.NoError(t, os.MkdirAll(dir, 0755))
+			content := `{"name": "` + tt.packageName + `"}`
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "composer.json"), []byte(content), 0644))
+
+			metadata, err := e.Extract(dir)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedVendor, metadata.LanguageSpecific["vendor"])
+			assert.Equal(t, tt.expectedMatch, metadata.LanguageSpecific["project_match_repo"])
+		})
+	}
+}
+
+func TestSplitPackageName(t *testing.T) {
+	vendor, pkg := splitPackageName("vendor/package")
+	assert.Equal(t, "vendor", vendor)
+	assert.Equal(t, "package", pkg)
+
+	vendor, pkg = splitPackageName("package")
+	assert.Equal(t, "", vendor)
+	assert.Equal(t, "package", pkg)
+}
+
 func TestGeneratePHPVersionMatrix(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -527,6 +628,30 @@ func TestGeneratePHPVersionMatrix(t *testing.T) {
 			expectedCount: 2,
 			shouldContain: []string{"8.2", "8.3"},
 		},
+		{
+			name:          "upper bound excludes capped versions",
+			constraint:    ">=8.1 <8.3",
+			expectedCount: 2,
+			shouldContain: []string{"8.1", "8.2"},
+		},
+		{
+			name:          "inclusive upper bound",
+			constraint:    ">=7.4,<=8.2",
+			expectedCount: 2,
+			shouldContain: []string{"8.1", "8.2"},
+		},
+		{
+			name:          "OR alternatives are unioned",
+			constraint:    "^8.1 || ^8.2",
+			expectedCount: 3,
+			shouldContain: []string{"8.1", "8.2", "8.3"},
+		},
+		{
+			name:          "OR alternative with upper bound",
+			constraint:    ">=8.1 <8.2 || ^8.4",
+			expectedCount: 2,
+			shouldContain: []string{"8.1", "8.4"},
+		},
 		{
 			name:          "unknown version defaults",
 			constraint:    ">=99.0",
@@ -552,6 +677,25 @@ func TestGeneratePHPVersionMatrix(t *testing.T) {
 	}
 }
 
+func TestGeneratePlatformPHPVersionMatrix(t *testing.T) {
+	tests := []struct {
+		name       string
+		platform   string
+		requirePHP string
+		expected   []string
+	}{
+		{"platform within constraint", "8.2.0", "^8.1", []string{"8.2", "8.3"}},
+		{"no constraint", "8.3", "", []string{"8.3"}},
+		{"platform above constraint", "8.4.1", "~8.1.0 || ~8.2.0", []string{"8.4"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, generatePlatformPHPVersionMatrix(tt.platform, tt.requirePHP))
+		})
+	}
+}
+
 func TestDetectPHPFramework(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -657,3 +801,55 @@ func TestExtractor_Extract_SupportURLs(t *testing.T) {
 	docsURL := metadata.LanguageSpecific["docs_url"]
 	assert.Equal(t, "https://docs.example.com", docsURL)
 }
+
+func TestGeneratePHPVersionMatrix_WindowOverride(t *testing.T) {
+	versions.Set(versions.PHP, versions.Window{
+		Versions: []string{"8.3", "8.4"},
+		Default:  []string{"8.4"},
+	})
+	t.Cleanup(versions.Reset)
+
+	assert.Equal(t, []string{"8.3", "8.4"}, generatePHPVersionMatrix(">=8.1"))
+	assert.Equal(t, []string{"8.4"}, generatePHPVersionMatrix(""))
+}
+
+func TestExtractor_Extract_TransitiveDependencies(t *testing.T) {
+	dir := t.TempDir()
+
+	composerContent := `{
+  "name": "vendor/package",
+  "require": {
+    "php": "^8.1",
+    "symfony/console": "^6.0"
+  },
+  "require-dev": {
+    "phpunit/phpunit": "^10.0"
+  }
+}`
+	lockContent := `{
+  "packages": [
+    {"name": "symfony/console", "version": "v6.4.1"},
+    {"name": "symfony/string", "version": "v6.4.0"},
+    {"name": "psr/container", "version": "2.0.2"}
+  ],
+  "packages-dev": [
+    {"name": "phpunit/phpunit", "version": "10.5.2"},
+    {"name": "sebastian/diff", "version": "5.1.0"}
+  ]
+}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "composer.json"), []byte(composerContent), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "composer.lock"), []byte(lockContent), 0644))
+
+	e := NewExtractor()
+	metadata, err := e.Extract(dir)
+	require.NoError(t, err)
+	assert.NotContains(t, metadata.LanguageSpecific, "transitive_dependency_count")
+
+	extractor.SetLockfileDependencies(true)
+	defer extractor.SetLockfileDependencies(false)
+
+	metadata, err = e.Extract(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 1, metadata.LanguageSpecific["dependency_count"])
+	assert.Equal(t, 3, metadata.LanguageSpecific["transitive_dependency_count"])
+}
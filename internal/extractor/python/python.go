@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/BurntSushi/toml"
@@ -141,8 +142,8 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 	if pyprojectExists {
 		if err := e.extractFromPyProject(pyprojectPath, metadata); err != nil {
 			// Provide detailed error about pyproject.toml parsing failure
-			return nil, fmt.Errorf("found pyproject.toml but failed to parse it: %w\n\nFiles found: %s\nFiles not found: %s\n\nThis error often occurs due to:\n- Invalid TOML syntax (check for merge conflict markers like <<<<<<<, =======, >>>>>>>)\n- Malformed data structures\n- Encoding issues",
-				err, strings.Join(filesFound, ", "), strings.Join(filesNotFound, ", "))
+			return nil, &extractor.ManifestParseError{Manifest: "pyproject.toml", Err: fmt.Errorf("%w\n\nFiles found: %s\nFiles not found: %s\n\nThis error often occurs due to:\n- Invalid TOML syntax (check for merge conflict markers like <<<<<<<, =======, >>>>>>>)\n- Malformed data structures\n- Encoding issues",
+				err, strings.Join(filesFound, ", "), strings.Join(filesNotFound, ", "))}
 		}
 		// Check if we got meaningful metadata from pyproject.toml
 		// Consider it valid if we have a [project] section OR tool-specific configs
@@ -175,6 +176,7 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 				}
 			}
 			applyFallbackPythonMatrix(metadata, "pyproject.toml")
+			extractor.RecordManifest(metadata, pyprojectPath)
 			return metadata, nil
 		}
 		// pyproject.toml exists but has no [project] section
@@ -184,8 +186,8 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 	// Try setup.cfg (intermediate format)
 	if setupCfgExists {
 		if err := e.extractFromSetupCfg(setupCfgPath, metadata); err != nil {
-			return nil, fmt.Errorf("found setup.cfg but failed to parse it: %w\n\nFiles found: %s\nFiles not found: %s",
-				err, strings.Join(filesFound, ", "), strings.Join(filesNotFound, ", "))
+			return nil, &extractor.ManifestParseError{Manifest: "setup.cfg", Err: fmt.Errorf("%w\n\nFiles found: %s\nFiles not found: %s",
+				err, strings.Join(filesFound, ", "), strings.Join(filesNotFound, ", "))}
 		}
 		// Canonical PBR layout pairs declarative setup.cfg with a tiny
 		// setup.py shim such as `setup(setup_requires=['pbr'], pbr=True)`.
@@ -203,19 +205,21 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 			loadRequirementsTxt(projectPath, metadata)
 		}
 		applyFallbackPythonMatrix(metadata, "setup.cfg")
+		extractor.RecordManifest(metadata, setupCfgPath)
 		return metadata, nil
 	}
 
 	// Try setup.py (legacy format)
 	if setupPyExists {
 		if err := e.extractFromSetupPy(setupPyPath, metadata); err != nil {
-			return nil, fmt.Errorf("found setup.py but failed to parse it: %w\n\nFiles found: %s\nFiles not found: %s",
-				err, strings.Join(filesFound, ", "), strings.Join(filesNotFound, ", "))
+			return nil, &extractor.ManifestParseError{Manifest: "setup.py", Err: fmt.Errorf("%w\n\nFiles found: %s\nFiles not found: %s",
+				err, strings.Join(filesFound, ", "), strings.Join(filesNotFound, ", "))}
 		}
 		if _, hasDeps := metadata.LanguageSpecific["dependencies"]; !hasDeps {
 			loadRequirementsTxt(projectPath, metadata)
 		}
 		applyFallbackPythonMatrix(metadata, "setup.py")
+		extractor.RecordManifest(metadata, setupPyPath)
 		return metadata, nil
 	}
 
@@ -390,6 +394,7 @@ func (e *Extractor) extractFromPyProject(path string, metadata *extractor.Projec
 					poetryPythonConstraint = strings.TrimSpace(py)
 				}
 			}
+			applyPoetryMetadata(poetry, pyproject, metadata)
 		}
 
 		// PDM
@@ -414,6 +419,18 @@ func (e *Extractor) extractFromPyProject(path string, metadata *extractor.Projec
 		}
 	}
 
+	// Build backend plugins that derive the version from git tags. The
+	// version is left for the git tag fallback to resolve; the provider is
+	// also reported as dynamic_provider like setup.cfg/setup.py projects.
+	if provider := detectDynamicProviderFromPyProject(pyproject); provider != "" {
+		metadata.LanguageSpecific["versioning_type"] = "dynamic"
+		metadata.LanguageSpecific["dynamic_version_source"] = provider
+		metadata.LanguageSpecific["dynamic_provider"] = provider
+		if strings.TrimSpace(metadata.Version) == "" {
+			metadata.LanguageSpecific["version_unresolved"] = true
+		}
+	}
+
 	// Generate Python version matrix
 	effectiveRequires := pyproject.Project.RequiresPython
 	effectiveSource := ""
@@ -447,6 +464,216 @@ func (e *Extractor) extractFromPyProject(path string, metadata *extractor.Projec
 	return nil
 }
 
+// poetryBuildBackend is the PEP 517 backend provided by poetry-core
+const poetryBuildBackend = "poetry.core.masonry.api"
+
+// applyPoetryMetadata fills in project metadata from [tool.poetry] for
+// Poetry projects that do not declare a PEP 621 [project] table. Values
+// from [project] always take precedence.
+func applyPoetryMetadata(poetry map[string]interface{}, pyproject PyProjectTOML, metadata *extractor.ProjectMetadata) {
+	if name, ok := poetry["name"].(string); ok && metadata.Name == "" {
+		metadata.Name = name
+		metadata.LanguageSpecific["package_name"] = name
+	}
+	if description, ok := poetry["description"].(string); ok && metadata.Description == "" {
+		metadata.Description = description
+	}
+	if license, ok := poetry["license"].(string); ok && metadata.License == "" {
+		metadata.License = license
+	}
+	if len(metadata.Authors) == 0 {
+		if authors, ok := poetry["authors"].([]interface{}); ok {
+			for _, author := range authors {
+				if name, ok := author.(string); ok && name != "" {
+					metadata.Authors = append(metadata.Authors, name)
+				}
+			}
+		}
+	}
+	if homepage, ok := poetry["homepage"].(string); ok && metadata.Homepage == "" {
+		metadata.Homepage = homepage
+	}
+	if repository, ok := poetry["repository"].(string); ok && metadata.Repository == "" {
+		metadata.Repository = repository
+	}
+
+	if pyproject.BuildSystem.BuildBackend == "" {
+		metadata.LanguageSpecific["build_backend"] = poetryBuildBackend
+	}
+
+	// Runtime dependencies, excluding the Python constraint itself
+	if _, hasDeps := metadata.LanguageSpecific["dependencies"]; !hasDeps {
+		if deps, ok := poetry["dependencies"].(map[string]interface{}); ok {
+			dependencies := poetryDependencies(deps)
+			delete(dependencies, "python")
+			if len(dependencies) > 0 {
+				metadata.LanguageSpecific["dependencies"] = sortedRequirements(dependencies)
+				metadata.LanguageSpecific["dependency_count"] = len(dependencies)
+				metadata.LanguageSpecific["dependencies_source"] = "pyproject.toml (poetry)"
+			}
+		}
+	}
+
+	// Development dependencies: the legacy [tool.poetry.dev-dependencies]
+	// table and every [tool.poetry.group.<name>.dependencies] group
+	devDependencies := make(map[string]string)
+	if deps, ok := poetry["dev-dependencies"].(map[string]interface{}); ok {
+		for name, constraint := range poetryDependencies(deps) {
+			devDependencies[name] = constraint
+		}
+	}
+	if groups, ok := poetry["group"].(map[string]interface{}); ok {
+		groupNames := make([]string, 0, len(groups))
+		for groupName, group := range groups {
+			groupTable, ok := group.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			groupNames = append(groupNames, groupName)
+			if deps, ok := groupTable["dependencies"].(map[string]interface{}); ok {
+				for name, constraint := range poetryDependencies(deps) {
+					devDependencies[name] = constraint
+				}
+			}
+		}
+		if len(groupNames) > 0 {
+			sort.Strings(groupNames)
+			metadata.LanguageSpecific["poetry_groups"] = groupNames
+		}
+	}
+	if len(devDependencies) > 0 {
+		metadata.LanguageSpecific["dev_dependencies"] = sortedRequirements(devDependencies)
+		metadata.LanguageSpecific["dev_dependency_count"] = len(devDependencies)
+	}
+}
+
+// poetryDependencies converts a Poetry dependency table to PEP 508
+// requirement strings, keyed by package name, so they match the [project]
+// dependencies list. Caret and tilde constraints become PEP 440 ranges;
+// table entries use their version, or a direct reference to their
+// git/path/url source when unversioned.
+func poetryDependencies(deps map[string]interface{}) map[string]string {
+	requirements := make(map[string]string, len(deps))
+	for name, spec := range deps {
+		requirements[name] = poetryRequirement(name, spec)
+	}
+	return requirements
+}
+
+// sortedRequirements returns the requirement strings ordered by package
+// name
+func sortedRequirements(requirements map[string]string) []string {
+	names := make([]string, 0, len(requirements))
+	for name := range requirements {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	list := make([]string, 0, len(names))
+	for _, name := range names {
+		list = append(list, requirements[name])
+	}
+	return list
+}
+
+// poetryRequirement formats a single Poetry dependency specification as a
+// PEP 508 requirement string. Multiple-constraint dependencies (e.g. per
+// platform versions) have no single equivalent and keep the bare name.
+func poetryRequirement(name string, spec interface{}) string {
+	switch v := spec.(type) {
+	case string:
+		return name + poetryToPEP440(v)
+	case map[string]interface{}:
+		if extras, ok := v["extras"].([]interface{}); ok && len(extras) > 0 {
+			names := make([]string, 0, len(extras))
+			for _, extra := range extras {
+				if extraName, ok := extra.(string); ok {
+					names = append(names, extraName)
+				}
+			}
+			name += "[" + strings.Join(names, ",") + "]"
+		}
+		if version, ok := v["version"].(string); ok {
+			return name + poetryToPEP440(version)
+		}
+		if location, ok := v["git"].(string); ok {
+			return name + " @ git+" + location
+		}
+		if location, ok := v["url"].(string); ok {
+			return name + " @ " + location
+		}
+		if location, ok := v["path"].(string); ok {
+			return name + " @ file:" + location
+		}
+	}
+	return name
+}
+
+// poetryToPEP440 converts a Poetry version constraint to a PEP 440
+// specifier, e.g. "^2.31.0" -> ">=2.31.0,<3.0.0", "~8.1" -> ">=8.1,<8.2.0"
+// and "1.2.3" -> "==1.2.3". "*" means any version and returns "".
+func poetryToPEP440(constraint string) string {
+	var specifiers []string
+	for _, part := range strings.Split(constraint, ",") {
+		part = strings.TrimSpace(part)
+		switch {
+		case part == "" || part == "*":
+			continue
+		case strings.HasPrefix(part, "^"):
+			specifiers = append(specifiers, poetryRange(part, strings.TrimPrefix(part, "^"), true))
+		case strings.HasPrefix(part, "~") && !strings.HasPrefix(part, "~="):
+			specifiers = append(specifiers, poetryRange(part, strings.TrimPrefix(part, "~"), false))
+		case part[0] >= '0' && part[0] <= '9':
+			specifiers = append(specifiers, "=="+part)
+		default:
+			specifiers = append(specifiers, part)
+		}
+	}
+	return strings.Join(specifiers, ",")
+}
+
+// poetryRange expands a caret or tilde version into a PEP 440 range. A
+// caret allows changes that keep the leftmost non-zero component; a tilde
+// allows patch changes, or minor changes when only a major is given. The
+// original constraint is returned when version is not purely numeric.
+func poetryRange(original, version string, caret bool) string {
+	parts := strings.Split(version, ".")
+	nums := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return original
+		}
+		nums[i] = n
+	}
+
+	bump := 0
+	if caret {
+		bump = len(nums) - 1
+		for i, n := range nums {
+			if n != 0 {
+				bump = i
+				break
+			}
+		}
+	} else if len(nums) > 1 {
+		bump = 1
+	}
+
+	upper := make([]string, max(len(nums), 3))
+	for i := range upper {
+		switch {
+		case i < bump:
+			upper[i] = strconv.Itoa(nums[i])
+		case i == bump:
+			upper[i] = strconv.Itoa(nums[i] + 1)
+		default:
+			upper[i] = "0"
+		}
+	}
+	return ">=" + version + ",<" + strings.Join(upper, ".")
+}
+
 // extractFromSetupCfg extracts metadata from setup.cfg using a
 // continuation-aware INI parser. It handles classic declarative
 // setuptools layouts, PBR-style configurations, and the older
@@ -536,6 +763,7 @@ func (e *Extractor) extractFromSetupCfg(path string, metadata *extractor.Project
 		metadata.LanguageSpecific["version_matrix"] = classifierVersions
 		metadata.LanguageSpecific["matrix_json"] = fmt.Sprintf(`{"python-version": [%s]}`,
 			strings.Join(quoteStrings(classifierVersions), ", "))
+		extractor.ApplyOSMatrix(metadata, "python-version", classifierVersions)
 		metadata.LanguageSpecific["build_version"] = classifierVersions[len(classifierVersions)-1]
 		metadata.LanguageSpecific["requires_python_source"] = "classifiers"
 		emitEOLOutputs(metadata, classifierVersions)
@@ -636,6 +864,7 @@ func (e *Extractor) extractFromSetupPy(path string, metadata *extractor.ProjectM
 			metadata.LanguageSpecific["version_matrix"] = classifierVersions
 			metadata.LanguageSpecific["matrix_json"] = fmt.Sprintf(`{"python-version": [%s]}`,
 				strings.Join(quoteStrings(classifierVersions), ", "))
+			extractor.ApplyOSMatrix(metadata, "python-version", classifierVersions)
 			metadata.LanguageSpecific["build_version"] = classifierVersions[len(classifierVersions)-1]
 			metadata.LanguageSpecific["requires_python_source"] = "classifiers"
 			emitEOLOutputs(metadata, classifierVersions)
@@ -671,17 +900,17 @@ func (e *Extractor) extractFromSetupPy(path string, metadata *extractor.ProjectM
 // Detect checks if this extractor can handle the project
 func (e *Extractor) Detect(projectPath string) bool {
 	// Check for pyproject.toml
-	if _, err := os.Stat(filepath.Join(projectPath, "pyproject.toml")); err == nil {
+	if extractor.FileExists(projectPath, "pyproject.toml") {
 		return true
 	}
 
 	// Check for setup.cfg
-	if _, err := os.Stat(filepath.Join(projectPath, "setup.cfg")); err == nil {
+	if extractor.FileExists(projectPath, "setup.cfg") {
 		return true
 	}
 
 	// Check for setup.py
-	if _, err := os.Stat(filepath.Join(projectPath, "setup.py")); err == nil {
+	if extractor.FileExists(projectPath, "setup.py") {
 		return true
 	}
 
@@ -963,6 +1192,33 @@ func detectDynamicProviderFromSetupCfg(cfg map[string]map[string]setupCfgValue)
 	return ""
 }
 
+// detectDynamicProviderFromPyProject returns the build backend plugin
+// that takes the version from version control, or empty string if there
+// is none. Recognised providers: "setuptools-scm", "hatch-vcs",
+// "pdm-backend", "poetry-dynamic-versioning", "versioningit".
+func detectDynamicProviderFromPyProject(pyproject PyProjectTOML) string {
+	if _, ok := pyproject.Tool["setuptools_scm"].(map[string]interface{}); ok {
+		return "setuptools-scm"
+	}
+	if hatch, ok := pyproject.Tool["hatch"].(map[string]interface{}); ok {
+		if version, ok := hatch["version"].(map[string]interface{}); ok && version["source"] == "vcs" {
+			return "hatch-vcs"
+		}
+	}
+	if pdm, ok := pyproject.Tool["pdm"].(map[string]interface{}); ok {
+		if version, ok := pdm["version"].(map[string]interface{}); ok && version["source"] == "scm" {
+			return "pdm-backend"
+		}
+	}
+	if pdv, ok := pyproject.Tool["poetry-dynamic-versioning"].(map[string]interface{}); ok && pdv["enable"] == true {
+		return "poetry-dynamic-versioning"
+	}
+	if _, ok := pyproject.Tool["versioningit"].(map[string]interface{}); ok {
+		return "versioningit"
+	}
+	return ""
+}
+
 // extractRequirementName returns the lowercased distribution name token
 // at the start of a PEP 508 requirement line (e.g. `pbr>=2.0 ; ...` ->
 // `pbr`). Returns an empty string when no valid name is found. This is
@@ -1070,6 +1326,9 @@ func propagateFallbackPythonMatrix(metadata, fallbackMetadata *extractor.Project
 	if matrixJSON, ok := fallbackMetadata.LanguageSpecific["matrix_json"].(string); ok && matrixJSON != "" {
 		metadata.LanguageSpecific["matrix_json"] = matrixJSON
 	}
+	if matrixOSJSON, ok := fallbackMetadata.LanguageSpecific["matrix_os_json"].(string); ok && matrixOSJSON != "" {
+		metadata.LanguageSpecific["matrix_os_json"] = matrixOSJSON
+	}
 	if buildVersion, ok := fallbackMetadata.LanguageSpecific["build_version"].(string); ok && buildVersion != "" {
 		metadata.LanguageSpecific["build_version"] = buildVersion
 	}
@@ -1123,6 +1382,7 @@ func applyFallbackPythonMatrix(metadata *extractor.ProjectMetadata, source strin
 	metadata.LanguageSpecific["version_matrix"] = fallback
 	metadata.LanguageSpecific["matrix_json"] = fmt.Sprintf(`{"python-version": [%s]}`,
 		strings.Join(quoteStrings(fallback), ", "))
+	extractor.ApplyOSMatrix(metadata, "python-version", fallback)
 	metadata.LanguageSpecific["build_version"] = fallback[len(fallback)-1]
 	metadata.LanguageSpecific["requires_python_fallback"] = true
 	emitEOLOutputs(metadata, fallback)
@@ -1207,6 +1467,7 @@ func resolveAndEmitMatrix(metadata *extractor.ProjectMetadata, requiresPython, s
 	metadata.LanguageSpecific["version_matrix"] = matrix
 	metadata.LanguageSpecific["matrix_json"] = fmt.Sprintf(`{"python-version": [%s]}`,
 		strings.Join(quoteStrings(matrix), ", "))
+	extractor.ApplyOSMatrix(metadata, "python-version", matrix)
 	metadata.LanguageSpecific["build_version"] = matrix[len(matrix)-1]
 	if effectiveSource != "" {
 		metadata.LanguageSpecific["requires_python_source"] = effectiveSource
@@ -285,6 +285,98 @@ version = {attr = "package.__version__"}
 	assert.Equal(t, "dynamic", versioningType)
 }
 
+func TestPythonExtractor_Extract_DynamicVersionSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		provider string
+	}{
+		{
+			name: "setuptools_scm",
+			content: `[build-system]
+requires = ["setuptools>=64", "setuptools-scm>=8"]
+build-backend = "setuptools.build_meta"
+
+[project]
+name = "scm-package"
+dynamic = ["version"]
+
+[tool.setuptools_scm]
+version_file = "src/scm_package/_version.py"
+`,
+			provider: "setuptools-scm",
+		},
+		{
+			name: "hatch-vcs",
+			content: `[build-system]
+requires = ["hatchling", "hatch-vcs"]
+build-backend = "hatchling.build"
+
+[project]
+name = "hatch-package"
+dynamic = ["version"]
+
+[tool.hatch.version]
+source = "vcs"
+`,
+			provider: "hatch-vcs",
+		},
+		{
+			name: "pdm-backend",
+			content: `[build-system]
+requires = ["pdm-backend"]
+build-backend = "pdm.backend"
+
+[project]
+name = "pdm-package"
+dynamic = ["version"]
+
+[tool.pdm.version]
+source = "scm"
+`,
+			provider: "pdm-backend",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := createTempProject(t, map[string]string{
+				"pyproject.toml": tt.content,
+			})
+			defer os.RemoveAll(tmpDir)
+
+			metadata, err := NewExtractor().Extract(tmpDir)
+			require.NoError(t, err)
+
+			assert.Empty(t, metadata.Version)
+			assert.Equal(t, "dynamic", metadata.LanguageSpecific["versioning_type"])
+			assert.Equal(t, tt.provider, metadata.LanguageSpecific["dynamic_version_source"])
+			assert.Equal(t, true, metadata.LanguageSpecific["version_unresolved"])
+		})
+	}
+}
+
+func TestPythonExtractor_Extract_HatchRegexVersionIsNotVCS(t *testing.T) {
+	pyprojectContent := `[project]
+name = "hatch-regex"
+version = "1.2.3"
+
+[tool.hatch.version]
+path = "src/hatch_regex/__about__.py"
+`
+
+	tmpDir := createTempProject(t, map[string]string{
+		"pyproject.toml": pyprojectContent,
+	})
+	defer os.RemoveAll(tmpDir)
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "static", metadata.LanguageSpecific["versioning_type"])
+	assert.NotContains(t, metadata.LanguageSpecific, "dynamic_version_source")
+}
+
 func TestPythonExtractor_Extract_Poetry(t *testing.T) {
 	pyprojectContent := `[tool.poetry]
 name = "poetry-package"
@@ -316,6 +408,91 @@ build-backend = "poetry.core.masonry.api"
 	assert.True(t, hasPoetry)
 }
 
+func TestPythonExtractor_Extract_PoetryProject(t *testing.T) {
+	pyprojectContent := `[tool.poetry]
+name = "poetry-app"
+version = "2.3.1"
+description = "A Poetry managed application"
+authors = ["Jane Doe <jane@example.com>"]
+license = "Apache-2.0"
+repository = "https://github.com/example/poetry-app"
+
+[tool.poetry.dependencies]
+python = "^3.10"
+requests = "^2.31.0"
+click = "~8.1"
+pydantic = { version = ">=2.0,<3.0", extras = ["email"] }
+internal-lib = { git = "https://github.com/example/internal-lib.git" }
+
+[tool.poetry.group.dev.dependencies]
+pytest = "^8.0"
+
+[tool.poetry.group.docs.dependencies]
+mkdocs = "^1.5"
+`
+
+	tmpDir := createTempProject(t, map[string]string{
+		"pyproject.toml": pyprojectContent,
+	})
+	defer os.RemoveAll(tmpDir)
+
+	extractor := NewExtractor()
+	metadata, err := extractor.Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "poetry-app", metadata.Name)
+	assert.Equal(t, "2.3.1", metadata.Version)
+	assert.Equal(t, "A Poetry managed application", metadata.Description)
+	assert.Equal(t, "Apache-2.0", metadata.License)
+	assert.Equal(t, []string{"Jane Doe <jane@example.com>"}, metadata.Authors)
+	assert.Equal(t, "https://github.com/example/poetry-app", metadata.Repository)
+
+	assert.Equal(t, "poetry-app", metadata.LanguageSpecific["package_name"])
+	assert.Equal(t, "^3.10", metadata.LanguageSpecific["requires_python"])
+	assert.Equal(t, "poetry.core.masonry.api", metadata.LanguageSpecific["build_backend"])
+	assert.Equal(t, "pyproject.toml", metadata.LanguageSpecific["metadata_source"])
+
+	// Requirement strings, as for PEP 621 [project] dependencies
+	assert.Equal(t, []string{
+		"click>=8.1,<8.2.0",
+		"internal-lib @ git+https://github.com/example/internal-lib.git",
+		"pydantic[email]>=2.0,<3.0",
+		"requests>=2.31.0,<3.0.0",
+	}, metadata.LanguageSpecific["dependencies"])
+	assert.Equal(t, 4, metadata.LanguageSpecific["dependency_count"])
+
+	assert.Equal(t, []string{
+		"mkdocs>=1.5,<2.0.0",
+		"pytest>=8.0,<9.0.0",
+	}, metadata.LanguageSpecific["dev_dependencies"])
+	assert.Equal(t, []string{"dev", "docs"}, metadata.LanguageSpecific["poetry_groups"])
+}
+
+func TestPoetryToPEP440(t *testing.T) {
+	tests := []struct {
+		constraint string
+		expected   string
+	}{
+		{"^1.2.3", ">=1.2.3,<2.0.0"},
+		{"^0.2.3", ">=0.2.3,<0.3.0"},
+		{"^0.0.3", ">=0.0.3,<0.0.4"},
+		{"^0", ">=0,<1.0.0"},
+		{"~1.2.3", ">=1.2.3,<1.3.0"},
+		{"~1", ">=1,<2.0.0"},
+		{"~=1.4", "~=1.4"},
+		{"1.2.3", "==1.2.3"},
+		{">=1.0, <2.0", ">=1.0,<2.0"},
+		{"^1.0b1", "^1.0b1"},
+		{"*", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint, func(t *testing.T) {
+			assert.Equal(t, tt.expected, poetryToPEP440(tt.constraint))
+		})
+	}
+}
+
 func TestGeneratePythonVersionMatrix(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -0,0 +1,246 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package r
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+)
+
+// Extractor extracts metadata from R packages
+type Extractor struct {
+	extractor.BaseExtractor
+}
+
+// NewExtractor creates a new R extractor
+func NewExtractor() *Extractor {
+	return &Extractor{
+		BaseExtractor: extractor.NewBaseExtractor("r", 1),
+	}
+}
+
+func init() {
+	extractor.RegisterExtractor(NewExtractor())
+}
+
+// Description represents the fields of an R package DESCRIPTION file
+type Description struct {
+	Package     string
+	Version     string
+	Title       string
+	Description string
+	License     string
+	Maintainer  string
+	URL         []string
+	BugReports  string
+	Depends     []string
+	Imports     []string
+	Suggests    []string
+	LinkingTo   []string
+
+	// Version constraint on R itself from Depends, e.g. ">= 4.1.0"
+	RequiresR string
+}
+
+// Detect checks if this is an R package
+func (e *Extractor) Detect(projectPath string) bool {
+	if !extractor.FileExists(projectPath, "DESCRIPTION") {
+		return false
+	}
+	content, err := os.ReadFile(filepath.Join(projectPath, "DESCRIPTION"))
+	if err != nil {
+		return false
+	}
+	return parseDCF(string(content))["Package"] != ""
+}
+
+// Extract retrieves metadata from an R package
+func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, error) {
+	metadata := &extractor.ProjectMetadata{
+		LanguageSpecific: make(map[string]interface{}),
+	}
+
+	descriptionPath := filepath.Join(projectPath, "DESCRIPTION")
+	content, err := os.ReadFile(descriptionPath)
+	if err != nil {
+		return nil, err
+	}
+	desc := parseDescription(string(content))
+
+	metadata.Name = desc.Package
+	metadata.Description = desc.Title
+	metadata.License = desc.License
+	if desc.Version != "" {
+		metadata.Version = desc.Version
+		metadata.VersionSource = "DESCRIPTION"
+	}
+	if desc.Maintainer != "" {
+		metadata.Authors = []string{desc.Maintainer}
+		metadata.LanguageSpecific["maintainer"] = desc.Maintainer
+	}
+	if len(desc.URL) > 0 {
+		metadata.Homepage = desc.URL[0]
+		metadata.LanguageSpecific["urls"] = desc.URL
+		for _, url := range desc.URL {
+			if strings.Contains(url, "github.com/") || strings.Contains(url, "gitlab.com/") {
+				metadata.Repository = url
+				break
+			}
+		}
+	}
+	if desc.BugReports != "" {
+		metadata.LanguageSpecific["bug_reports"] = desc.BugReports
+	}
+
+	metadata.LanguageSpecific["package_name"] = desc.Package
+	if desc.Description != "" {
+		metadata.LanguageSpecific["long_description"] = desc.Description
+	}
+	if desc.RequiresR != "" {
+		metadata.LanguageSpecific["requires_r"] = desc.RequiresR
+	}
+
+	// Depends and Imports are needed at runtime; Suggests and LinkingTo
+	// are reported separately
+	if len(desc.Depends) > 0 {
+		metadata.LanguageSpecific["depends"] = desc.Depends
+	}
+	if len(desc.Imports) > 0 {
+		metadata.LanguageSpecific["imports"] = desc.Imports
+	}
+	if len(desc.Suggests) > 0 {
+		metadata.LanguageSpecific["suggests"] = desc.Suggests
+	}
+	if len(desc.LinkingTo) > 0 {
+		metadata.LanguageSpecific["linking_to"] = desc.LinkingTo
+	}
+	dependencies := append(append([]string{}, desc.Depends...), desc.Imports...)
+	if len(dependencies) > 0 {
+		metadata.LanguageSpecific["dependencies"] = dependencies
+		metadata.LanguageSpecific["dependency_count"] = len(dependencies)
+	}
+
+	if info, err := os.Stat(filepath.Join(projectPath, "tests")); err == nil && info.IsDir() {
+		metadata.LanguageSpecific["has_tests"] = true
+	}
+	if info, err := os.Stat(filepath.Join(projectPath, "vignettes")); err == nil && info.IsDir() {
+		metadata.LanguageSpecific["has_vignettes"] = true
+	}
+
+	extractor.RecordManifest(metadata, descriptionPath)
+
+	return metadata, nil
+}
+
+// parseDescription maps DESCRIPTION fields onto a Description
+func parseDescription(content string) *Description {
+	fields := parseDCF(content)
+
+	desc := &Description{
+		Package:     fields["Package"],
+		Version:     fields["Version"],
+		Title:       fields["Title"],
+		Description: fields["Description"],
+		License:     fields["License"],
+		Maintainer:  fields["Maintainer"],
+		BugReports:  fields["BugReports"],
+		Imports:     parseDependencyField(fields["Imports"]),
+		Suggests:    parseDependencyField(fields["Suggests"]),
+		LinkingTo:   parseDependencyField(fields["LinkingTo"]),
+	}
+
+	// URL may list several addresses separated by commas or whitespace
+	desc.URL = strings.FieldsFunc(fields["URL"], func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+
+	// Depends may name R itself, which is a version constraint rather
+	// than a package dependency
+	for _, entry := range splitDependencyEntries(fields["Depends"]) {
+		name, constraint := splitDependency(entry)
+		if name == "R" {
+			desc.RequiresR = constraint
+			continue
+		}
+		desc.Depends = append(desc.Depends, name)
+	}
+
+	return desc
+}
+
+// parseDCF parses the first record of a Debian Control File, the format
+// used by DESCRIPTION. Continuation lines start with whitespace and are
+// folded into the preceding field with a single space.
+func parseDCF(content string) map[string]string {
+	fields := make(map[string]string)
+	var current string
+
+	for _, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		if strings.TrimSpace(line) == "" {
+			// A blank line ends the record
+			if len(fields) > 0 {
+				break
+			}
+			continue
+		}
+
+		if line[0] == ' ' || line[0] == '\t' {
+			if current != "" {
+				value := strings.TrimSpace(line)
+				if value == "." {
+					// A lone "." marks a paragraph break
+					value = ""
+				}
+				fields[current] = strings.TrimSpace(fields[current] + " " + value)
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			current = ""
+			continue
+		}
+		current = strings.TrimSpace(key)
+		fields[current] = strings.TrimSpace(value)
+	}
+
+	return fields
+}
+
+// parseDependencyField returns the package names from a comma-separated
+// dependency field, dropping version constraints in parentheses
+func parseDependencyField(value string) []string {
+	var names []string
+	for _, entry := range splitDependencyEntries(value) {
+		if name, _ := splitDependency(entry); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// splitDependencyEntries splits a dependency field on commas
+func splitDependencyEntries(value string) []string {
+	var entries []string
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// splitDependency splits "pkg (>= 1.0)" into its name and constraint
+func splitDependency(entry string) (name, constraint string) {
+	name, rest, found := strings.Cut(entry, "(")
+	name = strings.TrimSpace(name)
+	if found {
+		constraint = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(rest), ")"))
+	}
+	return name, constraint
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package r
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleDescription = `Package: tidyprobe
+Type: Package
+Title: Probe Tidy Data Frames
+Version: 1.2.0
+Authors@R: person("Jane", "Doe", email = "jane@example.com",
+    role = c("aut", "cre"))
+Maintainer: Jane Doe <jane@example.com>
+Description: Inspects data frames for tidy-data violations
+    and reports columns that need reshaping.
+    .
+    Works with tibbles.
+License: MIT + file LICENSE
+URL: https://tidyprobe.example.org,
+    https://github.com/example/tidyprobe
+BugReports: https://github.com/example/tidyprobe/issues
+Depends:
+    R (>= 4.1.0),
+    methods
+Imports:
+    dplyr (>= 1.1.0),
+    rlang,
+    tibble
+Suggests: testthat (>= 3.0.0), knitr
+LinkingTo: Rcpp
+Encoding: UTF-8
+`
+
+func TestNewExtractor(t *testing.T) {
+	e := NewExtractor()
+	assert.NotNil(t, e)
+	assert.Equal(t, "r", e.Name())
+	assert.Equal(t, 1, e.Priority())
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected bool
+	}{
+		{name: "R package", content: sampleDescription, expected: true},
+		{name: "DESCRIPTION without Package", content: "Unnamed repository; edit this file to name it.\n", expected: false},
+		{name: "no DESCRIPTION", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			if tt.content != "" {
+				require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "DESCRIPTION"), []byte(tt.content), 0644))
+			}
+			assert.Equal(t, tt.expected, NewExtractor().Detect(tmpDir))
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "DESCRIPTION"), []byte(sampleDescription), 0644))
+	require.NoError(t, os.Mkdir(filepath.Join(tmpDir, "tests"), 0755))
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "tidyprobe", metadata.Name)
+	assert.Equal(t, "1.2.0", metadata.Version)
+	assert.Equal(t, "DESCRIPTION", metadata.VersionSource)
+	assert.Equal(t, "Probe Tidy Data Frames", metadata.Description)
+	assert.Equal(t, "MIT + file LICENSE", metadata.License)
+	assert.Equal(t, []string{"Jane Doe <jane@example.com>"}, metadata.Authors)
+	assert.Equal(t, "https://tidyprobe.example.org", metadata.Homepage)
+	assert.Equal(t, "https://github.com/example/tidyprobe", metadata.Repository)
+
+	ls := metadata.LanguageSpecific
+	assert.Equal(t, "https://github.com/example/tidyprobe/issues", ls["bug_reports"])
+	assert.Equal(t, ">= 4.1.0", ls["requires_r"])
+	assert.Equal(t, []string{"methods"}, ls["depends"])
+	assert.Equal(t, []string{"dplyr", "rlang", "tibble"}, ls["imports"])
+	assert.Equal(t, []string{"testthat", "knitr"}, ls["suggests"])
+	assert.Equal(t, []string{"Rcpp"}, ls["linking_to"])
+	assert.Equal(t, []string{"methods", "dplyr", "rlang", "tibble"}, ls["dependencies"])
+	assert.Equal(t, 4, ls["dependency_count"])
+	assert.Equal(t, true, ls["has_tests"])
+	assert.Equal(t, "Inspects data frames for tidy-data violations and reports columns that need reshaping. Works with tibbles.",
+		ls["long_description"])
+}
+
+func TestParseDCF_ContinuationLines(t *testing.T) {
+	fields := parseDCF("Package: a\nTitle: One\n  Two\n\tThree\n\nPackage: ignored\n")
+
+	assert.Equal(t, "a", fields["Package"])
+	assert.Equal(t, "One Two Three", fields["Title"])
+}
+
+func TestParseDependencyField(t *testing.T) {
+	assert.Equal(t, []string{"dplyr", "rlang"}, parseDependencyField("dplyr (>= 1.1.0),\n rlang,"))
+	assert.Empty(t, parseDependencyField(""))
+}
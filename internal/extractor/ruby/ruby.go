@@ -76,8 +76,7 @@ func (e *Extractor) Detect(projectPath string) bool {
 	}
 
 	for _, pattern := range indicators {
-		matches, err := filepath.Glob(filepath.Join(projectPath, pattern))
-		if err == nil && len(matches) > 0 {
+		if len(extractor.GlobFiles(projectPath, pattern)) > 0 {
 			return true
 		}
 	}
@@ -109,6 +108,8 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 	if err == nil && gemspecPath != "" {
 		if err := e.extractFromGemspec(gemspecPath, metadata); err != nil {
 			// Continue with Gemfile if gemspec fails
+		} else {
+			extractor.RecordManifest(metadata, gemspecPath)
 		}
 	}
 
@@ -117,6 +118,8 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 	if _, err := os.Stat(gemfilePath); err == nil {
 		if err := e.extractFromGemfile(gemfilePath, metadata); err != nil {
 			// Non-fatal error, continue
+		} else if _, ok := metadata.LanguageSpecific["manifest_sha256"]; !ok {
+			extractor.RecordManifest(metadata, gemfilePath)
 		}
 	}
 
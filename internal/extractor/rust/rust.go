@@ -140,6 +140,7 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 		if err := e.extractFromCargoToml(cargoTomlPath, metadata); err != nil {
 			return nil, err
 		}
+		extractor.RecordManifest(metadata, cargoTomlPath)
 		return metadata, nil
 	}
 
@@ -150,8 +151,21 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 func (e *Extractor) extractFromCargoToml(path string, metadata *extractor.ProjectMetadata) error {
 	var cargo CargoToml
 
-	if _, err := toml.DecodeFile(path, &cargo); err != nil {
-		return fmt.Errorf("failed to parse Cargo.toml: %w", err)
+	md, err := toml.DecodeFile(path, &cargo)
+	if err != nil {
+		return &extractor.ManifestParseError{Manifest: "Cargo.toml", Err: err}
+	}
+
+	// Workspace members inherit field.workspace = true values from the
+	// [workspace.package] table of the workspace root above them
+	workspaceRoot := ""
+	if !md.IsDefined("workspace") && inheritsFromWorkspace(cargo.Package) {
+		if rootDir, root := findWorkspaceRoot(filepath.Dir(path)); root != nil {
+			cargo.Workspace.Package = root.Workspace.Package
+			if rel, err := filepath.Rel(filepath.Dir(path), rootDir); err == nil {
+				workspaceRoot = rel
+			}
+		}
 	}
 
 	// Extract common metadata with workspace inheritance support
@@ -188,10 +202,16 @@ func (e *Extractor) extractFromCargoToml(path string, metadata *extractor.Projec
 	metadata.Repository = getStringValue(cargo.Package.Repository, cargo.Workspace.Package.Repository)
 	metadata.Authors = getStringSliceValue(cargo.Package.Authors, cargo.Workspace.Package.Authors)
 	metadata.VersionSource = "Cargo.toml"
+	if metadata.Version != "" && isWorkspaceReference(cargo.Package.Version) {
+		metadata.VersionSource = "Cargo.toml (workspace)"
+	}
 
 	// Rust-specific metadata
 	metadata.LanguageSpecific["package_name"] = cargo.Package.Name
 	metadata.LanguageSpecific["metadata_source"] = "Cargo.toml"
+	if workspaceRoot != "" {
+		metadata.LanguageSpecific["workspace_root"] = filepath.ToSlash(workspaceRoot)
+	}
 
 	edition := getStringValue(cargo.Package.Edition, cargo.Workspace.Package.Edition)
 	if edition != "" {
@@ -239,7 +259,7 @@ func (e *Extractor) extractFromCargoToml(path string, metadata *extractor.Projec
 	// Parse dependencies
 	if len(cargo.Dependencies) > 0 {
 		deps := parseDependencies(cargo.Dependencies, "normal")
-		metadata.LanguageSpecific["dependencies"] = formatDependencies(deps)
+		metadata.LanguageSpecific["dependencies"] = dependencyVersions(deps)
 		metadata.LanguageSpecific["dependency_count"] = len(deps)
 
 		// Extract optional dependencies
@@ -338,6 +358,7 @@ func (e *Extractor) extractFromCargoToml(path string, metadata *extractor.Projec
 			matrixJSON := fmt.Sprintf(`{"rust-version": [%s]}`,
 				strings.Join(quoteStrings(matrix), ", "))
 			metadata.LanguageSpecific["matrix_json"] = matrixJSON
+			extractor.ApplyOSMatrix(metadata, "rust-version", matrix)
 		}
 	} else if edition != "" {
 		// Use edition as fallback
@@ -347,12 +368,52 @@ func (e *Extractor) extractFromCargoToml(path string, metadata *extractor.Projec
 			matrixJSON := fmt.Sprintf(`{"rust-version": [%s]}`,
 				strings.Join(quoteStrings(matrix), ", "))
 			metadata.LanguageSpecific["matrix_json"] = matrixJSON
+			extractor.ApplyOSMatrix(metadata, "rust-version", matrix)
 		}
 	}
 
 	return nil
 }
 
+// isWorkspaceReference reports whether a package field is declared as
+// field.workspace = true
+func isWorkspaceReference(value interface{}) bool {
+	table, ok := value.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	workspace, _ := table["workspace"].(bool)
+	return workspace
+}
+
+// inheritsFromWorkspace reports whether any inheritable package field is
+// a workspace reference
+func inheritsFromWorkspace(pkg Package) bool {
+	for _, value := range []interface{}{
+		pkg.Version, pkg.Authors, pkg.Edition, pkg.RustVersion, pkg.Description,
+		pkg.Homepage, pkg.Repository, pkg.License, pkg.Keywords, pkg.Categories,
+	} {
+		if isWorkspaceReference(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// findWorkspaceRoot returns the directory and contents of the nearest
+// Cargo.toml above dir that declares a [workspace], as Cargo does for
+// members
+func findWorkspaceRoot(dir string) (string, *CargoToml) {
+	for parent := filepath.Dir(dir); parent != dir; dir, parent = parent, filepath.Dir(parent) {
+		var root CargoToml
+		md, err := toml.DecodeFile(filepath.Join(parent, "Cargo.toml"), &root)
+		if err == nil && md.IsDefined("workspace") {
+			return parent, &root
+		}
+	}
+	return "", nil
+}
+
 // getStringValue extracts a string from an interface{} that could be a string or workspace reference
 func getStringValue(value interface{}, workspaceDefault string) string {
 	if value == nil {
@@ -472,6 +533,16 @@ func formatDependencies(deps []Dependency) []string {
 	return formatted
 }
 
+// dependencyVersions maps each dependency name to its version requirement.
+// Inline-table dependencies without a version (git/path) map to "".
+func dependencyVersions(deps []Dependency) map[string]string {
+	versions := make(map[string]string, len(deps))
+	for _, dep := range deps {
+		versions[dep.Name] = dep.Version
+	}
+	return versions
+}
+
 // detectRustFrameworks detects common Rust frameworks from dependencies
 func detectRustFrameworks(deps map[string]interface{}) []string {
 	frameworks := []string{}
@@ -781,7 +852,7 @@ func generateRustVersionMatrixFromEdition(edition string) []string {
 // Detect checks if this extractor can handle the project
 func (e *Extractor) Detect(projectPath string) bool {
 	// Check for Cargo.toml
-	if _, err := os.Stat(filepath.Join(projectPath, "Cargo.toml")); err == nil {
+	if extractor.FileExists(projectPath, "Cargo.toml") {
 		return true
 	}
 
@@ -176,6 +176,18 @@ cc = "1.0"
 		t.Errorf("dependency_count = %v, expected 3", depCount)
 	}
 
+	// Check dependency versions from plain string and inline-table specs
+	deps, ok := metadata.LanguageSpecific["dependencies"].(map[string]string)
+	if !ok {
+		t.Fatalf("dependencies is not map[string]string")
+	}
+	expectedDeps := map[string]string{"serde": "1.0", "tokio": "1.35", "reqwest": "0.11"}
+	for name, version := range expectedDeps {
+		if deps[name] != version {
+			t.Errorf("dependencies[%s] = %q, expected %q", name, deps[name], version)
+		}
+	}
+
 	// Check dev dependencies
 	devDepCount, ok := metadata.LanguageSpecific["dev_dependency_count"].(int)
 	if !ok || devDepCount != 1 {
@@ -782,3 +794,64 @@ serde = "1.0"
 		t.Errorf("Expected 2 workspace members, got %v", metadata.LanguageSpecific["workspace_members"])
 	}
 }
+
+func TestWorkspaceMemberInheritance(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	rootToml := `[workspace]
+members = ["crates/app"]
+resolver = "2"
+
+[workspace.package]
+version = "1.4.0"
+edition = "2021"
+license = "Apache-2.0"
+`
+	memberToml := `[package]
+name = "app"
+version.workspace = true
+edition.workspace = true
+license = { workspace = true }
+description = "Member-level description"
+`
+
+	memberDir := filepath.Join(tmpDir, "crates", "app")
+	if err := os.MkdirAll(memberDir, 0755); err != nil {
+		t.Fatalf("Failed to create member dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "Cargo.toml"), []byte(rootToml), 0644); err != nil {
+		t.Fatalf("Failed to write workspace Cargo.toml: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(memberDir, "Cargo.toml"), []byte(memberToml), 0644); err != nil {
+		t.Fatalf("Failed to write member Cargo.toml: %v", err)
+	}
+
+	metadata, err := NewExtractor().Extract(memberDir)
+	if err != nil {
+		t.Fatalf("Failed to extract metadata: %v", err)
+	}
+
+	if metadata.Version != "1.4.0" {
+		t.Errorf("Expected version '1.4.0' (from workspace root), got '%s'", metadata.Version)
+	}
+
+	if metadata.VersionSource != "Cargo.toml (workspace)" {
+		t.Errorf("Expected version source 'Cargo.toml (workspace)', got '%s'", metadata.VersionSource)
+	}
+
+	if edition, ok := metadata.LanguageSpecific["edition"].(string); !ok || edition != "2021" {
+		t.Errorf("Expected edition '2021' (from workspace root), got '%v'", metadata.LanguageSpecific["edition"])
+	}
+
+	if metadata.License != "Apache-2.0" {
+		t.Errorf("Expected license 'Apache-2.0' (from workspace root), got '%s'", metadata.License)
+	}
+
+	if metadata.Description != "Member-level description" {
+		t.Errorf("Expected member description, got '%s'", metadata.Description)
+	}
+
+	if root := metadata.LanguageSpecific["workspace_root"]; root != "../.." {
+		t.Errorf("Expected workspace_root '../..', got '%v'", root)
+	}
+}
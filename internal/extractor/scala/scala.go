@@ -5,13 +5,16 @@ package scala
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor/versions"
 )
 
 // Extractor extracts metadata from Scala projects
@@ -33,17 +36,17 @@ func init() {
 // Detect checks if this is a Scala project
 func (e *Extractor) Detect(projectPath string) bool {
 	// Check for build.sbt
-	if _, err := os.Stat(filepath.Join(projectPath, "build.sbt")); err == nil {
+	if extractor.FileExists(projectPath, "build.sbt") {
 		return true
 	}
 
 	// Check for project/build.properties (SBT)
-	if _, err := os.Stat(filepath.Join(projectPath, "project", "build.properties")); err == nil {
+	if extractor.FileExists(projectPath, filepath.Join("project", "build.properties")) {
 		return true
 	}
 
 	// Check for build.sc (Mill)
-	if _, err := os.Stat(filepath.Join(projectPath, "build.sc")); err == nil {
+	if extractor.FileExists(projectPath, "build.sc") {
 		return true
 	}
 
@@ -62,13 +65,8 @@ func (e *Extractor) Detect(projectPath string) bool {
 	}
 
 	// Check for .scala files in root or src
-	patterns := []string{
-		filepath.Join(projectPath, "*.scala"),
-		filepath.Join(projectPath, "src", "*.scala"),
-	}
-	for _, pattern := range patterns {
-		matches, err := filepath.Glob(pattern)
-		if err == nil && len(matches) > 0 {
+	for _, dir := range []string{projectPath, filepath.Join(projectPath, "src")} {
+		if len(extractor.GlobFiles(dir, "*.scala")) > 0 {
 			return true
 		}
 	}
@@ -87,8 +85,12 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 	if _, err := os.Stat(buildSbtPath); err == nil {
 		if err := e.extractFromBuildSbt(buildSbtPath, metadata); err == nil {
 			metadata.LanguageSpecific["build_tool"] = "SBT"
+			extractor.RecordManifest(metadata, buildSbtPath)
 			e.extractSbtVersion(projectPath, metadata)
+			e.extractSbtPlatforms(projectPath, metadata)
 			return metadata, nil
+		} else if ferr := extractor.ManifestParseFailure(metadata, "build.sbt", err); ferr != nil {
+			return nil, ferr
 		}
 	}
 
@@ -97,7 +99,10 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 	if _, err := os.Stat(buildScPath); err == nil {
 		if err := e.extractFromMill(buildScPath, metadata); err == nil {
 			metadata.LanguageSpecific["build_tool"] = "Mill"
+			extractor.RecordManifest(metadata, buildScPath)
 			return metadata, nil
+		} else if ferr := extractor.ManifestParseFailure(metadata, "build.sc", err); ferr != nil {
+			return nil, ferr
 		}
 	}
 
@@ -108,13 +113,15 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 
 // extractFromBuildSbt parses build.sbt
 func (e *Extractor) extractFromBuildSbt(path string, metadata *extractor.ProjectMetadata) error {
-	file, err := os.Open(path)
+	data, err := os.ReadFile(path)
 	if err != nil {
 		return err
 	}
-	defer file.Close()
+	if err := checkSyntax(metadata, "build.sbt", data); err != nil {
+		return err
+	}
 
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
 
 	// Regex patterns for SBT
 	nameRegex := regexp.MustCompile(`name\s*:=\s*"([^"]+)"`)
@@ -129,11 +136,19 @@ func (e *Extractor) extractFromBuildSbt(path string, metadata *extractor.Project
 	libraryDependencyRegex := regexp.MustCompile(`libraryDependencies\s*\+\+?=\s*(?:Seq\()?\s*"([^"]+)"\s*%+\s*"([^"]+)"\s*%\s*"([^"]+)"`)
 	// Match standalone dependency lines within Seq block: "org" %% "name" % "version"
 	standaloneDependencyRegex := regexp.MustCompile(`^\s*"([^"]+)"\s*%%?\s*"([^"]+)"\s*%\s*"([^"]+)"`)
+	// Match crossScalaVersions := Seq("2.12.18", "2.13.12"), possibly
+	// spanning several lines or opening Seq( on the line after :=
+	crossScalaVersionsRegex := regexp.MustCompile(`crossScalaVersions\s*:=`)
+	quotedStringRegex := regexp.MustCompile(`"([^"]+)"`)
 
 	var dependencies []string
 	var scalaVersion string
+	var crossScalaVersions []string
 	var inLibraryDependencies bool
 	var parenDepth int // Track parenthesis depth for robust Seq block detection
+	var inCrossScalaVersions bool
+	var crossOpened bool // Whether the Seq(...) has been opened yet
+	var crossParenDepth int
 
 	for scanner.Scan() {
 		line := scanner.Text()
@@ -157,6 +172,29 @@ func (e *Extractor) extractFromBuildSbt(path string, metadata *extractor.Project
 			scalaVersion = matches[1]
 		}
 
+		// Collect crossScalaVersions values until the Seq(...) closes
+		if loc := crossScalaVersionsRegex.FindStringIndex(line); loc != nil {
+			inCrossScalaVersions = true
+			crossOpened = false
+			crossParenDepth = 0
+			line = strings.TrimSpace(line[loc[1]:])
+		}
+		if inCrossScalaVersions {
+			for _, matches := range quotedStringRegex.FindAllStringSubmatch(line, -1) {
+				crossScalaVersions = append(crossScalaVersions, matches[1])
+			}
+			crossParenDepth += strings.Count(line, "(") - strings.Count(line, ")")
+			if strings.Contains(line, "(") {
+				crossOpened = true
+			}
+			// An empty remainder after := waits for Seq( on the next line;
+			// anything else without a paren is not a literal list
+			if (crossOpened && crossParenDepth <= 0) || (!crossOpened && line != "") {
+				inCrossScalaVersions = false
+			}
+			continue
+		}
+
 		if matches := organizationRegex.FindStringSubmatch(line); matches != nil {
 			metadata.LanguageSpecific["organization"] = matches[1]
 		}
@@ -212,7 +250,14 @@ func (e *Extractor) extractFromBuildSbt(path string, metadata *extractor.Project
 
 	if scalaVersion != "" {
 		metadata.LanguageSpecific["scala_version"] = scalaVersion
+	}
 
+	// Cross-built libraries list every version they publish for; the
+	// single scalaVersion is only the fallback
+	if len(crossScalaVersions) > 0 {
+		metadata.LanguageSpecific["cross_scala_versions"] = crossScalaVersions
+		metadata.LanguageSpecific["scala_version_matrix"] = uniqueStrings(crossScalaVersions)
+	} else if scalaVersion != "" {
 		// Generate Scala version matrix
 		matrix := generateScalaVersionMatrix(scalaVersion)
 		if len(matrix) > 0 {
@@ -242,24 +287,155 @@ func (e *Extractor) extractSbtVersion(projectPath string, metadata *extractor.Pr
 	}
 }
 
+var (
+	// Markers in build.sbt for each cross-build target platform
+	sbtPlatformMarkers = []struct {
+		platform string
+		regex    *regexp.Regexp
+	}{
+		{"jvm", regexp.MustCompile(`\bJVMPlatform\b|\.jvmSettings\b`)},
+		{"js", regexp.MustCompile(`\bScalaJSPlugin\b|\bscalaJSVersion\b|\bJSPlatform\b|\.jsSettings\b`)},
+		{"native", regexp.MustCompile(`\bScalaNativePlugin\b|\bnativeConfig\b|\bNativePlatform\b|\.nativeSettings\b`)},
+	}
+	// addSbtPlugin("org.scala-js" % "sbt-scalajs" % "1.16.0")
+	sbtPluginRegex = regexp.MustCompile(`addSbtPlugin\(\s*"([^"]+)"\s*%\s*"([^"]+)"\s*%\s*"([^"]+)"`)
+	// lazy val server = project.in(file("server"))
+	sbtValDefinitionRegex = regexp.MustCompile(`(?m)^\s*(?:lazy\s+)?val\s+\w+\s*=`)
+	// project.in(...), (project in file(...)) or Project("id", file(...));
+	// crossProject(...) does not match
+	sbtPlainProjectRegex = regexp.MustCompile(`^\s*\(?\s*project\b|^\s*Project\s*\(`)
+	// Output keys for the versions of the platform plugins
+	sbtPlatformPlugins = map[string]string{
+		"sbt-scalajs":      "scalajs_version",
+		"sbt-scala-native": "scala_native_version",
+	}
+)
+
+// extractSbtPlatforms reports the platforms an SBT build targets (jvm, js,
+// native) and the Scala.js and Scala Native plugin versions from
+// project/plugins.sbt. Cross-built projects name their platforms in
+// crossProject(...); other builds target the JVM unless they enable the
+// Scala.js or Scala Native plugin. A plain project that enables neither
+// plugin, alongside cross-built or Scala.js projects, also targets the JVM.
+func (e *Extractor) extractSbtPlatforms(projectPath string, metadata *extractor.ProjectMetadata) {
+	content, err := os.ReadFile(filepath.Join(projectPath, "build.sbt"))
+	if err != nil {
+		return
+	}
+	buildSbt := stripSbtComments(string(content))
+
+	found := make(map[string]bool)
+	for _, marker := range sbtPlatformMarkers {
+		if marker.regex.MatchString(buildSbt) {
+			found[marker.platform] = true
+		}
+	}
+	if hasSbtJVMProject(buildSbt) ||
+		(!strings.Contains(buildSbt, "crossProject") && !found["js"] && !found["native"]) {
+		found["jvm"] = true
+	}
+
+	platforms := make([]string, 0, len(sbtPlatformMarkers))
+	for _, marker := range sbtPlatformMarkers {
+		if found[marker.platform] {
+			platforms = append(platforms, marker.platform)
+		}
+	}
+	metadata.LanguageSpecific["platforms"] = platforms
+
+	plugins, err := os.ReadFile(filepath.Join(projectPath, "project", "plugins.sbt"))
+	if err != nil {
+		return
+	}
+	for _, matches := range sbtPluginRegex.FindAllStringSubmatch(stripSbtComments(string(plugins)), -1) {
+		if key, ok := sbtPlatformPlugins[matches[2]]; ok {
+			metadata.LanguageSpecific[key] = matches[3]
+		}
+	}
+}
+
+// hasSbtJVMProject reports whether build.sbt defines a plain project that
+// enables neither Scala.js nor Scala Native. Root projects that only
+// aggregate others do not count.
+func hasSbtJVMProject(buildSbt string) bool {
+	starts := sbtValDefinitionRegex.FindAllStringIndex(buildSbt, -1)
+	for i, start := range starts {
+		end := len(buildSbt)
+		if i+1 < len(starts) {
+			end = starts[i+1][0]
+		}
+		definition := buildSbt[start[1]:end]
+		if !sbtPlainProjectRegex.MatchString(definition) || strings.Contains(definition, ".aggregate(") {
+			continue
+		}
+		jvm := true
+		for _, marker := range sbtPlatformMarkers {
+			if marker.platform != "jvm" && marker.regex.MatchString(definition) {
+				jvm = false
+				break
+			}
+		}
+		if jvm {
+			return true
+		}
+	}
+	return false
+}
+
+// stripSbtComments removes // line comments from SBT build content
+func stripSbtComments(content string) string {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "//") {
+			lines[i] = ""
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// MillModule is an object declared in a Mill build.sc
+type MillModule struct {
+	Name         string
+	ScalaVersion string
+	IsTest       bool
+}
+
+var (
+	millObjectRegex = regexp.MustCompile(`^object\s+(\w+)\s+extends\s+([^{]*)`)
+	// Test modules extend TestModule or one of the *Tests traits, e.g.
+	// ScalaTests, ScalaModuleTests or SbtModuleTests
+	millTestModuleRegex = regexp.MustCompile(`\bTestModule\b|\w*Tests\b`)
+)
+
 // extractFromMill parses build.sc (Mill build tool)
 func (e *Extractor) extractFromMill(path string, metadata *extractor.ProjectMetadata) error {
-	file, err := os.Open(path)
+	data, err := os.ReadFile(path)
 	if err != nil {
 		return err
 	}
-	defer file.Close()
+	if err := checkSyntax(metadata, "build.sc", data); err != nil {
+		return err
+	}
 
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
 
-	objectRegex := regexp.MustCompile(`object\s+(\w+)\s+extends`)
 	scalaVersionRegex := regexp.MustCompile(`def\s+scalaVersion\s*=\s*"([^"]+)"`)
 	// Match ivy dependencies with both : and :: (Scala cross-version) syntax
 	// e.g., ivy"com.lihaoyi::upickle:3.1.3" or ivy"org.example:artifact:1.0"
 	ivyDepRegex := regexp.MustCompile(`ivy"([^:]+)::?([^:]+):([^"]+)"`)
 
 	var dependencies []string
-	var scalaVersion string
+	var modules []MillModule
+	// The first scalaVersion anywhere in the file, e.g. in a shared trait
+	var firstScalaVersion string
+
+	// Open objects, innermost last, with the brace depth of their body
+	type openObject struct {
+		module int
+		depth  int
+	}
+	var stack []openObject
+	depth := 0
 
 	for scanner.Scan() {
 		line := scanner.Text()
@@ -269,26 +445,88 @@ func (e *Extractor) extractFromMill(path string, metadata *extractor.ProjectMeta
 			continue
 		}
 
-		if matches := objectRegex.FindStringSubmatch(line); matches != nil && metadata.Name == "" {
-			metadata.Name = matches[1]
+		// Top-level modules, and test modules nested inside them
+		if matches := millObjectRegex.FindStringSubmatch(line); matches != nil {
+			isTest := millTestModuleRegex.MatchString(matches[2])
+			if depth == 0 || (isTest && len(stack) > 0) {
+				name := matches[1]
+				if depth > 0 {
+					name = modules[stack[len(stack)-1].module].Name + "." + name
+				}
+				modules = append(modules, MillModule{Name: name, IsTest: isTest})
+				if strings.Contains(line, "{") {
+					stack = append(stack, openObject{module: len(modules) - 1, depth: depth + 1})
+				}
+			}
 		}
 
 		if matches := scalaVersionRegex.FindStringSubmatch(line); matches != nil {
-			scalaVersion = matches[1]
+			if firstScalaVersion == "" {
+				firstScalaVersion = matches[1]
+			}
+			if len(stack) > 0 {
+				modules[stack[len(stack)-1].module].ScalaVersion = matches[1]
+			}
 		}
 
 		if matches := ivyDepRegex.FindStringSubmatch(line); matches != nil {
 			dep := fmt.Sprintf("%s:%s:%s", matches[1], matches[2], matches[3])
 			dependencies = append(dependencies, dep)
 		}
+
+		depth += strings.Count(line, "{") - strings.Count(line, "}")
+		for len(stack) > 0 && stack[len(stack)-1].depth > depth {
+			stack = stack[:len(stack)-1]
+		}
 	}
 
 	if err := scanner.Err(); err != nil {
 		return err
 	}
 
-	if scalaVersion != "" {
-		metadata.LanguageSpecific["scala_version"] = scalaVersion
+	// The first non-test module names the project
+	for _, module := range modules {
+		if !module.IsTest {
+			if metadata.Name == "" {
+				metadata.Name = module.Name
+			}
+			if module.ScalaVersion != "" {
+				metadata.LanguageSpecific["scala_version"] = module.ScalaVersion
+			}
+			break
+		}
+	}
+	if _, ok := metadata.LanguageSpecific["scala_version"]; !ok {
+		for _, module := range modules {
+			if module.ScalaVersion != "" {
+				metadata.LanguageSpecific["scala_version"] = module.ScalaVersion
+				break
+			}
+		}
+	}
+	if _, ok := metadata.LanguageSpecific["scala_version"]; !ok && firstScalaVersion != "" {
+		metadata.LanguageSpecific["scala_version"] = firstScalaVersion
+	}
+
+	if len(modules) > 0 {
+		moduleList := make([]map[string]interface{}, 0, len(modules))
+		testModules := 0
+		for _, module := range modules {
+			entry := map[string]interface{}{
+				"name": module.Name,
+				"test": module.IsTest,
+			}
+			if module.ScalaVersion != "" {
+				entry["scala_version"] = module.ScalaVersion
+			}
+			if module.IsTest {
+				testModules++
+			}
+			moduleList = append(moduleList, entry)
+		}
+		metadata.LanguageSpecific["modules"] = moduleList
+		metadata.LanguageSpecific["module_count"] = len(modules) - testModules
+		metadata.LanguageSpecific["test_module_count"] = testModules
 	}
 
 	if len(dependencies) > 0 {
@@ -299,6 +537,19 @@ func (e *Extractor) extractFromMill(path string, metadata *extractor.ProjectMeta
 	return nil
 }
 
+// uniqueStrings returns values without duplicates, keeping the first occurrence
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	unique := make([]string, 0, len(values))
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
+
 // generateScalaVersionMatrix generates a matrix of compatible Scala versions
 func generateScalaVersionMatrix(version string) []string {
 	// Parse major.minor from version
@@ -310,9 +561,20 @@ func generateScalaVersionMatrix(version string) []string {
 	major := parts[0]
 	minor := parts[1]
 
-	// Scala 3.x
+	// Scala 3.x: the 3.3 LTS line and the 3.4 line are each built on
+	// their own minor. Scala Next lines (3.5+) are also tested against
+	// the latest known Scala Next minor.
 	if major == "3" {
-		return []string{"3.3", "3.4"}
+		minorNum, err := strconv.Atoi(minor)
+		if err != nil {
+			return []string{major + "." + minor}
+		}
+		declared := fmt.Sprintf("3.%d", minorNum)
+		latest := versions.Get(versions.Scala3).Latest()
+		if minorNum >= 5 && versions.Compare(declared, latest) < 0 {
+			return []string{declared, latest}
+		}
+		return []string{declared}
 	}
 
 	// Scala 2.13.x
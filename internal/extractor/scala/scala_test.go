@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -116,6 +117,7 @@ libraryDependencies ++= Seq(
 	assert.Equal(t, "A sample Scala project", metadata.Description)
 	assert.Equal(t, "https://example.com", metadata.Homepage)
 	assert.Equal(t, "Apache-2.0", metadata.License)
+	assert.Equal(t, "Apache-2.0", extractor.NormalizeLicense(metadata.License))
 
 	assert.Equal(t, "SBT", metadata.LanguageSpecific["build_tool"])
 	assert.Equal(t, "2.13.12", metadata.LanguageSpecific["scala_version"])
@@ -128,6 +130,80 @@ libraryDependencies ++= Seq(
 	assert.Equal(t, 2, metadata.LanguageSpecific["dependency_count"])
 }
 
+func TestExtractFromBuildSbtCrossScalaVersions(t *testing.T) {
+	buildSbtContent := `name := "cross-built"
+
+scalaVersion := "2.13.12"
+
+crossScalaVersions := Seq(
+  "2.12.18",
+  "2.13.12", // current
+  "3.3.1"
+)
+
+libraryDependencies += "org.typelevel" %% "cats-core" % "2.10.0"
+`
+
+	tmpDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmpDir, "build.sbt"), []byte(buildSbtContent), 0644)
+	require.NoError(t, err)
+
+	e := NewExtractor()
+	metadata, err := e.Extract(tmpDir)
+	require.NoError(t, err)
+
+	expected := []string{"2.12.18", "2.13.12", "3.3.1"}
+	assert.Equal(t, expected, metadata.LanguageSpecific["cross_scala_versions"])
+	assert.Equal(t, expected, metadata.LanguageSpecific["scala_version_matrix"])
+	assert.Equal(t, "2.13.12", metadata.LanguageSpecific["scala_version"])
+	assert.Equal(t, []string{"org.typelevel:cats-core:2.10.0"}, metadata.LanguageSpecific["dependencies"])
+}
+
+func TestExtractFromBuildSbtCrossScalaVersionsSingleLine(t *testing.T) {
+	buildSbtContent := `lazy val root = (project in file("."))
+  .settings(
+    name := "cross-inline",
+    crossScalaVersions := Seq("2.13.12", "3.3.1"),
+    scalaVersion := "3.3.1"
+  )
+`
+
+	tmpDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmpDir, "build.sbt"), []byte(buildSbtContent), 0644)
+	require.NoError(t, err)
+
+	e := NewExtractor()
+	metadata, err := e.Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"2.13.12", "3.3.1"}, metadata.LanguageSpecific["scala_version_matrix"])
+	assert.Equal(t, "3.3.1", metadata.LanguageSpecific["scala_version"])
+}
+
+func TestExtractFromBuildSbtCrossScalaVersionsSeqOnNextLine(t *testing.T) {
+	buildSbtContent := `name := "cross-wrapped"
+
+crossScalaVersions :=
+  Seq("2.13.12", "3.3.1")
+
+scalaVersion := "3.3.1"
+
+libraryDependencies += "org.typelevel" %% "cats-core" % "2.10.0"
+`
+
+	tmpDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmpDir, "build.sbt"), []byte(buildSbtContent), 0644)
+	require.NoError(t, err)
+
+	e := NewExtractor()
+	metadata, err := e.Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"2.13.12", "3.3.1"}, metadata.LanguageSpecific["cross_scala_versions"])
+	assert.Equal(t, "3.3.1", metadata.LanguageSpecific["scala_version"])
+	assert.Equal(t, []string{"org.typelevel:cats-core:2.10.0"}, metadata.LanguageSpecific["dependencies"])
+}
+
 func TestExtractFromBuildSbtSingleLineSeq(t *testing.T) {
 	// Test that dependencies on the same line as libraryDependencies with Seq are not duplicated
 	buildSbtContent := `name := "single-line-test"
@@ -235,6 +311,73 @@ object myproject extends ScalaModule {
 	assert.Contains(t, deps, "com.lihaoyi:os-lib:0.9.1")
 }
 
+func TestExtractFromMill_Modules(t *testing.T) {
+	buildScContent := `import mill._, scalalib._
+
+// object commented extends ScalaModule
+object core extends ScalaModule {
+  def scalaVersion = "3.3.1"
+
+  def ivyDeps = Agg(ivy"com.lihaoyi::os-lib:0.9.1")
+
+  object test extends ScalaTests with TestModule.Munit {
+    def ivyDeps = Agg(ivy"org.scalameta::munit:0.7.29")
+  }
+}
+
+object cli extends ScalaModule {
+  def scalaVersion = "2.13.12"
+  def moduleDeps = Seq(core)
+}
+
+object integration extends ScalaModule with TestModule.Utest {
+  def scalaVersion = "3.3.1"
+}
+`
+
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "build.sc"), []byte(buildScContent), 0644))
+
+	e := NewExtractor()
+	metadata, err := e.Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "core", metadata.Name)
+	assert.Equal(t, "3.3.1", metadata.LanguageSpecific["scala_version"])
+
+	assert.Equal(t, []map[string]interface{}{
+		{"name": "core", "test": false, "scala_version": "3.3.1"},
+		{"name": "core.test", "test": true},
+		{"name": "cli", "test": false, "scala_version": "2.13.12"},
+		{"name": "integration", "test": true, "scala_version": "3.3.1"},
+	}, metadata.LanguageSpecific["modules"])
+	assert.Equal(t, 2, metadata.LanguageSpecific["module_count"])
+	assert.Equal(t, 2, metadata.LanguageSpecific["test_module_count"])
+	assert.Equal(t, 2, metadata.LanguageSpecific["dependency_count"])
+}
+
+func TestExtractFromMill_SharedTrait(t *testing.T) {
+	buildScContent := `import mill._, scalalib._
+
+trait CommonModule extends ScalaModule {
+  def scalaVersion = "3.3.1"
+}
+
+object core extends CommonModule {
+  def ivyDeps = Agg(ivy"com.lihaoyi::os-lib:0.9.1")
+}
+`
+
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "build.sc"), []byte(buildScContent), 0644))
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "core", metadata.Name)
+	assert.Equal(t, "3.3.1", metadata.LanguageSpecific["scala_version"])
+}
+
 func TestGenerateScalaVersionMatrix(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -242,9 +385,24 @@ func TestGenerateScalaVersionMatrix(t *testing.T) {
 		expected []string
 	}{
 		{
-			name:     "Scala 3.3",
+			name:     "Scala 3.3 LTS",
 			version:  "3.3.1",
-			expected: []string{"3.3", "3.4"},
+			expected: []string{"3.3"},
+		},
+		{
+			name:     "Scala 3.4",
+			version:  "3.4.2",
+			expected: []string{"3.4"},
+		},
+		{
+			name:     "Scala 3.5 (next line)",
+			version:  "3.5.0",
+			expected: []string{"3.5", "3.6"},
+		},
+		{
+			name:     "Scala 3.6 (latest next)",
+			version:  "3.6.3",
+			expected: []string{"3.6"},
 		},
 		{
 			name:     "Scala 2.13",
@@ -270,3 +428,174 @@ func TestGenerateScalaVersionMatrix(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractSbtPlatformsScalaJS(t *testing.T) {
+	buildSbtContent := `name := "frontend"
+version := "0.3.0"
+scalaVersion := "3.3.3"
+
+enablePlugins(ScalaJSPlugin)
+
+scalaJSUseMainModuleInitializer := true
+`
+	pluginsContent := `addSbtPlugin("org.scala-js" % "sbt-scalajs" % "1.16.0")
+// addSbtPlugin("org.scala-native" % "sbt-scala-native" % "0.5.1")
+`
+
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "build.sbt"), []byte(buildSbtContent), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "project"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "project", "plugins.sbt"), []byte(pluginsContent), 0644))
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"js"}, metadata.LanguageSpecific["platforms"])
+	assert.Equal(t, "1.16.0", metadata.LanguageSpecific["scalajs_version"])
+	assert.NotContains(t, metadata.LanguageSpecific, "scala_native_version")
+}
+
+func TestExtractSbtPlatformsCrossProject(t *testing.T) {
+	buildSbtContent := `lazy val core = crossProject(JSPlatform, JVMPlatform, NativePlatform)
+  .in(file("core"))
+  .settings(name := "core")
+  .nativeSettings(nativeConfig ~= { _.withLTO(LTO.thin) })
+`
+	pluginsContent := `addSbtPlugin("org.scala-js" % "sbt-scalajs" % "1.16.0")
+addSbtPlugin("org.scala-native" % "sbt-scala-native" % "0.5.1")
+addSbtPlugin("org.portable-scala" % "sbt-scalajs-crossproject" % "1.3.2")
+`
+
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "build.sbt"), []byte(buildSbtContent), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "project"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "project", "plugins.sbt"), []byte(pluginsContent), 0644))
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"jvm", "js", "native"}, metadata.LanguageSpecific["platforms"])
+	assert.Equal(t, "1.16.0", metadata.LanguageSpecific["scalajs_version"])
+	assert.Equal(t, "0.5.1", metadata.LanguageSpecific["scala_native_version"])
+}
+
+func TestExtractSbtPlatformsMixedCrossProject(t *testing.T) {
+	buildSbtContent := `lazy val root = (project in file("."))
+  .aggregate(shared.js, shared.native, server)
+
+lazy val shared = crossProject(JSPlatform, NativePlatform)
+  .in(file("shared"))
+
+lazy val server = project
+  .in(file("server"))
+  .dependsOn(shared.native)
+
+lazy val client = project
+  .in(file("client"))
+  .enablePlugins(ScalaJSPlugin)
+`
+
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "build.sbt"), []byte(buildSbtContent), 0644))
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"jvm", "js", "native"}, metadata.LanguageSpecific["platforms"])
+}
+
+func TestExtractSbtPlatformsCrossProjectAggregateRoot(t *testing.T) {
+	buildSbtContent := `lazy val root = project.in(file(".")).aggregate(core.js, core.native)
+
+lazy val core = crossProject(JSPlatform, NativePlatform)
+  .in(file("core"))
+`
+
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "build.sbt"), []byte(buildSbtContent), 0644))
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"js", "native"}, metadata.LanguageSpecific["platforms"])
+}
+
+func TestExtractSbtPlatformsJVMOnly(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "build.sbt"), []byte(`name := "service"`), 0644))
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"jvm"}, metadata.LanguageSpecific["platforms"])
+}
+
+func TestExtract_InvalidBuildSbtStrictMode(t *testing.T) {
+	buildSbt := `name := "broken"
+version := "1.0.0"
+libraryDependencies ++= Seq(
+  "org.typelevel" %% "cats-core" % "2.10.0"
+`
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "build.sbt"), []byte(buildSbt), 0644))
+
+	// Without strict mode the extractor warns and keeps what it read
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, "SBT", metadata.LanguageSpecific["build_tool"])
+	assert.Equal(t, "broken", metadata.Name)
+	assert.Equal(t, "1.0.0", metadata.Version)
+	require.Len(t, metadata.Warnings, 1)
+	assert.Contains(t, metadata.Warnings[0], "build.sbt may be malformed")
+
+	extractor.SetStrictMode(true)
+	t.Cleanup(func() { extractor.SetStrictMode(false) })
+
+	metadata, err = NewExtractor().Extract(tmpDir)
+	require.Error(t, err)
+	assert.Nil(t, metadata)
+	var parseErr *extractor.ManifestParseError
+	require.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, "build.sbt", parseErr.Manifest)
+	assert.Contains(t, err.Error(), "unclosed '(' opened on line 3")
+}
+
+func TestExtract_ValidBuildSbtStrictMode(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "build.sbt"),
+		[]byte("name := \"ok\"\nscalacOptions += \"-Xlint:_,-missing-interpolator\" // (\n"), 0644))
+
+	extractor.SetStrictMode(true)
+	t.Cleanup(func() { extractor.SetStrictMode(false) })
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, "SBT", metadata.LanguageSpecific["build_tool"])
+}
+
+func TestCheckDelimiters(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		wantErr string
+	}{
+		{name: "balanced", source: "lazy val root = (project in file(\".\")).settings(Seq(a := 1))\n"},
+		{name: "delimiters in strings and comments", source: "val s = \"(\" // )\n/* { */ val t = \"\"\"[\"\"\"\nval c = ')'\n"},
+		{name: "unclosed", source: "settings(\n  name := \"x\"\n", wantErr: "unclosed '(' opened on line 1"},
+		{name: "mismatched", source: "Seq(1, 2]\n", wantErr: "unexpected ']' on line 1"},
+		{name: "unterminated string", source: "name := \"oops\nversion := \"1.0\"\n", wantErr: "unterminated string on line 1"},
+		{name: "unterminated comment", source: "/* never closed\n", wantErr: "unterminated comment"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkDelimiters([]byte(tt.source))
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package scala
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+)
+
+// closingDelimiters maps each opening delimiter to its closing one
+var closingDelimiters = map[byte]byte{'(': ')', '[': ']', '{': '}'}
+
+// checkSyntax checks a build file's delimiters. In strict mode a problem
+// is returned so the extractor fails. Otherwise it is recorded as a
+// warning and the values the regexes can still read are kept.
+func checkSyntax(metadata *extractor.ProjectMetadata, manifest string, data []byte) error {
+	err := checkDelimiters(data)
+	if err == nil || extractor.StrictModeEnabled() {
+		return err
+	}
+	metadata.Warnings = append(metadata.Warnings, fmt.Sprintf("%s may be malformed: %v", manifest, err))
+	return nil
+}
+
+// checkDelimiters reports unbalanced parentheses, brackets and braces or
+// an unterminated string in Scala source such as build.sbt. The regex
+// based parsing cannot tell a truncated or broken build file from a valid
+// one, so this is what separates an unparseable manifest from a sparse one.
+func checkDelimiters(data []byte) error {
+	type opening struct {
+		char byte
+		line int
+	}
+	var stack []opening
+	line := 1
+
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		switch {
+		case c == '\n':
+			line++
+
+		case bytes.HasPrefix(data[i:], []byte("//")):
+			// Line comment: skip to the newline, which the loop counts
+			for i+1 < len(data) && data[i+1] != '\n' {
+				i++
+			}
+
+		case bytes.HasPrefix(data[i:], []byte("/*")):
+			end := bytes.Index(data[i+2:], []byte("*/"))
+			if end < 0 {
+				return fmt.Errorf("unterminated comment starting on line %d", line)
+			}
+			line += bytes.Count(data[i:i+2+end], []byte("\n"))
+			i += end + 3
+
+		case bytes.HasPrefix(data[i:], []byte(`"""`)):
+			end := bytes.Index(data[i+3:], []byte(`"""`))
+			if end < 0 {
+				return fmt.Errorf("unterminated string starting on line %d", line)
+			}
+			line += bytes.Count(data[i:i+3+end], []byte("\n"))
+			i += end + 5
+
+		case c == '"':
+			start := line
+			for i++; i < len(data) && data[i] != '"'; i++ {
+				if data[i] == '\\' {
+					i++
+				} else if data[i] == '\n' {
+					return fmt.Errorf("unterminated string on line %d", start)
+				}
+			}
+			if i >= len(data) {
+				return fmt.Errorf("unterminated string on line %d", start)
+			}
+
+		case c == '\'' && i+2 < len(data) && data[i+1] != '\\' && data[i+2] == '\'':
+			// Character literal such as '(' or '"'
+			i += 2
+
+		case c == '\'' && i+3 < len(data) && data[i+1] == '\\' && data[i+3] == '\'':
+			// Escaped character literal such as '\"'
+			i += 3
+
+		case c == '(' || c == '[' || c == '{':
+			stack = append(stack, opening{char: c, line: line})
+
+		case c == ')' || c == ']' || c == '}':
+			if len(stack) == 0 || closingDelimiters[stack[len(stack)-1].char] != c {
+				return fmt.Errorf("unexpected '%c' on line %d", c, line)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if len(stack) > 0 {
+		open := stack[len(stack)-1]
+		return fmt.Errorf("unclosed '%c' opened on line %d", open.char, open.line)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import "fmt"
+
+// strictMode makes extractors fail on a manifest they found but could not
+// parse, instead of falling back to another manifest or to defaults. It is
+// package-scoped for the same reason as matrixOS; cmd/build-metadata/main.go
+// sets it from the --strict flag or the strict input.
+var strictMode bool
+
+// SetStrictMode enables or disables strict manifest parsing
+func SetStrictMode(enabled bool) {
+	strictMode = enabled
+}
+
+// StrictModeEnabled reports whether unparseable manifests are errors
+func StrictModeEnabled() bool {
+	return strictMode
+}
+
+// ManifestParseError reports a manifest that is present but unparseable
+type ManifestParseError struct {
+	Manifest string
+	Err      error
+}
+
+func (e *ManifestParseError) Error() string {
+	return fmt.Sprintf("failed to parse %s: %v", e.Manifest, e.Err)
+}
+
+func (e *ManifestParseError) Unwrap() error {
+	return e.Err
+}
+
+// ManifestParseFailure handles a manifest that was found but could not be
+// parsed. In strict mode it returns a ManifestParseError for the extractor
+// to return. Otherwise it records a warning and returns nil, and the
+// extractor falls back as it would without the manifest.
+func ManifestParseFailure(metadata *ProjectMetadata, manifest string, err error) error {
+	parseErr := &ManifestParseError{Manifest: manifest, Err: err}
+	if strictMode {
+		return parseErr
+	}
+	metadata.Warnings = append(metadata.Warnings, parseErr.Error()+"; falling back")
+	return nil
+}
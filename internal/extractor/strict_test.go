@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestManifestParseFailure tests the warning fallback and the strict
+// mode error
+func TestManifestParseFailure(t *testing.T) {
+	cause := errors.New("unexpected token")
+
+	metadata := &ProjectMetadata{}
+	if err := ManifestParseFailure(metadata, "build.sbt", cause); err != nil {
+		t.Fatalf("ManifestParseFailure() error = %v, want nil outside strict mode", err)
+	}
+	if len(metadata.Warnings) != 1 || metadata.Warnings[0] != "failed to parse build.sbt: unexpected token; falling back" {
+		t.Errorf("Warnings = %v, want the parse failure", metadata.Warnings)
+	}
+
+	SetStrictMode(true)
+	defer SetStrictMode(false)
+
+	metadata = &ProjectMetadata{}
+	err := ManifestParseFailure(metadata, "build.sbt", cause)
+	var parseErr *ManifestParseError
+	if !errors.As(err, &parseErr) || parseErr.Manifest != "build.sbt" || !errors.Is(err, cause) {
+		t.Errorf("ManifestParseFailure() error = %v, want a ManifestParseError wrapping the cause", err)
+	}
+	if len(metadata.Warnings) != 0 {
+		t.Errorf("Warnings = %v, want none in strict mode", metadata.Warnings)
+	}
+}
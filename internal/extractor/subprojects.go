@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// DefaultSubprojectDepth is the default directory depth searched for
+// sub-projects below the project root
+const DefaultSubprojectDepth = 3
+
+// subprojectIgnoreDirs are never descended into when searching for sub-projects
+var subprojectIgnoreDirs = map[string]bool{
+	"node_modules": true,
+	"vendor":       true,
+	".git":         true,
+	"target":       true,
+}
+
+// DetectedProject is a sub-project found below the project root
+type DetectedProject struct {
+	Path      string `json:"path"`      // Relative to the project root
+	Extractor string `json:"extractor"` // Name of the extractor that detected it
+}
+
+// DetectAll returns the sub-projects found below projectPath using the
+// global registry and DefaultSubprojectDepth
+func DetectAll(projectPath string) []DetectedProject {
+	return DetectAllWithDepth(projectPath, DefaultSubprojectDepth)
+}
+
+// DetectAllWithDepth returns the sub-projects found below projectPath
+// using the global registry, searching at most maxDepth directories deep
+func DetectAllWithDepth(projectPath string, maxDepth int) []DetectedProject {
+	return globalRegistry.DetectAll(projectPath, maxDepth)
+}
+
+// DetectAll walks projectPath up to maxDepth directories deep and returns
+// each directory that a registered extractor detects. The project root
+// itself is not included. When several extractors match a directory the
+// one with the highest priority is chosen.
+func (r *Registry) DetectAll(projectPath string, maxDepth int) []DetectedProject {
+	projects := make([]DetectedProject, 0)
+	if maxDepth <= 0 {
+		return projects
+	}
+
+	extractors := r.GetAll()
+	sort.Slice(extractors, func(i, j int) bool {
+		if extractors[i].Priority() != extractors[j].Priority() {
+			return extractors[i].Priority() > extractors[j].Priority()
+		}
+		return extractors[i].Name() < extractors[j].Name()
+	})
+
+	// Every extractor's Detect checks the same directories; share one
+	// listing per directory across them
+	beginScan()
+	defer endScan()
+
+	var walk func(dir string, depth int)
+	walk = func(dir string, depth int) {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return
+		}
+
+		for _, entry := range entries {
+			if !entry.IsDir() || subprojectIgnoreDirs[entry.Name()] {
+				continue
+			}
+
+			subdir := filepath.Join(dir, entry.Name())
+			for _, e := range extractors {
+				if e.Detect(subdir) {
+					relPath, _ := filepath.Rel(projectPath, subdir)
+					projects = append(projects, DetectedProject{
+						Path:      filepath.ToSlash(relPath),
+						Extractor: e.Name(),
+					})
+					break
+				}
+			}
+
+			if depth < maxDepth {
+				walk(subdir, depth+1)
+			}
+		}
+	}
+	walk(projectPath, 1)
+
+	return projects
+}
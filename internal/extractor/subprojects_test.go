@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// manifestExtractor detects directories containing a given manifest file
+type manifestExtractor struct {
+	BaseExtractor
+	manifest string
+}
+
+func (m *manifestExtractor) Extract(projectPath string) (*ProjectMetadata, error) {
+	return &ProjectMetadata{}, nil
+}
+
+func (m *manifestExtractor) Detect(projectPath string) bool {
+	_, err := os.Stat(filepath.Join(projectPath, m.manifest))
+	return err == nil
+}
+
+// TestRegistryDetectAll tests discovering monorepo sub-projects
+func TestRegistryDetectAll(t *testing.T) {
+	root := t.TempDir()
+	files := []string{
+		"package.json",
+		"services/api/go.mod",
+		"services/web/package.json",
+		"services/web/node_modules/dep/package.json",
+		"vendor/lib/go.mod",
+		"tools/gen/go.mod",
+		"tools/gen/package.json",
+		"a/b/c/d/go.mod",
+	}
+	for _, file := range files {
+		path := filepath.Join(root, file)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte("{}"), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", file, err)
+		}
+	}
+
+	registry := NewRegistry()
+	registry.Register(&manifestExtractor{BaseExtractor: NewBaseExtractor("go-module", 2), manifest: "go.mod"})
+	registry.Register(&manifestExtractor{BaseExtractor: NewBaseExtractor("javascript", 1), manifest: "package.json"})
+
+	got := registry.DetectAll(root, DefaultSubprojectDepth)
+	want := []DetectedProject{
+		{Path: "services/api", Extractor: "go-module"},
+		{Path: "services/web", Extractor: "javascript"},
+		{Path: "tools/gen", Extractor: "go-module"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DetectAll() = %+v, want %+v", got, want)
+	}
+
+	if got := registry.DetectAll(root, 4); len(got) != 4 {
+		t.Errorf("DetectAll() with depth 4 = %+v, want 4 projects", got)
+	}
+	if got := registry.DetectAll(root, 0); len(got) != 0 {
+		t.Errorf("DetectAll() with depth 0 = %+v, want none", got)
+	}
+}
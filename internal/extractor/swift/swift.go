@@ -4,6 +4,7 @@
 package swift
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -11,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor/versions"
 )
 
 // Extractor extracts metadata from Swift projects
@@ -35,6 +37,9 @@ type PackageManifest struct {
 	SwiftVersion   string
 	CLanguageStd   string
 	CXXLanguageStd string
+
+	// Notes on parts of the manifest the regex parser could not handle
+	Warnings []string
 }
 
 // Platform represents a platform requirement
@@ -85,6 +90,8 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 	}
 
 	e.populateMetadata(manifest, metadata, projectPath)
+	metadata.Warnings = append(metadata.Warnings, manifest.Warnings...)
+	extractor.RecordManifest(metadata, packagePath)
 
 	return metadata, nil
 }
@@ -118,6 +125,11 @@ func (e *Extractor) parsePackageSwift(path string) (*PackageManifest, error) {
 
 	// Extract dependencies
 	manifest.Dependencies = e.extractDependencies(text)
+	if declared := len(packageDeclarationRegex.FindAllString(text, -1)); declared > len(manifest.Dependencies) {
+		manifest.Warnings = append(manifest.Warnings, fmt.Sprintf(
+			"dependency parsing incomplete: regex fallback used (%d of %d package declarations parsed)",
+			len(manifest.Dependencies), declared))
+	}
 
 	// Extract targets
 	manifest.Targets = e.extractTargets(text)
@@ -160,26 +172,74 @@ func (e *Extractor) extractSwiftToolsVersion(text string) string {
 func (e *Extractor) extractPlatforms(text string) []Platform {
 	platforms := make([]Platform, 0)
 
-	// Pattern: platforms: [.macOS(.v10_15), .iOS(.v13)]
-	re := regexp.MustCompile(`platforms:\s*\[(.*?)\]`)
+	// Pattern: platforms: [.macOS(.v10_15), .iOS("16.0")], possibly
+	// spread over several lines
+	re := regexp.MustCompile(`(?s)platforms:\s*\[(.*?)\]`)
 	if matches := re.FindStringSubmatch(text); len(matches) > 1 {
 		platformsText := matches[1]
 
-		// Extract individual platforms
-		platformRe := regexp.MustCompile(`\.(\w+)\(\.(v\d+(?:_\d+)?)\)`)
+		// Extract individual platforms in the .v13 / .v10_15 enum form or
+		// the "16.0" string form
+		platformRe := regexp.MustCompile(`\.(\w+)\(\s*(?:\.v(\d+(?:_\d+)*)|"(\d+(?:\.\d+)*)")\s*\)`)
 		for _, match := range platformRe.FindAllStringSubmatch(platformsText, -1) {
-			if len(match) > 2 {
-				platforms = append(platforms, Platform{
-					Name:    match[1],
-					Version: strings.ReplaceAll(match[2], "v", ""),
-				})
+			version := strings.ReplaceAll(match[2], "_", ".")
+			if version == "" {
+				version = match[3]
 			}
+			platforms = append(platforms, Platform{
+				Name:    match[1],
+				Version: version,
+			})
 		}
 	}
 
 	return platforms
 }
 
+// applePlatforms are the platforms whose builds need a macOS runner
+var applePlatforms = map[string]bool{
+	"macOS":       true,
+	"iOS":         true,
+	"tvOS":        true,
+	"watchOS":     true,
+	"visionOS":    true,
+	"macCatalyst": true,
+	"driverKit":   true,
+}
+
+// platformMatrixEntry is one include entry of the platform matrix
+type platformMatrixEntry struct {
+	Platform   string `json:"platform"`
+	MinVersion string `json:"min-version"`
+	OS         string `json:"os"`
+}
+
+// generatePlatformMatrix returns a GitHub Actions matrix JSON document
+// pairing each declared platform with a runner able to build it, e.g.
+// {"include":[{"platform":"iOS","min-version":"16","os":"macos-latest"}]}
+func generatePlatformMatrix(platforms []Platform) string {
+	entries := make([]platformMatrixEntry, 0, len(platforms))
+	for _, p := range platforms {
+		if !applePlatforms[p.Name] {
+			continue
+		}
+		entries = append(entries, platformMatrixEntry{
+			Platform:   p.Name,
+			MinVersion: p.Version,
+			OS:         "macos-latest",
+		})
+	}
+	if len(entries) == 0 {
+		return ""
+	}
+
+	matrix, err := json.Marshal(map[string][]platformMatrixEntry{"include": entries})
+	if err != nil {
+		return ""
+	}
+	return string(matrix)
+}
+
 // extractProducts extracts package products
 func (e *Extractor) extractProducts(text string) []Product {
 	products := make([]Product, 0)
@@ -232,6 +292,10 @@ func (e *Extractor) extractProducts(text string) []Product {
 	return products
 }
 
+// packageDeclarationRegex matches every .package(...) declaration,
+// whichever form it uses (url:, path:, id:, name: + url:)
+var packageDeclarationRegex = regexp.MustCompile(`\.package\(\s*(?:name|url|path|id):`)
+
 // extractDependencies extracts package dependencies
 func (e *Extractor) extractDependencies(text string) []Dependency {
 	dependencies := make([]Dependency, 0)
@@ -395,6 +459,9 @@ func (e *Extractor) populateMetadata(manifest *PackageManifest, metadata *extrac
 		}
 		metadata.LanguageSpecific["platforms"] = platforms
 		metadata.LanguageSpecific["platform_count"] = len(platforms)
+		if platformMatrix := generatePlatformMatrix(manifest.Platforms); platformMatrix != "" {
+			metadata.LanguageSpecific["platform_matrix"] = platformMatrix
+		}
 	}
 
 	// Products
@@ -484,6 +551,7 @@ func (e *Extractor) populateMetadata(manifest *PackageManifest, metadata *extrac
 			matrixJSON := fmt.Sprintf(`{"swift-version": [%s]}`,
 				strings.Join(quoteStrings(matrix), ", "))
 			metadata.LanguageSpecific["matrix_json"] = matrixJSON
+			extractor.ApplyOSMatrix(metadata, "swift-version", matrix)
 		}
 	}
 
@@ -509,8 +577,7 @@ func (e *Extractor) populateMetadata(manifest *PackageManifest, metadata *extrac
 // Detect checks if this extractor can handle the project
 func (e *Extractor) Detect(projectPath string) bool {
 	// Check for Package.swift
-	packagePath := filepath.Join(projectPath, "Package.swift")
-	if _, err := os.Stat(packagePath); err == nil {
+	if extractor.FileExists(projectPath, "Package.swift") {
 		return true
 	}
 
@@ -521,44 +588,15 @@ func (e *Extractor) Detect(projectPath string) bool {
 
 // generateSwiftVersionMatrix generates a list of Swift versions from a tools version
 func generateSwiftVersionMatrix(toolsVersion string) []string {
-	versions := []string{}
+	window := versions.Get(versions.Swift)
 
 	// Parse the tools version
 	parts := strings.Split(toolsVersion, ".")
 	if len(parts) < 2 {
-		return []string{"5.9", "5.10"}
-	}
-
-	major := parts[0]
-	minor := parts[1]
-	minVersion := major + "." + minor
-
-	// Map minimum version to supported versions
-	// Only includes actively supported Swift versions (5.9+)
-	// Swift 5.8 and earlier are no longer actively supported
-	supportedVersions := map[string][]string{
-		"5.9":  {"5.9", "5.10", "5.11", "6.0", "6.1"},
-		"5.10": {"5.10", "5.11", "6.0", "6.1"},
-		"5.11": {"5.11", "6.0", "6.1"},
-		"6.0":  {"6.0", "6.1"},
-		"6.1":  {"6.1"},
-	}
-
-	if versionList, ok := supportedVersions[minVersion]; ok {
-		versions = versionList
-	}
-
-	// Default to recent versions if not found or for legacy versions
-	if len(versions) == 0 {
-		// For legacy versions (< 5.9), map to supported versions
-		if minVersion < "5.9" {
-			versions = []string{"5.9", "5.10", "5.11", "6.0", "6.1"}
-		} else {
-			versions = []string{"5.10", "5.11", "6.0", "6.1"}
-		}
+		return window.From("")
 	}
 
-	return versions
+	return window.From(parts[0] + "." + parts[1])
 }
 
 // quoteStrings adds quotes around each string
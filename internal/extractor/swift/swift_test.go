@@ -8,6 +8,8 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor/versions"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -145,13 +147,37 @@ let package = Package(
 	metadata, err := e.Extract(dir)
 	require.NoError(t, err)
 
-	// Regex parser may not extract platforms reliably
-	if platforms := metadata.LanguageSpecific["platforms"]; platforms != nil {
-		platformsList, ok := platforms.([]map[string]string)
-		if ok && len(platformsList) > 0 {
-			assert.GreaterOrEqual(t, len(platformsList), 1)
-		}
+	expected := []map[string]string{
+		{"name": "macOS", "version": "13"},
+		{"name": "iOS", "version": "16"},
+		{"name": "tvOS", "version": "16"},
+		{"name": "watchOS", "version": "9"},
 	}
+	assert.Equal(t, expected, metadata.LanguageSpecific["platforms"])
+	assert.Equal(t, 4, metadata.LanguageSpecific["platform_count"])
+	assert.Equal(t,
+		`{"include":[{"platform":"macOS","min-version":"13","os":"macos-latest"},`+
+			`{"platform":"iOS","min-version":"16","os":"macos-latest"},`+
+			`{"platform":"tvOS","min-version":"16","os":"macos-latest"},`+
+			`{"platform":"watchOS","min-version":"9","os":"macos-latest"}]}`,
+		metadata.LanguageSpecific["platform_matrix"])
+}
+
+func TestExtractPlatforms_Forms(t *testing.T) {
+	text := `let package = Package(
+    name: "Forms",
+    platforms: [.macOS(.v10_15), .iOS("16.0"),
+        .visionOS( .v1 )]
+)`
+
+	e := NewExtractor()
+	platforms := e.extractPlatforms(text)
+
+	assert.Equal(t, []Platform{
+		{Name: "macOS", Version: "10.15"},
+		{Name: "iOS", Version: "16.0"},
+		{Name: "visionOS", Version: "1"},
+	}, platforms)
 }
 
 func TestExtractor_Extract_Products(t *testing.T) {
@@ -232,6 +258,56 @@ let package = Package(
 	}
 }
 
+func TestExtractor_Extract_DependencyWarnings(t *testing.T) {
+	dir := t.TempDir()
+	packagePath := filepath.Join(dir, "Package.swift")
+
+	// The dependencies array spans several lines, which the regex parser
+	// does not follow
+	packageContent := `// swift-tools-version:5.9
+import PackageDescription
+
+let package = Package(
+    name: "MyPackage",
+    dependencies: [
+        .package(url: "https://github.com/apple/swift-log.git", from: "1.5.0"),
+        .package(path: "../LocalPackage")
+    ],
+    targets: [
+        .target(name: "MyPackage")
+    ]
+)`
+
+	err := os.WriteFile(packagePath, []byte(packageContent), 0644)
+	require.NoError(t, err)
+
+	e := NewExtractor()
+	metadata, err := e.Extract(dir)
+	require.NoError(t, err)
+
+	require.Len(t, metadata.Warnings, 1)
+	assert.Contains(t, metadata.Warnings[0], "dependency parsing incomplete: regex fallback used")
+}
+
+func TestExtractor_Extract_NoWarningsWhenComplete(t *testing.T) {
+	dir := t.TempDir()
+	packagePath := filepath.Join(dir, "Package.swift")
+
+	packageContent := `// swift-tools-version:5.9
+import PackageDescription
+
+let package = Package(name: "MyPackage", dependencies: [.package(url: "https://github.com/apple/swift-log.git", from: "1.5.0")])`
+
+	err := os.WriteFile(packagePath, []byte(packageContent), 0644)
+	require.NoError(t, err)
+
+	e := NewExtractor()
+	metadata, err := e.Extract(dir)
+	require.NoError(t, err)
+
+	assert.Empty(t, metadata.Warnings)
+}
+
 func TestExtractor_Extract_Targets(t *testing.T) {
 	dir := t.TempDir()
 	packagePath := filepath.Join(dir, "Package.swift")
@@ -296,6 +372,10 @@ let package = Package(
 	require.NotNil(t, matrixJSON)
 	assert.Contains(t, matrixJSON, "swift-version")
 	assert.Contains(t, matrixJSON, "5.9")
+
+	normalized := extractor.NormalizeMatrix(matrixJSON.(string))
+	assert.Contains(t, normalized, `"swift-version":`)
+	assert.Contains(t, normalized, `"version":`)
 }
 
 func TestExtractor_Extract_MissingFile(t *testing.T) {
@@ -453,8 +533,8 @@ func TestGenerateSwiftVersionMatrix(t *testing.T) {
 			// Empty version defaults to recent supported versions
 			name:          "empty version defaults",
 			toolsVersion:  "",
-			expectedCount: 2,
-			shouldContain: []string{"5.9", "5.10"},
+			expectedCount: 4,
+			shouldContain: []string{"5.10", "5.11", "6.0", "6.1"},
 		},
 	}
 
@@ -638,3 +718,15 @@ let package = Package(
 		assert.GreaterOrEqual(t, metadata.LanguageSpecific["target_count"], 1)
 	}
 }
+
+func TestGenerateSwiftVersionMatrix_WindowOverride(t *testing.T) {
+	versions.Set(versions.Swift, versions.Window{
+		Versions: []string{"6.0", "6.1", "6.2"},
+		Default:  []string{"6.2"},
+	})
+	t.Cleanup(versions.Reset)
+
+	assert.Equal(t, []string{"6.0", "6.1", "6.2"}, generateSwiftVersionMatrix("5.9"))
+	assert.Equal(t, []string{"6.1", "6.2"}, generateSwiftVersionMatrix("6.1"))
+	assert.Equal(t, []string{"6.2"}, generateSwiftVersionMatrix(""))
+}
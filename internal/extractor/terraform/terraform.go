@@ -8,13 +8,19 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclparse"
 	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor/versions"
+	"github.com/zclconf/go-cty/cty"
 )
 
+// lockFileName is the dependency lock file written by "terraform init"
+const lockFileName = ".terraform.lock.hcl"
+
 // Extractor extracts metadata from Terraform projects
 type Extractor struct {
 	extractor.BaseExtractor
@@ -35,7 +41,9 @@ type TerraformConfig struct {
 	CloudOrganization string
 	Modules           []ModuleCall
 	Resources         []Resource
-	IsOpenTofu        bool // Detected if using OpenTofu
+	ProviderConfigs   []string          // Names of provider "x" {} configuration blocks
+	LockedProviders   map[string]string // Provider address to version from .terraform.lock.hcl
+	IsOpenTofu        bool              // Detected if using OpenTofu
 }
 
 // ProviderRequirement represents a required provider
@@ -97,8 +105,14 @@ func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, err
 		}
 	}
 
+	// Provider versions selected by "terraform init"
+	if locked, err := parseLockFile(filepath.Join(projectPath, lockFileName)); err == nil {
+		config.LockedProviders = locked
+	}
+
 	// Extract metadata
 	e.populateMetadata(config, metadata, projectPath)
+	extractor.RecordManifest(metadata, primaryTerraformFile(projectPath, files))
 
 	return metadata, nil
 }
@@ -135,6 +149,10 @@ func (e *Extractor) parseFile(path string, config *TerraformConfig) error {
 				switch block.Type {
 				case "terraform":
 					e.parseTerraformBlock(block, config)
+				case "provider":
+					if len(block.Labels) > 0 {
+						config.ProviderConfigs = append(config.ProviderConfigs, block.Labels[0])
+					}
 				case "module":
 					e.parseModuleBlock(block, config)
 				case "resource":
@@ -177,7 +195,18 @@ func (e *Extractor) parseTerraformBlock(block *hcl.Block, config *TerraformConfi
 					// Handle both string and object syntax
 					if val.Type().IsObjectType() {
 						// Parse object attributes
-						config.RequiredProviders[name] = ProviderRequirement{}
+						req := ProviderRequirement{}
+						if val.Type().HasAttribute("source") {
+							if source := val.GetAttr("source"); source.Type() == cty.String && source.IsKnown() && !source.IsNull() {
+								req.Source = source.AsString()
+							}
+						}
+						if val.Type().HasAttribute("version") {
+							if version := val.GetAttr("version"); version.Type() == cty.String && version.IsKnown() && !version.IsNull() {
+								req.Version = version.AsString()
+							}
+						}
+						config.RequiredProviders[name] = req
 					} else {
 						config.RequiredProviders[name] = ProviderRequirement{
 							Version: strings.Trim(val.AsString(), `"`),
@@ -244,20 +273,24 @@ func (e *Extractor) parseWithRegex(content string, config *TerraformConfig) erro
 	}
 
 	// Extract required_providers
-	providerBlockRe := regexp.MustCompile(`required_providers\s*{([^}]+)}`)
-	if matches := providerBlockRe.FindStringSubmatch(content); len(matches) > 1 {
-		providerContent := matches[1]
-		providerRe := regexp.MustCompile(`(\w+)\s*=\s*{[^}]*version\s*=\s*"([^"]+)"`)
+	if providerContent := bracedBlockBody(content, "required_providers"); providerContent != "" {
+		providerRe := regexp.MustCompile(`(\w+)\s*=\s*{([^}]*)}`)
+		sourceRe := regexp.MustCompile(`source\s*=\s*"([^"]+)"`)
+		versionRe := regexp.MustCompile(`version\s*=\s*"([^"]+)"`)
 		for _, match := range providerRe.FindAllStringSubmatch(providerContent, -1) {
-			if len(match) > 2 {
-				config.RequiredProviders[match[1]] = ProviderRequirement{
-					Version: match[2],
-				}
+			req := ProviderRequirement{}
+			if sourceMatch := sourceRe.FindStringSubmatch(match[2]); sourceMatch != nil {
+				req.Source = sourceMatch[1]
+			}
+			if versionMatch := versionRe.FindStringSubmatch(match[2]); versionMatch != nil {
+				req.Version = versionMatch[1]
 			}
+			config.RequiredProviders[match[1]] = req
 		}
-		// Also handle simple string syntax
+		// Also handle simple string syntax, outside the object values so
+		// their source and version attributes are not taken as providers
 		simpleProviderRe := regexp.MustCompile(`(\w+)\s*=\s*"([^"]+)"`)
-		for _, match := range simpleProviderRe.FindAllStringSubmatch(providerContent, -1) {
+		for _, match := range simpleProviderRe.FindAllStringSubmatch(providerRe.ReplaceAllString(providerContent, ""), -1) {
 			if len(match) > 2 {
 				if _, exists := config.RequiredProviders[match[1]]; !exists {
 					config.RequiredProviders[match[1]] = ProviderRequirement{
@@ -274,6 +307,12 @@ func (e *Extractor) parseWithRegex(content string, config *TerraformConfig) erro
 		config.Backend = matches[1]
 	}
 
+	// Extract provider configuration blocks
+	providerConfigRe := regexp.MustCompile(`(?m)^\s*provider\s+"([^"]+)"\s*{`)
+	for _, match := range providerConfigRe.FindAllStringSubmatch(content, -1) {
+		config.ProviderConfigs = append(config.ProviderConfigs, match[1])
+	}
+
 	// Extract modules
 	moduleRe := regexp.MustCompile(`module\s+"([^"]+)"\s*{([^}]+)}`)
 	for _, match := range moduleRe.FindAllStringSubmatch(content, -1) {
@@ -298,6 +337,29 @@ func (e *Extractor) parseWithRegex(content string, config *TerraformConfig) erro
 	return nil
 }
 
+// bracedBlockBody returns the content between the braces of the first
+// block named keyword, or an empty string when there is none. Nested
+// braces are balanced; an unterminated block runs to the end of content.
+func bracedBlockBody(content, keyword string) string {
+	start := regexp.MustCompile(regexp.QuoteMeta(keyword) + `\s*{`).FindStringIndex(content)
+	if start == nil {
+		return ""
+	}
+	depth := 1
+	for i := start[1]; i < len(content); i++ {
+		switch content[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return content[start[1]:i]
+			}
+		}
+	}
+	return content[start[1]:]
+}
+
 // populateMetadata converts TerraformConfig to ProjectMetadata
 func (e *Extractor) populateMetadata(config *TerraformConfig, metadata *extractor.ProjectMetadata, projectPath string) {
 	// Try to extract project name from directory
@@ -320,23 +382,57 @@ func (e *Extractor) populateMetadata(config *TerraformConfig, metadata *extracto
 		metadata.LanguageSpecific["backend"] = config.Backend
 	}
 
+	// A backend or provider configuration marks a deployable root module;
+	// reusable modules only declare variables, outputs and resources
+	isRootModule := config.Backend != "" || len(config.ProviderConfigs) > 0
+	metadata.LanguageSpecific["is_root_module"] = isRootModule
+	if isRootModule {
+		metadata.LanguageSpecific["module_type"] = "root"
+	} else {
+		metadata.LanguageSpecific["module_type"] = "module"
+	}
+
 	// Providers
 	if len(config.RequiredProviders) > 0 {
 		providers := make([]map[string]string, 0, len(config.RequiredProviders))
+		dependencies := make(map[string]string, len(config.RequiredProviders))
+		sources := make([]string, 0, len(config.RequiredProviders))
 		for name, req := range config.RequiredProviders {
+			// Providers without a source default to the hashicorp namespace
+			source := req.Source
+			if source == "" {
+				source = "hashicorp/" + name
+			}
 			provider := map[string]string{
-				"name": name,
+				"name":     name,
+				"source":   source,
+				"registry": providerRegistry(source, config.IsOpenTofu),
 			}
 			if req.Version != "" {
 				provider["version"] = req.Version
 			}
-			if req.Source != "" {
-				provider["source"] = req.Source
+			sources = append(sources, source)
+
+			// The locked version is what actually gets installed, so
+			// prefer it over the constraint for display
+			display := req.Version
+			if locked := lockedProviderVersion(config.LockedProviders, name, source); locked != "" {
+				provider["locked_version"] = locked
+				display = locked
 			}
+			dependencies[name] = display
+
 			providers = append(providers, provider)
 		}
+		sort.Strings(sources)
 		metadata.LanguageSpecific["providers"] = providers
 		metadata.LanguageSpecific["provider_count"] = len(providers)
+		metadata.LanguageSpecific["provider_sources"] = sources
+		metadata.LanguageSpecific["dependencies"] = dependencies
+	}
+
+	if len(config.LockedProviders) > 0 {
+		metadata.LanguageSpecific["locked_providers"] = config.LockedProviders
 	}
 
 	// Modules
@@ -380,23 +476,112 @@ func (e *Extractor) populateMetadata(config *TerraformConfig, metadata *extracto
 			matrixJSON := fmt.Sprintf(`{"%s-version": [%s]}`,
 				engine, strings.Join(quoteStrings(matrix), ", "))
 			metadata.LanguageSpecific["matrix_json"] = matrixJSON
+			extractor.ApplyOSMatrix(metadata, engine+"-version", matrix)
 		}
 	}
 }
 
+// parseLockFile reads provider versions from a .terraform.lock.hcl file,
+// keyed by the full provider address. A missing lock file yields nil.
+func parseLockFile(path string) (map[string]string, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	file, diags := hclparse.NewParser().ParseHCLFile(path)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("failed to parse %s: %s", path, diags.Error())
+	}
+
+	schema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "provider", LabelNames: []string{"source"}},
+		},
+	}
+	content, _, _ := file.Body.PartialContent(schema)
+	if content == nil {
+		return nil, nil
+	}
+
+	locked := make(map[string]string)
+	versionSchema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{{Name: "version"}},
+	}
+	for _, block := range content.Blocks {
+		body, _, _ := block.Body.PartialContent(versionSchema)
+		if body == nil {
+			continue
+		}
+		attr, exists := body.Attributes["version"]
+		if !exists {
+			continue
+		}
+		val, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() || val.Type() != cty.String || val.IsNull() {
+			continue
+		}
+		locked[block.Labels[0]] = val.AsString()
+	}
+
+	return locked, nil
+}
+
+// providerRegistry returns the registry host a provider source is
+// installed from. Sources of the form "host/namespace/type" name their
+// registry; shorter sources use the public Terraform registry, or the
+// OpenTofu registry for OpenTofu configurations.
+func providerRegistry(source string, isOpenTofu bool) string {
+	if parts := strings.Split(source, "/"); len(parts) == 3 {
+		return strings.ToLower(parts[0])
+	}
+	if isOpenTofu {
+		return "registry.opentofu.org"
+	}
+	return "registry.terraform.io"
+}
+
+// lockedProviderVersion finds the locked version for a required provider.
+// Lock files use fully qualified addresses, so a source of "hashicorp/aws"
+// matches "registry.terraform.io/hashicorp/aws"; providers without a
+// source default to the hashicorp namespace.
+func lockedProviderVersion(locked map[string]string, name, source string) string {
+	if len(locked) == 0 {
+		return ""
+	}
+	if source == "" {
+		source = "hashicorp/" + name
+	}
+	source = strings.ToLower(source)
+
+	for address, version := range locked {
+		address = strings.ToLower(address)
+		if address == source || strings.HasSuffix(address, "/"+source) {
+			return version
+		}
+	}
+	return ""
+}
+
+// primaryTerraformFile returns main.tf when present, otherwise the first
+// of the given .tf files
+func primaryTerraformFile(projectPath string, files []string) string {
+	mainPath := filepath.Join(projectPath, "main.tf")
+	if _, err := os.Stat(mainPath); err == nil {
+		return mainPath
+	}
+	return files[0]
+}
+
 // Detect checks if this extractor can handle the project
 func (e *Extractor) Detect(projectPath string) bool {
 	// Check for any .tf files
-	files, err := filepath.Glob(filepath.Join(projectPath, "*.tf"))
-	return err == nil && len(files) > 0
+	return len(extractor.GlobFiles(projectPath, "*.tf")) > 0
 }
 
 // Helper functions
 
 // generateTerraformVersionMatrix generates a list of Terraform/OpenTofu versions from a constraint
 func generateTerraformVersionMatrix(requiredVersion string) []string {
-	versions := []string{}
-
 	// Parse common version constraints
 	minVersion := ""
 	if strings.Contains(requiredVersion, ">=") {
@@ -414,36 +599,8 @@ func generateTerraformVersionMatrix(requiredVersion string) []string {
 		}
 	}
 
-	// Map minimum version to supported versions
-	// Includes latest Terraform and OpenTofu versions
-	supportedVersions := map[string][]string{
-		"1.5":  {"1.5", "1.6", "1.7", "1.8", "1.9", "1.10"},
-		"1.6":  {"1.6", "1.7", "1.8", "1.9", "1.10"},
-		"1.7":  {"1.7", "1.8", "1.9", "1.10"},
-		"1.8":  {"1.8", "1.9", "1.10"},
-		"1.9":  {"1.9", "1.10"},
-		"1.10": {"1.10"},
-	}
-
-	if minVersion != "" {
-		if versionList, ok := supportedVersions[minVersion]; ok {
-			versions = versionList
-		} else {
-			// Map legacy versions to supported versions
-			if minVersion < "1.5" {
-				versions = []string{"1.5", "1.6", "1.7", "1.8", "1.9", "1.10"}
-			} else {
-				versions = []string{"1.8", "1.9", "1.10"}
-			}
-		}
-	}
-
-	// Default to current stable versions
-	if len(versions) == 0 {
-		versions = []string{"1.8", "1.9", "1.10"}
-	}
-
-	return versions
+	// Map the minimum onto the supported Terraform/OpenTofu versions
+	return versions.Get(versions.Terraform).From(minVersion)
 }
 
 // quoteStrings adds quotes around each string
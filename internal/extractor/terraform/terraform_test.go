@@ -8,6 +8,8 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor/versions"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -181,6 +183,84 @@ func TestExtractor_Extract_Providers(t *testing.T) {
 	assert.True(t, found, "aws provider should be present")
 }
 
+func TestExtractor_Extract_LockedProviders(t *testing.T) {
+	dir := t.TempDir()
+
+	versionsContent := `terraform {
+  required_version = ">= 1.5"
+
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.0"
+    }
+    random = {
+      source  = "hashicorp/random"
+      version = ">= 3.0"
+    }
+  }
+}`
+	lockContent := `# This file is maintained automatically by "terraform init".
+# Manual edits may be lost in future updates.
+
+provider "registry.terraform.io/hashicorp/aws" {
+  version     = "5.31.0"
+  constraints = "~> 5.0"
+  hashes = [
+    "h1:abc=",
+  ]
+}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "versions.tf"), []byte(versionsContent), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".terraform.lock.hcl"), []byte(lockContent), 0644))
+
+	e := NewExtractor()
+	metadata, err := e.Extract(dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"registry.terraform.io/hashicorp/aws": "5.31.0",
+	}, metadata.LanguageSpecific["locked_providers"])
+
+	providers, ok := metadata.LanguageSpecific["providers"].([]map[string]string)
+	require.True(t, ok)
+	for _, p := range providers {
+		switch p["name"] {
+		case "aws":
+			assert.Equal(t, "hashicorp/aws", p["source"])
+			assert.Equal(t, "~> 5.0", p["version"])
+			assert.Equal(t, "5.31.0", p["locked_version"])
+		case "random":
+			assert.NotContains(t, p, "locked_version")
+		}
+	}
+
+	// Locked versions are preferred for display, falling back to the constraint
+	assert.Equal(t, map[string]string{
+		"aws":    "5.31.0",
+		"random": ">= 3.0",
+	}, metadata.LanguageSpecific["dependencies"])
+}
+
+func TestExtractor_Extract_NoLockFile(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "versions.tf"), []byte(`terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.0"
+    }
+  }
+}`), 0644))
+
+	e := NewExtractor()
+	metadata, err := e.Extract(dir)
+	require.NoError(t, err)
+
+	assert.NotContains(t, metadata.LanguageSpecific, "locked_providers")
+	assert.Equal(t, map[string]string{"aws": "~> 5.0"}, metadata.LanguageSpecific["dependencies"])
+}
+
 func TestExtractor_Extract_Modules(t *testing.T) {
 	dir := t.TempDir()
 	mainPath := filepath.Join(dir, "main.tf")
@@ -304,6 +384,10 @@ func TestExtractor_Extract_VersionMatrix(t *testing.T) {
 	require.NotNil(t, matrixJSON)
 	assert.Contains(t, matrixJSON, "terraform-version")
 	assert.Contains(t, matrixJSON, "1.5")
+
+	normalized := extractor.NormalizeMatrix(matrixJSON.(string))
+	assert.Contains(t, normalized, `"terraform-version":`)
+	assert.Contains(t, normalized, `"version":`)
 }
 
 func TestExtractor_Extract_MissingFiles(t *testing.T) {
@@ -405,6 +489,131 @@ func TestExtractor_Extract_RegexFallback(t *testing.T) {
 	assert.Equal(t, ">= 1.5.0", metadata.LanguageSpecific["terraform_version"])
 }
 
+func TestExtractor_Extract_ProviderSources(t *testing.T) {
+	tfContent := `terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.0"
+    }
+    internal = {
+      source  = "registry.example.com/corp/internal"
+      version = "1.2.0"
+    }
+    random = "~> 3.5"
+  }
+}
+`
+	// A trailing syntax error forces the regex fallback
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "HCL", content: tfContent},
+		{name: "regex fallback", content: tfContent + "locals {\n  broken = \n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "versions.tf"), []byte(tt.content), 0644))
+
+			metadata, err := NewExtractor().Extract(dir)
+			require.NoError(t, err)
+
+			providersList, ok := metadata.LanguageSpecific["providers"].([]map[string]string)
+			require.True(t, ok)
+			require.Len(t, providersList, 3)
+
+			byName := make(map[string]map[string]string)
+			for _, p := range providersList {
+				byName[p["name"]] = p
+			}
+			assert.Equal(t, "hashicorp/aws", byName["aws"]["source"])
+			assert.Equal(t, "registry.terraform.io", byName["aws"]["registry"])
+			assert.Equal(t, "~> 5.0", byName["aws"]["version"])
+			assert.Equal(t, "registry.example.com/corp/internal", byName["internal"]["source"])
+			assert.Equal(t, "registry.example.com", byName["internal"]["registry"])
+			assert.Equal(t, "1.2.0", byName["internal"]["version"])
+			assert.Equal(t, "hashicorp/random", byName["random"]["source"])
+			assert.Equal(t, "~> 3.5", byName["random"]["version"])
+
+			assert.Equal(t, []string{
+				"hashicorp/aws",
+				"hashicorp/random",
+				"registry.example.com/corp/internal",
+			}, metadata.LanguageSpecific["provider_sources"])
+		})
+	}
+}
+
+func TestProviderRegistry(t *testing.T) {
+	assert.Equal(t, "registry.terraform.io", providerRegistry("hashicorp/aws", false))
+	assert.Equal(t, "registry.opentofu.org", providerRegistry("hashicorp/aws", true))
+	assert.Equal(t, "tf.example.com", providerRegistry("TF.example.com/corp/internal", false))
+}
+
+func TestExtractor_Extract_ModuleType(t *testing.T) {
+	tests := []struct {
+		name         string
+		content      string
+		isRootModule bool
+		moduleType   string
+	}{
+		{
+			name: "backend block",
+			content: `terraform {
+  backend "s3" {
+    bucket = "my-bucket"
+  }
+}`,
+			isRootModule: true,
+			moduleType:   "root",
+		},
+		{
+			name: "provider configuration block",
+			content: `provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_s3_bucket" "example" {}`,
+			isRootModule: true,
+			moduleType:   "root",
+		},
+		{
+			name: "reusable module",
+			content: `variable "name" {
+  type = string
+}
+
+resource "aws_s3_bucket" "example" {
+  bucket = var.name
+}
+
+output "arn" {
+  value = aws_s3_bucket.example.arn
+}`,
+			isRootModule: false,
+			moduleType:   "module",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			err := os.WriteFile(filepath.Join(dir, "main.tf"), []byte(tt.content), 0644)
+			require.NoError(t, err)
+
+			e := NewExtractor()
+			metadata, err := e.Extract(dir)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.isRootModule, metadata.LanguageSpecific["is_root_module"])
+			assert.Equal(t, tt.moduleType, metadata.LanguageSpecific["module_type"])
+		})
+	}
+}
+
 func TestGenerateTerraformVersionMatrix(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -546,3 +755,15 @@ func TestExtractor_Extract_EmptyTerraformBlock(t *testing.T) {
 	// Should still succeed with resources but no terraform block
 	assert.Equal(t, 1, metadata.LanguageSpecific["resource_count"])
 }
+
+func TestGenerateTerraformVersionMatrix_WindowOverride(t *testing.T) {
+	versions.Set(versions.Terraform, versions.Window{
+		Versions: []string{"1.9", "1.10", "1.11"},
+		Default:  []string{"1.11"},
+	})
+	t.Cleanup(versions.Reset)
+
+	assert.Equal(t, []string{"1.9", "1.10", "1.11"}, generateTerraformVersionMatrix(">= 1.5"))
+	assert.Equal(t, []string{"1.10", "1.11"}, generateTerraformVersionMatrix("~> 1.10"))
+	assert.Equal(t, []string{"1.11"}, generateTerraformVersionMatrix(""))
+}
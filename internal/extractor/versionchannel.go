@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Release channels reported by VersionChannel
+const (
+	ChannelStable     = "stable"
+	ChannelPrerelease = "prerelease"
+	ChannelDev        = "dev"
+	ChannelSnapshot   = "snapshot"
+	ChannelUnknown    = "unknown"
+)
+
+// releaseNumberRe matches the numeric release segment, e.g. "1.2.3"
+var releaseNumberRe = regexp.MustCompile(`^\d+(?:\.\d+)*`)
+
+// pep440SuffixRe matches the PEP 440 pre, post and dev release suffixes
+// following the release segment, e.g. "b1", "rc1", ".post2" or ".dev3"
+var pep440SuffixRe = regexp.MustCompile(
+	`^(?:[-_.]?(a|b|c|rc|alpha|beta|pre|preview)[-_.]?\d*)?(?:[-_.]?(?:post|rev|r)[-_.]?\d*)?(?:[-_.]?(dev)[-_.]?\d*)?$`)
+
+// finalReleaseSuffixes mark Maven and Spring style final releases,
+// e.g. "5.3.0.RELEASE"
+var finalReleaseSuffixes = map[string]bool{
+	".final":   true,
+	".release": true,
+	".ga":      true,
+}
+
+// VersionChannel classifies a project version for release gating:
+// stable ("1.2.3"), prerelease ("1.2.3-rc.1", PEP 440 "1.2.3b1"), dev
+// (build metadata "1.2.3+build.5", PEP 440 "1.2.3.dev1"), snapshot (Maven
+// "1.2.3-SNAPSHOT") or unknown when the version is not recognized
+func VersionChannel(version string) string {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if strings.Contains(strings.ToLower(version), "snapshot") {
+		return ChannelSnapshot
+	}
+
+	release := releaseNumberRe.FindString(version)
+	if release == "" {
+		return ChannelUnknown
+	}
+	suffix := strings.ToLower(version[len(release):])
+
+	switch {
+	case suffix == "":
+		return ChannelStable
+	case strings.Contains(suffix, "+"):
+		return ChannelDev
+	case finalReleaseSuffixes[suffix]:
+		return ChannelStable
+	}
+
+	if match := pep440SuffixRe.FindStringSubmatch(suffix); match != nil {
+		switch {
+		case match[2] != "":
+			return ChannelDev
+		case match[1] != "":
+			return ChannelPrerelease
+		default:
+			// Post releases are stable
+			return ChannelStable
+		}
+	}
+
+	// Any other semver pre-release identifier, e.g. "-alpha.1" or "-M2"
+	if strings.HasPrefix(suffix, "-") {
+		return ChannelPrerelease
+	}
+	return ChannelUnknown
+}
+
+// IsPrerelease reports whether channel is anything other than a stable
+// or unrecognized release
+func IsPrerelease(channel string) bool {
+	return channel == ChannelPrerelease || channel == ChannelDev || channel == ChannelSnapshot
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import "testing"
+
+// TestVersionChannel tests classifying semver, PEP 440 and Maven versions
+func TestVersionChannel(t *testing.T) {
+	tests := []struct {
+		version    string
+		expected   string
+		prerelease bool
+	}{
+		// Semver
+		{"1.2.3", ChannelStable, false},
+		{"v2.0.0", ChannelStable, false},
+		{"1.0.0-alpha", ChannelPrerelease, true},
+		{"1.0.0-beta.2", ChannelPrerelease, true},
+		{"1.0.0-rc.1", ChannelPrerelease, true},
+		{"1.0.0+build.5", ChannelDev, true},
+		{"1.0.0-rc.1+20240301", ChannelDev, true},
+
+		// PEP 440
+		{"1.0.0b1", ChannelPrerelease, true},
+		{"1.0.0rc1", ChannelPrerelease, true},
+		{"2.1a3", ChannelPrerelease, true},
+		{"1.0.0.post1", ChannelStable, false},
+		{"1.0.0.dev4", ChannelDev, true},
+		{"1.0.0rc1.dev2", ChannelDev, true},
+
+		// Maven
+		{"1.0.0-SNAPSHOT", ChannelSnapshot, true},
+		{"3.2-snapshot", ChannelSnapshot, true},
+		{"5.3.0.RELEASE", ChannelStable, false},
+		{"4.0.0-M2", ChannelPrerelease, true},
+
+		// Unrecognized
+		{"", ChannelUnknown, false},
+		{"dynamic", ChannelUnknown, false},
+		{"1.0.0.weird", ChannelUnknown, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			channel := VersionChannel(tt.version)
+			if channel != tt.expected {
+				t.Errorf("VersionChannel(%q) = %q, want %q", tt.version, channel, tt.expected)
+			}
+			if got := IsPrerelease(channel); got != tt.prerelease {
+				t.Errorf("IsPrerelease(%q) = %v, want %v", channel, got, tt.prerelease)
+			}
+		})
+	}
+}
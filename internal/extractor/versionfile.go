@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// versionFiles lists plain version file names in lookup order
+var versionFiles = []string{"VERSION", "version.txt"}
+
+// VersionSourceFile is the VersionSource reported for versions read from
+// a plain version file
+const VersionSourceFile = "VERSION-file"
+
+// ReadVersionFile returns the first non-empty line of a top-level VERSION
+// or version.txt file, trimmed
+func ReadVersionFile(projectPath string) (string, bool) {
+	for _, name := range versionFiles {
+		file, err := os.Open(filepath.Join(projectPath, name))
+		if err != nil {
+			continue
+		}
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			if line := strings.TrimSpace(scanner.Text()); line != "" {
+				file.Close()
+				return line, true
+			}
+		}
+		file.Close()
+	}
+	return "", false
+}
+
+// ApplyVersionFile fills in an empty Version from a VERSION or
+// version.txt file. It runs after manifest parsing and before the git tag
+// fallback, as a committed version file is an explicit declaration.
+// Returns true when the version was set.
+func ApplyVersionFile(projectPath string, metadata *ProjectMetadata) bool {
+	if metadata == nil || metadata.Version != "" {
+		return false
+	}
+
+	version, ok := ReadVersionFile(projectPath)
+	if !ok {
+		return false
+	}
+
+	metadata.Version = version
+	metadata.VersionSource = VersionSourceFile
+	return true
+}
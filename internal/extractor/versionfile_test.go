@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package extractor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestApplyVersionFile tests the VERSION file fallback
+func TestApplyVersionFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		existing string
+		expected string
+		applied  bool
+	}{
+		{
+			name:     "VERSION file",
+			files:    map[string]string{"VERSION": "\n  2.3.4  \n3.0.0\n"},
+			expected: "2.3.4",
+			applied:  true,
+		},
+		{
+			name:     "version.txt",
+			files:    map[string]string{"version.txt": "1.0.0-rc.1\n"},
+			expected: "1.0.0-rc.1",
+			applied:  true,
+		},
+		{
+			name:     "VERSION wins over version.txt",
+			files:    map[string]string{"VERSION": "2.0.0\n", "version.txt": "1.0.0\n"},
+			expected: "2.0.0",
+			applied:  true,
+		},
+		{
+			name:     "manifest version is kept",
+			files:    map[string]string{"VERSION": "2.3.4\n"},
+			existing: "1.0.0",
+			expected: "1.0.0",
+		},
+		{
+			name:  "empty VERSION file",
+			files: map[string]string{"VERSION": "\n\n"},
+		},
+		{
+			name: "no version file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+					t.Fatalf("Failed to write %s: %v", name, err)
+				}
+			}
+
+			metadata := &ProjectMetadata{Version: tt.existing}
+			if applied := ApplyVersionFile(dir, metadata); applied != tt.applied {
+				t.Errorf("ApplyVersionFile() = %v, want %v", applied, tt.applied)
+			}
+			if metadata.Version != tt.expected {
+				t.Errorf("Version = %q, want %q", metadata.Version, tt.expected)
+			}
+			if tt.applied && metadata.VersionSource != VersionSourceFile {
+				t.Errorf("VersionSource = %q, want %q", metadata.VersionSource, VersionSourceFile)
+			}
+		})
+	}
+}
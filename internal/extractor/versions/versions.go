@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+// Package versions holds the supported version windows the matrix
+// generators draw from, so that dropping an end-of-life release or adding
+// a new one is a change to a single table.
+package versions
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Ecosystems with a supported version window
+const (
+	Elixir    = "elixir"
+	Java      = "java"
+	PHP       = "php"
+	Scala3    = "scala3"
+	Swift     = "swift"
+	Terraform = "terraform"
+)
+
+// Window is the range of versions a matrix may contain for one ecosystem
+type Window struct {
+	// Versions lists every supported major.minor release in ascending
+	// order, from the oldest still supported to the latest
+	Versions []string
+
+	// Default is the matrix used when no usable constraint is found
+	Default []string
+
+	// Newer lists releases after Latest, in ascending order, that only
+	// enter a matrix when a constraint's minimum requires them
+	Newer []string
+}
+
+// Minimum returns the oldest supported version
+func (w Window) Minimum() string {
+	if len(w.Versions) == 0 {
+		return ""
+	}
+	return w.Versions[0]
+}
+
+// Latest returns the newest supported version
+func (w Window) Latest() string {
+	if len(w.Versions) == 0 {
+		return ""
+	}
+	return w.Versions[len(w.Versions)-1]
+}
+
+// All returns the supported versions followed by the newer ones
+func (w Window) All() []string {
+	return append(append([]string(nil), w.Versions...), w.Newer...)
+}
+
+// From returns the supported versions at or above minVersion. Minimums
+// older than the window select every supported version, and minimums
+// beyond Latest select the matching Newer versions. An empty or
+// unparsable minimum, or one beyond every known version, selects Default.
+func (w Window) From(minVersion string) []string {
+	if minVersion == "" || !valid(minVersion) {
+		return append([]string(nil), w.Default...)
+	}
+
+	candidates := w.Versions
+	if Compare(minVersion, w.Latest()) > 0 {
+		candidates = w.Newer
+	}
+
+	selected := []string{}
+	for _, version := range candidates {
+		if Compare(version, minVersion) >= 0 {
+			selected = append(selected, version)
+		}
+	}
+	if len(selected) == 0 {
+		return append([]string(nil), w.Default...)
+	}
+	return selected
+}
+
+// defaultWindows returns the built-in windows. Update these as releases
+// reach end of life or new ones ship.
+func defaultWindows() map[string]Window {
+	return map[string]Window{
+		// Elixir supports the five most recent minor releases
+		Elixir: {
+			Versions: []string{"1.14", "1.15", "1.16", "1.17", "1.18"},
+			Default:  []string{"1.16", "1.17", "1.18"},
+			Newer:    []string{"1.19"},
+		},
+		// Java matrices test the declared release and the LTS releases
+		// after it, so only LTS releases are listed
+		Java: {
+			Versions: []string{"8", "11", "17", "21", "25"},
+			Default:  []string{"17", "21", "25"},
+		},
+		// PHP 7.x and 8.0 have reached end of life
+		PHP: {
+			Versions: []string{"8.1", "8.2", "8.3"},
+			Default:  []string{"8.1", "8.2", "8.3"},
+			Newer:    []string{"8.4"},
+		},
+		// Scala 3: the 3.3 LTS line, then the Scala Next lines. Latest is
+		// the Scala Next minor that 3.5+ projects are also tested against.
+		Scala3: {
+			Versions: []string{"3.3", "3.4", "3.5", "3.6"},
+			Default:  []string{"3.3", "3.6"},
+		},
+		// Swift 5.8 and earlier are no longer actively supported
+		Swift: {
+			Versions: []string{"5.9", "5.10", "5.11", "6.0", "6.1"},
+			Default:  []string{"5.10", "5.11", "6.0", "6.1"},
+		},
+		// Terraform 1.4 and earlier are end of life; OpenTofu follows
+		// the same minor versions
+		Terraform: {
+			Versions: []string{"1.5", "1.6", "1.7", "1.8", "1.9", "1.10"},
+			Default:  []string{"1.8", "1.9", "1.10"},
+		},
+	}
+}
+
+// windows holds the active table. It is mutable so tests (and callers
+// reading configuration) can override an ecosystem with Set.
+var windows = defaultWindows()
+
+// Get returns the supported window for an ecosystem
+func Get(ecosystem string) Window {
+	return windows[ecosystem]
+}
+
+// Set replaces the supported window for an ecosystem
+func Set(ecosystem string, window Window) {
+	windows[ecosystem] = window
+}
+
+// Reset restores the built-in windows
+func Reset() {
+	windows = defaultWindows()
+}
+
+// Compare compares two major.minor versions numerically, ignoring any
+// patch component. Missing or non-numeric parts count as zero.
+func Compare(a, b string) int {
+	aMajor, aMinor := split(a)
+	bMajor, bMinor := split(b)
+	if aMajor != bMajor {
+		return aMajor - bMajor
+	}
+	return aMinor - bMinor
+}
+
+// MajorMinor returns the "major.minor" form of a version string, ignoring
+// any patch component, e.g. "8.1.2" -> "8.1"
+func MajorMinor(version string) string {
+	major, minor := split(version)
+	return fmt.Sprintf("%d.%d", major, minor)
+}
+
+// split parses the major and minor parts of a version string
+func split(version string) (int, int) {
+	parts := strings.SplitN(version, ".", 3)
+	major, _ := strconv.Atoi(parts[0])
+	minor := 0
+	if len(parts) > 1 {
+		minor, _ = strconv.Atoi(parts[1])
+	}
+	return major, minor
+}
+
+// valid reports whether version starts with a numeric major version
+func valid(version string) bool {
+	major, _, _ := strings.Cut(version, ".")
+	_, err := strconv.Atoi(major)
+	return err == nil
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package versions
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWindowFrom(t *testing.T) {
+	window := Window{
+		Versions: []string{"1.8", "1.9", "1.10"},
+		Default:  []string{"1.9", "1.10"},
+		Newer:    []string{"2.0"},
+	}
+
+	tests := []struct {
+		name       string
+		minVersion string
+		want       []string
+	}{
+		{"within window", "1.9", []string{"1.9", "1.10"}},
+		{"numeric ordering", "1.10", []string{"1.10"}},
+		{"older than window", "1.2", []string{"1.8", "1.9", "1.10"}},
+		{"patch ignored", "1.9.3", []string{"1.9", "1.10"}},
+		{"newer release", "2.0", []string{"2.0"}},
+		{"beyond known releases", "99.0", []string{"1.9", "1.10"}},
+		{"empty", "", []string{"1.9", "1.10"}},
+		{"unparsable", "latest", []string{"1.9", "1.10"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := window.From(tt.minVersion); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("From(%q) = %v, want %v", tt.minVersion, got, tt.want)
+			}
+		})
+	}
+
+	if window.Minimum() != "1.8" || window.Latest() != "1.10" {
+		t.Errorf("Minimum/Latest = %s/%s, want 1.8/1.10", window.Minimum(), window.Latest())
+	}
+}
+
+func TestSetAndReset(t *testing.T) {
+	t.Cleanup(Reset)
+
+	builtIn := Get(Terraform)
+	Set(Terraform, Window{Versions: []string{"1.11"}, Default: []string{"1.11"}})
+	if got := Get(Terraform).Latest(); got != "1.11" {
+		t.Errorf("Latest() after Set = %s, want 1.11", got)
+	}
+
+	Reset()
+	if !reflect.DeepEqual(Get(Terraform), builtIn) {
+		t.Errorf("Reset() should restore the built-in window")
+	}
+}
+
+func TestDefaultWindowsAreOrdered(t *testing.T) {
+	for ecosystem, window := range defaultWindows() {
+		all := window.All()
+		for i := 1; i < len(all); i++ {
+			if Compare(all[i-1], all[i]) >= 0 {
+				t.Errorf("%s versions not ascending: %v", ecosystem, all)
+			}
+		}
+		for _, version := range window.Default {
+			if Compare(version, window.Minimum()) < 0 || Compare(version, window.Latest()) > 0 {
+				t.Errorf("%s default %s outside %s..%s", ecosystem, version, window.Minimum(), window.Latest())
+			}
+		}
+	}
+}
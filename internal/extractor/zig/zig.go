@@ -0,0 +1,369 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package zig
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+)
+
+const (
+	buildFileName    = "build.zig"
+	manifestFileName = "build.zig.zon"
+)
+
+// Extractor extracts metadata from Zig projects
+type Extractor struct {
+	extractor.BaseExtractor
+}
+
+// NewExtractor creates a new Zig extractor
+func NewExtractor() *Extractor {
+	return &Extractor{
+		BaseExtractor: extractor.NewBaseExtractor("zig", 1),
+	}
+}
+
+func init() {
+	extractor.RegisterExtractor(NewExtractor())
+}
+
+// Manifest represents the top-level fields of a build.zig.zon file
+type Manifest struct {
+	Name              string
+	Version           string
+	MinimumZigVersion string
+	Paths             []string
+
+	// Dependencies maps each dependency to its url, or path for local ones
+	Dependencies map[string]string
+}
+
+// Detect checks if this is a Zig project
+func (e *Extractor) Detect(projectPath string) bool {
+	for _, name := range []string{manifestFileName, buildFileName} {
+		if extractor.FileExists(projectPath, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Extract retrieves metadata from a Zig project
+func (e *Extractor) Extract(projectPath string) (*extractor.ProjectMetadata, error) {
+	metadata := &extractor.ProjectMetadata{
+		LanguageSpecific: make(map[string]interface{}),
+	}
+	metadata.LanguageSpecific["build_tool"] = "zig build"
+
+	manifestPath := filepath.Join(projectPath, manifestFileName)
+	content, err := os.ReadFile(manifestPath)
+	if os.IsNotExist(err) {
+		// build.zig alone carries no package metadata
+		buildPath := filepath.Join(projectPath, buildFileName)
+		if _, err := os.Stat(buildPath); err != nil {
+			return nil, fmt.Errorf("no %s or %s found in %s", manifestFileName, buildFileName, projectPath)
+		}
+		metadata.Name = filepath.Base(projectPath)
+		metadata.LanguageSpecific["metadata_source"] = buildFileName
+		extractor.RecordManifest(metadata, buildPath)
+		return metadata, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := parseManifest(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", manifestFileName, err)
+	}
+
+	metadata.Name = manifest.Name
+	if manifest.Version != "" {
+		metadata.Version = manifest.Version
+		metadata.VersionSource = manifestFileName
+	}
+
+	metadata.LanguageSpecific["package_name"] = manifest.Name
+	metadata.LanguageSpecific["metadata_source"] = manifestFileName
+	if manifest.MinimumZigVersion != "" {
+		metadata.LanguageSpecific["minimum_zig_version"] = manifest.MinimumZigVersion
+	}
+	if len(manifest.Paths) > 0 {
+		metadata.LanguageSpecific["paths"] = manifest.Paths
+	}
+	if len(manifest.Dependencies) > 0 {
+		metadata.LanguageSpecific["dependencies"] = manifest.Dependencies
+		metadata.LanguageSpecific["dependency_count"] = len(manifest.Dependencies)
+	}
+
+	extractor.RecordManifest(metadata, manifestPath)
+
+	return metadata, nil
+}
+
+// parseManifest reads the top-level fields of a build.zig.zon document
+func parseManifest(content string) (*Manifest, error) {
+	p := &zonParser{input: content}
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	root, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected a struct literal at the top level")
+	}
+
+	manifest := &Manifest{
+		Name:              zonString(root["name"]),
+		Version:           zonString(root["version"]),
+		MinimumZigVersion: zonString(root["minimum_zig_version"]),
+	}
+
+	if paths, ok := root["paths"].([]interface{}); ok {
+		for _, path := range paths {
+			if pathStr := zonString(path); pathStr != "" {
+				manifest.Paths = append(manifest.Paths, pathStr)
+			}
+		}
+	}
+
+	if deps, ok := root["dependencies"].(map[string]interface{}); ok {
+		manifest.Dependencies = make(map[string]string, len(deps))
+		for name, dep := range deps {
+			fields, _ := dep.(map[string]interface{})
+			source := zonString(fields["url"])
+			if source == "" {
+				source = zonString(fields["path"])
+			}
+			manifest.Dependencies[name] = source
+		}
+	}
+
+	return manifest, nil
+}
+
+// zonString returns a string or enum literal value, or "" for others.
+// Zig 0.14 declares .name as an enum literal (.name = .my_pkg).
+func zonString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case zonEnumLiteral:
+		return string(v)
+	}
+	return ""
+}
+
+// zonEnumLiteral is an enum literal value such as .my_pkg
+type zonEnumLiteral string
+
+// zonParser parses the subset of ZON used by build.zig.zon: anonymous
+// struct and tuple literals, strings, enum literals, numbers and bare
+// identifiers such as true. Struct literals become maps, tuples slices;
+// numbers and identifiers are kept as their source text.
+type zonParser struct {
+	input string
+	pos   int
+}
+
+// parseValue parses the value at the current position
+func (p *zonParser) parseValue() (interface{}, error) {
+	p.skipSpace()
+	if p.pos >= len(p.input) {
+		return nil, fmt.Errorf("unexpected end of input")
+	}
+
+	switch c := p.input[p.pos]; {
+	case c == '"':
+		return p.parseString()
+	case c == '\\' && strings.HasPrefix(p.input[p.pos:], `\\`):
+		return p.parseMultilineString(), nil
+	case c == '.' && strings.HasPrefix(p.input[p.pos:], ".{"):
+		p.pos += 2
+		return p.parseContainer()
+	case c == '.':
+		p.pos++
+		name, err := p.parseIdentifier()
+		return zonEnumLiteral(name), err
+	default:
+		start := p.pos
+		for p.pos < len(p.input) && !strings.ContainsRune(" \t\r\n,}", rune(p.input[p.pos])) {
+			p.pos++
+		}
+		if start == p.pos {
+			return nil, fmt.Errorf("unexpected %q at offset %d", c, start)
+		}
+		return p.input[start:p.pos], nil
+	}
+}
+
+// parseContainer parses the body of a .{ } literal after the opening
+// brace. Fields (.name = value) make it a struct; bare values a tuple.
+func (p *zonParser) parseContainer() (interface{}, error) {
+	fields := make(map[string]interface{})
+	var items []interface{}
+
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.input) {
+			return nil, fmt.Errorf("unterminated literal")
+		}
+		if p.input[p.pos] == '}' {
+			p.pos++
+			break
+		}
+
+		if name, ok, err := p.parseFieldName(); err != nil {
+			return nil, err
+		} else if ok {
+			value, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			fields[name] = value
+		} else {
+			value, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, value)
+		}
+
+		p.skipSpace()
+		if p.pos < len(p.input) && p.input[p.pos] == ',' {
+			p.pos++
+		}
+	}
+
+	if len(items) > 0 {
+		return items, nil
+	}
+	return fields, nil
+}
+
+// parseFieldName consumes ".name =" or `.@"name" =` and reports whether
+// a field initializer was found; otherwise the position is unchanged
+func (p *zonParser) parseFieldName() (string, bool, error) {
+	start := p.pos
+	if p.input[p.pos] != '.' || strings.HasPrefix(p.input[p.pos:], ".{") {
+		return "", false, nil
+	}
+	p.pos++
+
+	var name string
+	var err error
+	if strings.HasPrefix(p.input[p.pos:], `@"`) {
+		p.pos++
+		name, err = p.parseString()
+	} else {
+		name, err = p.parseIdentifier()
+	}
+	if err != nil {
+		return "", false, err
+	}
+
+	p.skipSpace()
+	if p.pos >= len(p.input) || p.input[p.pos] != '=' {
+		// An enum literal inside a tuple
+		p.pos = start
+		return "", false, nil
+	}
+	p.pos++
+	return name, true, nil
+}
+
+// parseIdentifier consumes a Zig identifier, or an @"quoted" one
+func (p *zonParser) parseIdentifier() (string, error) {
+	if strings.HasPrefix(p.input[p.pos:], `@"`) {
+		p.pos++
+		return p.parseString()
+	}
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if c != '_' && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			break
+		}
+		p.pos++
+	}
+	if start == p.pos {
+		return "", fmt.Errorf("expected identifier at offset %d", start)
+	}
+	return p.input[start:p.pos], nil
+}
+
+// parseString consumes a double-quoted string with Zig escapes
+func (p *zonParser) parseString() (string, error) {
+	start := p.pos
+	p.pos++ // opening quote
+
+	var sb strings.Builder
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		switch {
+		case c == '"':
+			p.pos++
+			return sb.String(), nil
+		case c == '\\' && p.pos+1 < len(p.input):
+			p.pos++
+			switch escaped := p.input[p.pos]; escaped {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case 'r':
+				sb.WriteByte('\r')
+			default:
+				sb.WriteByte(escaped)
+			}
+		case c == '\n':
+			return "", fmt.Errorf("unterminated string at offset %d", start)
+		default:
+			sb.WriteByte(c)
+		}
+		p.pos++
+	}
+	return "", fmt.Errorf("unterminated string at offset %d", start)
+}
+
+// parseMultilineString consumes consecutive \\ string lines
+func (p *zonParser) parseMultilineString() string {
+	var lines []string
+	for {
+		p.skipSpace()
+		if !strings.HasPrefix(p.input[p.pos:], `\\`) {
+			break
+		}
+		end := strings.IndexByte(p.input[p.pos:], '\n')
+		if end < 0 {
+			end = len(p.input) - p.pos
+		}
+		lines = append(lines, p.input[p.pos+2:p.pos+end])
+		p.pos += end
+	}
+	return strings.Join(lines, "\n")
+}
+
+// skipSpace skips whitespace and // comments
+func (p *zonParser) skipSpace() {
+	for p.pos < len(p.input) {
+		switch {
+		case strings.ContainsRune(" \t\r\n", rune(p.input[p.pos])):
+			p.pos++
+		case strings.HasPrefix(p.input[p.pos:], "//"):
+			if end := strings.IndexByte(p.input[p.pos:], '\n'); end >= 0 {
+				p.pos += end
+			} else {
+				p.pos = len(p.input)
+			}
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package zig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleManifest = `// Package manifest
+.{
+    .name = .zigtool,
+    .version = "0.3.1",
+    .fingerprint = 0x9c2f1e4a7b3d5e61,
+    .minimum_zig_version = "0.14.0",
+    .dependencies = .{
+        .zap = .{
+            .url = "https://github.com/zigzap/zap/archive/refs/tags/v0.9.1.tar.gz",
+            .hash = "1220d4802fb09d4e99c0e7265f90d6f3cfdc3e5e31c1b05f0924ee2dd26d9d6dbbf",
+        },
+        .@"local-lib" = .{
+            .path = "../local-lib",
+            .lazy = true,
+        },
+    },
+    .paths = .{
+        "build.zig",
+        "build.zig.zon",
+        "src",
+    },
+}
+`
+
+func TestNewExtractor(t *testing.T) {
+	e := NewExtractor()
+	assert.NotNil(t, e)
+	assert.Equal(t, "zig", e.Name())
+	assert.Equal(t, 1, e.Priority())
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    []string
+		expected bool
+	}{
+		{name: "build.zig.zon", files: []string{"build.zig.zon"}, expected: true},
+		{name: "build.zig only", files: []string{"build.zig"}, expected: true},
+		{name: "no zig files", files: []string{"main.c"}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			for _, file := range tt.files {
+				require.NoError(t, os.WriteFile(filepath.Join(tmpDir, file), []byte(sampleManifest), 0644))
+			}
+			assert.Equal(t, tt.expected, NewExtractor().Detect(tmpDir))
+		})
+	}
+}
+
+func TestExtract_Manifest(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "build.zig.zon"), []byte(sampleManifest), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "build.zig"), []byte("const std = @import(\"std\");\n"), 0644))
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "zigtool", metadata.Name)
+	assert.Equal(t, "0.3.1", metadata.Version)
+	assert.Equal(t, "build.zig.zon", metadata.VersionSource)
+	assert.Equal(t, "build.zig.zon", metadata.LanguageSpecific["metadata_source"])
+	assert.Equal(t, "0.14.0", metadata.LanguageSpecific["minimum_zig_version"])
+	assert.Equal(t, []string{"build.zig", "build.zig.zon", "src"}, metadata.LanguageSpecific["paths"])
+	assert.Equal(t, map[string]string{
+		"zap":       "https://github.com/zigzap/zap/archive/refs/tags/v0.9.1.tar.gz",
+		"local-lib": "../local-lib",
+	}, metadata.LanguageSpecific["dependencies"])
+	assert.Equal(t, 2, metadata.LanguageSpecific["dependency_count"])
+}
+
+func TestExtract_StringName(t *testing.T) {
+	// Zig releases before 0.14 declare the name as a string
+	manifest := `.{ .name = "legacy", .version = "1.0.0", .dependencies = .{}, .paths = .{""} }`
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "build.zig.zon"), []byte(manifest), 0644))
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, "legacy", metadata.Name)
+	assert.Equal(t, "1.0.0", metadata.Version)
+	assert.NotContains(t, metadata.LanguageSpecific, "dependencies")
+}
+
+func TestExtract_BuildFileOnly(t *testing.T) {
+	tmpDir := filepath.Join(t.TempDir(), "zig-app")
+	require.NoError(t, os.Mkdir(tmpDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "build.zig"), []byte("const std = @import(\"std\");\n"), 0644))
+
+	metadata, err := NewExtractor().Extract(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, "zig-app", metadata.Name)
+	assert.Empty(t, metadata.Version)
+	assert.Equal(t, "build.zig", metadata.LanguageSpecific["metadata_source"])
+}
+
+func TestParseManifest_Invalid(t *testing.T) {
+	_, err := parseManifest(`.{ .name = "unterminated }`)
+	assert.Error(t, err)
+}
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+// Package git runs the git commands behind metadata enrichment (commit
+// author, tag versions, remotes, changed files) and caches the repository
+// facts they share, so that each project path is queried once per run.
+package git
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Runner runs git with args in dir and returns its standard output
+type Runner func(ctx context.Context, dir string, args ...string) (string, error)
+
+// ExecRunner runs the git binary
+func ExecRunner(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", dir}, args...)...)
+	output, err := cmd.Output()
+	return string(output), err
+}
+
+// RepoInfo holds the repository facts for a project path. Fields are
+// empty when git cannot provide them, e.g. outside a repository, before
+// the first commit, or when no tag is reachable.
+type RepoInfo struct {
+	TopLevel  string
+	HeadSHA   string
+	Branch    string // Empty for a detached HEAD
+	LatestTag string
+
+	// DefaultBranch is the branch origin/HEAD points at, e.g. "main".
+	// Empty without a remote, or when origin/HEAD was never set.
+	DefaultBranch string
+
+	// HEAD commit author and committer date (RFC3339)
+	Author     string
+	Email      string
+	CommitDate string
+}
+
+// Client runs git through a Runner and caches RepoInfo and remotes per
+// path
+type Client struct {
+	runner Runner
+
+	mu      sync.Mutex
+	cache   map[string]*RepoInfo
+	remotes map[string]map[string]string
+}
+
+// NewClient creates a Client using runner, or ExecRunner when nil
+func NewClient(runner Runner) *Client {
+	if runner == nil {
+		runner = ExecRunner
+	}
+	return &Client{
+		runner:  runner,
+		cache:   make(map[string]*RepoInfo),
+		remotes: make(map[string]map[string]string),
+	}
+}
+
+// defaultClient is shared by the enrichment features within a run
+var defaultClient = NewClient(nil)
+
+// Default returns the Client shared within a run
+func Default() *Client {
+	return defaultClient
+}
+
+// Run runs an uncached git command in dir, for queries that depend on
+// more than the path, such as diffs between revisions
+func (c *Client) Run(ctx context.Context, dir string, args ...string) (string, error) {
+	return c.runner(ctx, dir, args...)
+}
+
+// Info returns the repository facts for path, running git on the first
+// call only. The returned RepoInfo is never nil and must not be modified.
+// Results from a cancelled or expired ctx are not cached.
+func (c *Client) Info(ctx context.Context, path string) *RepoInfo {
+	key := cacheKey(path)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if info, ok := c.cache[key]; ok {
+		return info
+	}
+
+	info := c.query(ctx, path)
+	if ctx.Err() == nil {
+		c.cache[key] = info
+	}
+	return info
+}
+
+// Remotes returns the fetch URL of each remote of the repository at path,
+// keyed by remote name, running git on the first call only. The map is
+// empty outside a repository and must not be modified. Results from a
+// cancelled or expired ctx are not cached.
+func (c *Client) Remotes(ctx context.Context, path string) map[string]string {
+	key := cacheKey(path)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if remotes, ok := c.remotes[key]; ok {
+		return remotes
+	}
+
+	remotes := make(map[string]string)
+	if output, err := c.runner(ctx, path, "remote", "-v"); err == nil {
+		for _, line := range strings.Split(output, "\n") {
+			fields := strings.Fields(line)
+			if len(fields) >= 3 && fields[2] == "(fetch)" {
+				remotes[fields[0]] = fields[1]
+			}
+		}
+	}
+	if ctx.Err() == nil {
+		c.remotes[key] = remotes
+	}
+	return remotes
+}
+
+// cacheKey returns the absolute form of path, so relative and absolute
+// spellings of a directory share a cache entry
+func cacheKey(path string) string {
+	if absPath, err := filepath.Abs(path); err == nil {
+		return absPath
+	}
+	return path
+}
+
+// query runs the git commands behind Info
+func (c *Client) query(ctx context.Context, path string) *RepoInfo {
+	info := &RepoInfo{}
+
+	output, err := c.runner(ctx, path, "rev-parse", "--show-toplevel")
+	if err != nil {
+		// Not a repository, or git is unavailable
+		return info
+	}
+	info.TopLevel = strings.TrimSpace(output)
+
+	// NUL separators keep names containing spaces or commas intact
+	output, err = c.runner(ctx, path, "log", "-1", "--format=%H%x00%an%x00%ae%x00%cI")
+	if err != nil {
+		// No commits yet
+		return info
+	}
+	if fields := strings.Split(strings.TrimSpace(output), "\x00"); len(fields) == 4 {
+		info.HeadSHA = fields[0]
+		info.Author = fields[1]
+		info.Email = fields[2]
+		if date, err := time.Parse(time.RFC3339, fields[3]); err == nil {
+			info.CommitDate = date.Format(time.RFC3339)
+		}
+	}
+
+	if output, err := c.runner(ctx, path, "rev-parse", "--abbrev-ref", "HEAD"); err == nil {
+		if branch := strings.TrimSpace(output); branch != "HEAD" {
+			info.Branch = branch
+		}
+	}
+
+	if output, err := c.runner(ctx, path, "describe", "--tags", "--abbrev=0"); err == nil {
+		info.LatestTag = strings.TrimSpace(output)
+	}
+
+	if output, err := c.runner(ctx, path, "symbolic-ref", "--short", "refs/remotes/origin/HEAD"); err == nil {
+		info.DefaultBranch = strings.TrimPrefix(strings.TrimSpace(output), "origin/")
+	}
+
+	return info
+}
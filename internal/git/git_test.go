@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package git
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// fakeRunner answers git commands from canned output and counts calls
+type fakeRunner struct {
+	responses map[string]string
+	calls     int
+}
+
+func (f *fakeRunner) run(_ context.Context, _ string, args ...string) (string, error) {
+	f.calls++
+	output, ok := f.responses[strings.Join(args, " ")]
+	if !ok {
+		return "", errors.New("exit status 128")
+	}
+	return output, nil
+}
+
+func TestClient_Info_Cached(t *testing.T) {
+	runner := &fakeRunner{responses: map[string]string{
+		"rev-parse --show-toplevel":                     "/repo\n",
+		"log -1 --format=%H%x00%an%x00%ae%x00%cI":       "abc123\x00Ada Lovelace\x00ada@example.com\x002024-03-01T12:00:00+00:00\n",
+		"rev-parse --abbrev-ref HEAD":                   "main\n",
+		"describe --tags --abbrev=0":                    "v1.2.0\n",
+		"symbolic-ref --short refs/remotes/origin/HEAD": "origin/main\n",
+	}}
+	client := NewClient(runner.run)
+
+	expected := &RepoInfo{
+		TopLevel:      "/repo",
+		HeadSHA:       "abc123",
+		Branch:        "main",
+		LatestTag:     "v1.2.0",
+		DefaultBranch: "main",
+		Author:        "Ada Lovelace",
+		Email:         "ada@example.com",
+		CommitDate:    "2024-03-01T12:00:00Z",
+	}
+
+	first := client.Info(context.Background(), "/repo/sub")
+	if !reflect.DeepEqual(first, expected) {
+		t.Errorf("Info() = %+v, want %+v", first, expected)
+	}
+	if runner.calls != 5 {
+		t.Errorf("first Info() ran git %d times, want 5", runner.calls)
+	}
+
+	second := client.Info(context.Background(), "/repo/sub/../sub")
+	if second != first {
+		t.Errorf("second Info() = %+v, want the cached %+v", second, first)
+	}
+	if runner.calls != 5 {
+		t.Errorf("cached Info() ran git again: %d calls, want 5", runner.calls)
+	}
+
+	client.Info(context.Background(), "/repo")
+	if runner.calls != 10 {
+		t.Errorf("Info() for another path ran git %d times in total, want 10", runner.calls)
+	}
+}
+
+func TestClient_Info_NotRepository(t *testing.T) {
+	runner := &fakeRunner{}
+	client := NewClient(runner.run)
+
+	if info := client.Info(context.Background(), "/tmp/project"); !reflect.DeepEqual(info, &RepoInfo{}) {
+		t.Errorf("Info() = %+v, want empty", info)
+	}
+	if runner.calls != 1 {
+		t.Errorf("Info() outside a repository ran git %d times, want 1", runner.calls)
+	}
+}
+
+func TestClient_Info_CancelledContextNotCached(t *testing.T) {
+	runner := &fakeRunner{}
+	client := NewClient(runner.run)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	client.Info(ctx, "/repo")
+	client.Info(context.Background(), "/repo")
+	if runner.calls != 2 {
+		t.Errorf("Info() ran git %d times, want 2 after a cancelled lookup", runner.calls)
+	}
+}
+
+func TestClient_Remotes_Cached(t *testing.T) {
+	runner := &fakeRunner{responses: map[string]string{
+		"remote -v": "origin\tgit@github.com:fork/repo.git (fetch)\n" +
+			"origin\tgit@github.com:fork/repo.git (push)\n" +
+			"upstream\thttps://github.com/org/repo.git (fetch)\n" +
+			"upstream\tno-push (push)\n",
+	}}
+	client := NewClient(runner.run)
+
+	expected := map[string]string{
+		"origin":   "git@github.com:fork/repo.git",
+		"upstream": "https://github.com/org/repo.git",
+	}
+	if remotes := client.Remotes(context.Background(), "/repo"); !reflect.DeepEqual(remotes, expected) {
+		t.Errorf("Remotes() = %v, want %v", remotes, expected)
+	}
+	client.Remotes(context.Background(), "/repo/../repo")
+	if runner.calls != 1 {
+		t.Errorf("Remotes() ran git %d times, want 1", runner.calls)
+	}
+
+	if remotes := NewClient((&fakeRunner{}).run).Remotes(context.Background(), "/tmp/project"); len(remotes) != 0 {
+		t.Errorf("Remotes() outside a repository = %v, want empty", remotes)
+	}
+}
+
+func TestClient_Info_Repository(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-q", "-b", "main"},
+		{"-c", "user.name=Ada Lovelace", "-c", "user.email=ada@example.com",
+			"commit", "-q", "--allow-empty", "-m", "initial"},
+		{"tag", "v0.1.0"},
+	} {
+		cmd := exec.Command("git", append([]string{"-C", dir, "-c", "commit.gpgsign=false", "-c", "tag.gpgsign=false"}, args...)...)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, output)
+		}
+	}
+
+	info := NewClient(nil).Info(context.Background(), dir)
+	if info.TopLevel == "" || len(info.HeadSHA) != 40 {
+		t.Errorf("Info() = %+v, want toplevel and a full HEAD sha", info)
+	}
+	if info.Branch != "main" || info.LatestTag != "v0.1.0" || info.Author != "Ada Lovelace" {
+		t.Errorf("Info() = %+v, want branch main, tag v0.1.0 and author Ada Lovelace", info)
+	}
+}
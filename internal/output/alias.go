@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseFieldAliases parses "from=to" pairs separated by commas, spaces or
+// newlines into an alias map
+func ParseFieldAliases(input string) (map[string]string, error) {
+	aliases := make(map[string]string)
+
+	normalized := strings.NewReplacer(",", " ", "\n", " ").Replace(input)
+	for _, pair := range strings.Fields(normalized) {
+		from, to, ok := strings.Cut(pair, "=")
+		from = strings.TrimSpace(from)
+		to = strings.TrimSpace(to)
+		if !ok || from == "" || to == "" {
+			return nil, fmt.Errorf("invalid field alias %q: expected from=to", pair)
+		}
+		aliases[from] = to
+	}
+
+	return aliases, nil
+}
+
+// ApplyFieldAliases renames top-level and common keys for rendering.
+// The metadata itself is not modified; when no aliases are given it is
+// returned unchanged.
+func ApplyFieldAliases(metadata interface{}, aliases map[string]string) interface{} {
+	if len(aliases) == 0 {
+		return metadata
+	}
+
+	metadataMap := renameKeys(convertToMap(metadata), aliases)
+	if common, ok := metadataMap[aliasedKey("common", aliases)].(map[string]interface{}); ok {
+		metadataMap[aliasedKey("common", aliases)] = renameKeys(common, aliases)
+	}
+
+	return metadataMap
+}
+
+// renameKeys returns a copy of m with aliased keys renamed
+func renameKeys(m map[string]interface{}, aliases map[string]string) map[string]interface{} {
+	renamed := make(map[string]interface{}, len(m))
+	for key, value := range m {
+		renamed[aliasedKey(key, aliases)] = value
+	}
+	return renamed
+}
+
+// aliasedKey returns the alias for key, or key itself
+func aliasedKey(key string, aliases map[string]string) string {
+	if alias, ok := aliases[key]; ok {
+		return alias
+	}
+	return key
+}
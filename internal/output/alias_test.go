@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestApplyFieldAliases_JSON tests rendering JSON with aliased common fields
+func TestApplyFieldAliases_JSON(t *testing.T) {
+	metadata := map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_name":    "example",
+			"project_version": "1.2.3",
+			"project_type":    "go-module",
+		},
+	}
+
+	aliases, err := ParseFieldAliases("project_name=name, project_version=version")
+	if err != nil {
+		t.Fatalf("ParseFieldAliases failed: %v", err)
+	}
+
+	jsonStr, err := GetMetadataJSON(ApplyFieldAliases(metadata, aliases), true)
+	if err != nil {
+		t.Fatalf("GetMetadataJSON failed: %v", err)
+	}
+
+	var result map[string]map[string]interface{}
+	if err := json.Unmarshal([]byte(jsonStr), &result); err != nil {
+		t.Fatalf("Failed to parse JSON: %v", err)
+	}
+
+	common := result["common"]
+	if common["name"] != "example" {
+		t.Errorf("name = %v, want example", common["name"])
+	}
+	if common["version"] != "1.2.3" {
+		t.Errorf("version = %v, want 1.2.3", common["version"])
+	}
+	if common["project_type"] != "go-module" {
+		t.Errorf("project_type = %v, want go-module", common["project_type"])
+	}
+	if _, ok := common["project_name"]; ok {
+		t.Error("project_name should be renamed")
+	}
+
+	// The source metadata is not modified
+	if _, ok := metadata["common"].(map[string]interface{})["project_name"]; !ok {
+		t.Error("Aliases should only apply at render time")
+	}
+}
+
+// TestParseFieldAliases_Invalid tests rejecting malformed alias pairs
+func TestParseFieldAliases_Invalid(t *testing.T) {
+	for _, input := range []string{"project_name", "=name", "project_name="} {
+		if _, err := ParseFieldAliases(input); err == nil {
+			t.Errorf("ParseFieldAliases(%q) should fail", input)
+		}
+	}
+}
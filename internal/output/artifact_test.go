@@ -10,6 +10,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/lfreleng-actions/build-metadata-action/internal/environment"
 	"gopkg.in/yaml.v3"
 )
 
@@ -494,6 +495,59 @@ func TestGetMetadataJSON_WithValidation(t *testing.T) {
 	}
 }
 
+// TestGetMetadataJSON_UnfilteredTools tests that structured output keeps
+// every detected tool while the summary shows only the relevant ones
+func TestGetMetadataJSON_UnfilteredTools(t *testing.T) {
+	metadata := struct {
+		Common      map[string]interface{} `json:"common"`
+		Environment environment.Metadata   `json:"environment"`
+	}{
+		Common: map[string]interface{}{
+			"project_type": "go-module",
+			"project_name": "tools-test",
+		},
+		Environment: environment.Metadata{
+			Tools: map[string]string{
+				"go":      "1.22.0",
+				"git":     "2.43.0",
+				"python3": "3.12.1",
+			},
+		},
+	}
+
+	summary := GenerateSummary(metadata)
+	if strings.Contains(summary, "2.43.0") || strings.Contains(summary, "3.12.1") {
+		t.Errorf("Summary should filter out git and python3 for a Go project:\n%s", summary)
+	}
+
+	jsonStr, err := GetMetadataJSON(metadata, false)
+	if err != nil {
+		t.Fatalf("GetMetadataJSON failed: %v", err)
+	}
+
+	var parsed struct {
+		Environment struct {
+			Tools map[string]string `json:"tools"`
+		} `json:"environment"`
+	}
+	if err := json.Unmarshal([]byte(jsonStr), &parsed); err != nil {
+		t.Fatalf("Generated JSON is not valid: %v", err)
+	}
+	for tool, version := range map[string]string{"go": "1.22.0", "git": "2.43.0", "python3": "3.12.1"} {
+		if parsed.Environment.Tools[tool] != version {
+			t.Errorf("JSON tools[%s] = %q, want %q", tool, parsed.Environment.Tools[tool], version)
+		}
+	}
+
+	yamlStr, err := GetMetadataYAML(metadata, false)
+	if err != nil {
+		t.Fatalf("GetMetadataYAML failed: %v", err)
+	}
+	if !strings.Contains(yamlStr, "git: 2.43.0") || !strings.Contains(yamlStr, "python3: 3.12.1") {
+		t.Errorf("YAML should include git and python3 tool versions:\n%s", yamlStr)
+	}
+}
+
 // TestGetMetadataYAML tests YAML string generation
 func TestGetMetadataYAML(t *testing.T) {
 	metadata := map[string]interface{}{
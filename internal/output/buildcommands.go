@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BuildCommands returns the canonical install, build and test commands for
+// a project type as the detector reports it (for example "helm-chart" or
+// "terraform-module"). JavaScript and PHP commands are derived from the
+// project's declared scripts when they are known. Unknown project types
+// return nil.
+func BuildCommands(projectType string, langSpecific map[string]interface{}) []string {
+	switch {
+	case strings.HasPrefix(projectType, "python"):
+		return []string{"pip install -e .", "pytest"}
+
+	case strings.HasPrefix(projectType, "javascript") || strings.HasPrefix(projectType, "typescript"):
+		return javascriptBuildCommands(langSpecific)
+
+	case projectType == "java-maven":
+		return []string{"mvn -B package", "mvn -B test"}
+
+	case strings.HasPrefix(projectType, "java-gradle") || projectType == "kotlin-gradle":
+		return []string{"./gradlew build", "./gradlew test"}
+
+	case strings.HasPrefix(projectType, "csharp") || strings.HasPrefix(projectType, "dotnet"):
+		return []string{"dotnet build", "dotnet test"}
+
+	case projectType == "go-module" || projectType == "go-workspace":
+		return []string{"go build ./...", "go test ./..."}
+
+	case projectType == "rust-cargo":
+		return []string{"cargo build", "cargo test"}
+
+	case strings.HasPrefix(projectType, "ruby"):
+		return []string{"bundle install", "bundle exec rake"}
+
+	case projectType == "php-composer":
+		return phpBuildCommands(langSpecific)
+
+	case projectType == "swift-package":
+		return []string{"swift build", "swift test"}
+
+	case projectType == "dart-flutter":
+		if isFlutter, _ := langSpecific["is_flutter"].(bool); isFlutter {
+			return []string{"flutter pub get", "flutter test"}
+		}
+		return []string{"dart pub get", "dart test"}
+
+	case projectType == "terraform-opentofu":
+		return []string{"tofu init", "tofu validate"}
+
+	case strings.HasPrefix(projectType, "terraform"):
+		return []string{"terraform init", "terraform validate"}
+
+	case strings.HasPrefix(projectType, "docker"):
+		return []string{"docker build ."}
+
+	case strings.HasPrefix(projectType, "helm"):
+		return []string{"helm lint .", "helm package ."}
+
+	case strings.HasPrefix(projectType, "openapi"):
+		return []string{"npx @redocly/cli lint"}
+
+	case projectType == "c-cmake":
+		return []string{"cmake -B build", "cmake --build build", "ctest --test-dir build"}
+
+	case projectType == "c-qmake":
+		return []string{"qmake", "make"}
+
+	case strings.HasPrefix(projectType, "c-autoconf"):
+		return []string{"./configure", "make", "make check"}
+
+	case projectType == "c-meson":
+		return []string{"meson setup build", "meson compile -C build", "meson test -C build"}
+
+	case projectType == "c-conan":
+		return []string{"conan install . --build=missing", "conan build ."}
+
+	case strings.HasPrefix(projectType, "elixir"):
+		return []string{"mix deps.get", "mix compile", "mix test"}
+
+	case strings.HasPrefix(projectType, "scala"):
+		return []string{"sbt compile", "sbt test"}
+
+	case strings.HasPrefix(projectType, "haskell"):
+		return []string{"cabal build", "cabal test"}
+
+	case strings.HasPrefix(projectType, "julia"):
+		return []string{
+			`julia --project -e 'using Pkg; Pkg.instantiate()'`,
+			`julia --project -e 'using Pkg; Pkg.test()'`,
+		}
+
+	case projectType == "clojure-leiningen":
+		return []string{"lein deps", "lein test"}
+
+	case projectType == "clojure-deps":
+		return []string{"clojure -P", "clojure -X:test"}
+
+	case strings.HasPrefix(projectType, "erlang"):
+		return []string{"rebar3 compile", "rebar3 eunit"}
+
+	case projectType == "nim-nimble":
+		return []string{"nimble build", "nimble test"}
+
+	case projectType == "r-package":
+		return []string{"R CMD build .", "R CMD check *.tar.gz"}
+
+	case projectType == "perl-cpan":
+		return []string{"perl Makefile.PL", "make", "make test"}
+
+	case projectType == "perl-module-build":
+		return []string{"perl Build.PL", "./Build", "./Build test"}
+
+	case projectType == "perl-cpanfile":
+		return []string{"cpanm --installdeps .", "prove -l t"}
+
+	case strings.HasPrefix(projectType, "zig"):
+		return []string{"zig build", "zig build test"}
+	}
+	return nil
+}
+
+// javascriptBuildCommands installs with the detected package manager and
+// runs the build, test and lint scripts the package declares
+func javascriptBuildCommands(langSpecific map[string]interface{}) []string {
+	manager, _ := langSpecific["package_manager"].(string)
+	switch manager {
+	case "", "unknown":
+		manager = "npm"
+	case "yarn-berry":
+		manager = "yarn"
+	}
+
+	scripts, known := scriptNames(langSpecific)
+	if !known {
+		return []string{manager + " install", manager + " test"}
+	}
+
+	commands := []string{manager + " install"}
+	for _, script := range []string{"build", "test", "lint"} {
+		if !scripts[script] {
+			continue
+		}
+		if script == "test" {
+			commands = append(commands, manager+" test")
+		} else {
+			commands = append(commands, fmt.Sprintf("%s run %s", manager, script))
+		}
+	}
+	return commands
+}
+
+// phpBuildCommands installs with Composer and runs the test and lint
+// scripts composer.json declares, falling back to PHPUnit
+func phpBuildCommands(langSpecific map[string]interface{}) []string {
+	commands := []string{"composer install"}
+	scripts, known := scriptNames(langSpecific)
+	if !known {
+		return append(commands, "composer test")
+	}
+
+	if scripts["test"] {
+		commands = append(commands, "composer test")
+	} else {
+		commands = append(commands, "vendor/bin/phpunit")
+	}
+	for _, script := range []string{"lint", "analyse", "cs"} {
+		if scripts[script] {
+			commands = append(commands, "composer "+script)
+		}
+	}
+	return commands
+}
+
+// scriptNames returns the set of declared script names, and whether the
+// metadata lists scripts at all
+func scriptNames(langSpecific map[string]interface{}) (map[string]bool, bool) {
+	names := make(map[string]bool)
+	switch scripts := langSpecific["scripts"].(type) {
+	case []interface{}:
+		for _, script := range scripts {
+			if name, ok := script.(string); ok {
+				names[name] = true
+			}
+		}
+	case []string:
+		for _, name := range scripts {
+			names[name] = true
+		}
+	default:
+		return names, false
+	}
+	return names, true
+}
+
+// buildCommandsSection renders the build commands as a shell code block
+// below the Project Information table
+func buildCommandsSection(commands []string) string {
+	if len(commands) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("### 🛠️ Build Commands\n\n")
+	sb.WriteString("```sh\n")
+	for _, command := range commands {
+		sb.WriteString(command + "\n")
+	}
+	sb.WriteString("```\n\n")
+	return sb.String()
+}
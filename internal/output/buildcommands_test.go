@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/detector"
+)
+
+// TestBuildCommands tests the commands suggested per project type
+func TestBuildCommands(t *testing.T) {
+	tests := []struct {
+		name         string
+		projectType  string
+		langSpecific map[string]interface{}
+		expected     []string
+	}{
+		{
+			name:        "python",
+			projectType: "python-modern",
+			expected:    []string{"pip install -e .", "pytest"},
+		},
+		{
+			name:        "go",
+			projectType: "go-module",
+			expected:    []string{"go build ./...", "go test ./..."},
+		},
+		{
+			name:        "php without scripts",
+			projectType: "php-composer",
+			expected:    []string{"composer install", "composer test"},
+		},
+		{
+			name:         "php with scripts",
+			projectType:  "php-composer",
+			langSpecific: map[string]interface{}{"scripts": []interface{}{"analyse", "cs", "post-install-cmd"}},
+			expected:     []string{"composer install", "vendor/bin/phpunit", "composer analyse", "composer cs"},
+		},
+		{
+			name:        "javascript with scripts",
+			projectType: "javascript-pnpm",
+			langSpecific: map[string]interface{}{
+				"package_manager": "pnpm",
+				"scripts":         []interface{}{"build", "lint", "test"},
+			},
+			expected: []string{"pnpm install", "pnpm run build", "pnpm test", "pnpm run lint"},
+		},
+		{
+			name:         "yarn berry without a test script",
+			projectType:  "javascript-yarn",
+			langSpecific: map[string]interface{}{"package_manager": "yarn-berry", "scripts": []string{"build"}},
+			expected:     []string{"yarn install", "yarn run build"},
+		},
+		{
+			name:         "flutter",
+			projectType:  "dart-flutter",
+			langSpecific: map[string]interface{}{"is_flutter": true},
+			expected:     []string{"flutter pub get", "flutter test"},
+		},
+		{
+			name:        "helm chart",
+			projectType: "helm-chart",
+			expected:    []string{"helm lint .", "helm package ."},
+		},
+		{
+			name:        "terraform module",
+			projectType: "terraform-module",
+			expected:    []string{"terraform init", "terraform validate"},
+		},
+		{
+			name:        "meson",
+			projectType: "c-meson",
+			expected:    []string{"meson setup build", "meson compile -C build", "meson test -C build"},
+		},
+		{
+			name:        "unknown",
+			projectType: "cobol-make",
+			expected:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildCommands(tt.projectType, tt.langSpecific)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("BuildCommands(%q) = %v, want %v", tt.projectType, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestBuildCommands_DetectorTypes tests that every project type the
+// detector can report has build commands
+func TestBuildCommands_DetectorTypes(t *testing.T) {
+	for _, rule := range detector.GetDetectionRules() {
+		projectType := (&detector.ProjectType{Type: rule.Type, Subtype: rule.Subtype}).String()
+		t.Run(projectType, func(t *testing.T) {
+			if commands := BuildCommands(projectType, nil); len(commands) == 0 {
+				t.Errorf("BuildCommands(%q) returned no commands", projectType)
+			}
+		})
+	}
+}
+
+// TestGenerateSummary_BuildCommands tests the optional Build Commands section
+func TestGenerateSummary_BuildCommands(t *testing.T) {
+	metadata := map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_type": "php-composer",
+			"project_name": "acme/tool",
+		},
+		"language_specific": map[string]interface{}{
+			"scripts": []string{"test", "lint"},
+		},
+	}
+
+	if summary := GenerateSummary(metadata); strings.Contains(summary, "Build Commands") {
+		t.Error("Build commands should only be shown when requested")
+	}
+
+	opts := DefaultSummaryOptions()
+	opts.ShowBuildCommands = true
+	summary := GenerateSummaryWithOptions(metadata, opts)
+	expected := "### 🛠️ Build Commands\n\n```sh\ncomposer install\ncomposer test\ncomposer lint\n```\n"
+	if !strings.Contains(summary, expected) {
+		t.Errorf("Should contain %s\nGot:\n%s", expected, summary)
+	}
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+// completenessFields are the common fields scored by CompletenessScore,
+// each worth an equal share of the 100 points
+var completenessFields = []string{
+	"project_name",
+	"project_version",
+	"license",
+	"project_match_repo",
+	"description",
+}
+
+// CompletenessScore rates how complete the collected metadata is from 0
+// to 100, based on the presence of the project name, version, license,
+// description and a project name matching the repository. It also
+// returns the fields that are missing, in scoring order.
+func CompletenessScore(metadata interface{}) (int, []string) {
+	common, _ := convertToMap(metadata)["common"].(map[string]interface{})
+
+	missing := make([]string, 0)
+	for _, field := range completenessFields {
+		if !hasCompletenessField(common, field) {
+			missing = append(missing, field)
+		}
+	}
+	present := len(completenessFields) - len(missing)
+	return present * 100 / len(completenessFields), missing
+}
+
+// hasCompletenessField reports whether a scored field is populated
+func hasCompletenessField(common map[string]interface{}, field string) bool {
+	switch field {
+	case "project_match_repo":
+		// A mismatched or unknown repository counts as missing
+		match, _ := common[field].(bool)
+		return match
+	case "license":
+		license, _ := common["license"].(string)
+		spdx, _ := common["license_spdx"].(string)
+		return license != "" || spdx != ""
+	default:
+		value, _ := common[field].(string)
+		return value != ""
+	}
+}
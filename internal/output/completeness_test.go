@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestCompletenessScore tests scoring fully populated and sparse metadata
+func TestCompletenessScore(t *testing.T) {
+	tests := []struct {
+		name        string
+		common      map[string]interface{}
+		wantScore   int
+		wantMissing []string
+	}{
+		{
+			name: "fully populated",
+			common: map[string]interface{}{
+				"project_name":       "my-tool",
+				"project_version":    "1.2.3",
+				"license_spdx":       "Apache-2.0",
+				"project_match_repo": true,
+				"description":        "A tool",
+			},
+			wantScore:   100,
+			wantMissing: []string{},
+		},
+		{
+			name: "sparse",
+			common: map[string]interface{}{
+				"project_name":       "my-tool",
+				"project_match_repo": false,
+			},
+			wantScore:   20,
+			wantMissing: []string{"project_version", "license", "project_match_repo", "description"},
+		},
+		{
+			name:        "empty",
+			common:      map[string]interface{}{},
+			wantScore:   0,
+			wantMissing: []string{"project_name", "project_version", "license", "project_match_repo", "description"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, missing := CompletenessScore(map[string]interface{}{"common": tt.common})
+			if score != tt.wantScore {
+				t.Errorf("score = %d, want %d", score, tt.wantScore)
+			}
+			if !reflect.DeepEqual(missing, tt.wantMissing) {
+				t.Errorf("missing = %v, want %v", missing, tt.wantMissing)
+			}
+		})
+	}
+}
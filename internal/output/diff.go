@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Change kinds reported by Diff
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeChanged = "changed"
+)
+
+// diffSections are the top-level metadata sections compared by Diff
+var diffSections = []string{"common", "language_specific"}
+
+// diffIgnoredFields change on every run and would drown out real changes
+var diffIgnoredFields = map[string]bool{
+	"common.build_timestamp":         true,
+	"common.project_path":            true,
+	"common.git_sha":                 true,
+	"common.git_branch":              true,
+	"common.git_commit_author":       true,
+	"common.git_commit_email":        true,
+	"common.git_commit_date":         true,
+	"common.files_changed_since_tag": true,
+}
+
+// Diff compares two metadata snapshots, e.g. from the base branch and a
+// pull request, and renders the changed common and language-specific
+// fields as a Markdown table suitable for a PR comment. Map values such
+// as dependencies are compared key by key so that individual additions
+// and removals are listed.
+func Diff(oldMetadata, newMetadata interface{}) string {
+	before := flattenForDiff(convertToMap(oldMetadata))
+	after := flattenForDiff(convertToMap(newMetadata))
+
+	fields := make(map[string]string, len(before)+len(after))
+	for field := range before {
+		fields[field] = ""
+	}
+	for field := range after {
+		fields[field] = ""
+	}
+
+	var rows strings.Builder
+	for _, field := range sortMapKeys(fields) {
+		oldValue, inOld := before[field]
+		newValue, inNew := after[field]
+
+		var change string
+		switch {
+		case inOld && !inNew:
+			change = ChangeRemoved
+		case !inOld && inNew:
+			change = ChangeAdded
+		case oldValue != newValue:
+			change = ChangeChanged
+		default:
+			continue
+		}
+		rows.WriteString(fmt.Sprintf("| `%s` | %s | %s | %s |\n",
+			field, change, diffCell(oldValue), diffCell(newValue)))
+	}
+
+	var sb strings.Builder
+	sb.WriteString("## Metadata Changes\n\n")
+	if rows.Len() == 0 {
+		sb.WriteString("No metadata changes\n")
+		return sb.String()
+	}
+	sb.WriteString("| Field | Change | Before | After |\n")
+	sb.WriteString("|-------|--------|--------|-------|\n")
+	sb.WriteString(rows.String())
+	return sb.String()
+}
+
+// flattenForDiff maps "section.key" (and "section.key.subkey" for map
+// values) to a display string for every compared field
+func flattenForDiff(metadataMap map[string]interface{}) map[string]string {
+	fields := make(map[string]string)
+	for _, section := range diffSections {
+		values, ok := metadataMap[section].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for key, value := range values {
+			field := section + "." + key
+			if diffIgnoredFields[field] {
+				continue
+			}
+			if nested, ok := value.(map[string]interface{}); ok {
+				for subKey, subValue := range nested {
+					fields[field+"."+subKey] = formatDiffValue(subValue)
+				}
+				continue
+			}
+			fields[field] = formatDiffValue(value)
+		}
+	}
+	return fields
+}
+
+// formatDiffValue renders a decoded JSON value for comparison and display
+func formatDiffValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, formatDiffValue(item))
+		}
+		return strings.Join(items, ", ")
+	case map[string]interface{}:
+		jsonBytes, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(jsonBytes)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// diffCell formats a value for a Markdown table cell
+func diffCell(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return "`" + strings.ReplaceAll(value, "|", "\\|") + "`"
+}
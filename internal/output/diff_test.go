@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestDiff tests a version bump, a new dependency and a matrix change
+func TestDiff(t *testing.T) {
+	base := map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_name":    "example",
+			"project_version": "1.2.0",
+			"build_timestamp": "2025-01-01T00:00:00Z",
+		},
+		"language_specific": map[string]interface{}{
+			"dependencies": map[string]interface{}{
+				"requests": ">=2.31",
+				"urllib3":  ">=2.0",
+			},
+			"python_version_matrix": []interface{}{"3.10", "3.11"},
+		},
+	}
+	pr := map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_name":    "example",
+			"project_version": "1.3.0",
+			"build_timestamp": "2025-02-01T00:00:00Z",
+		},
+		"language_specific": map[string]interface{}{
+			"dependencies": map[string]interface{}{
+				"requests": ">=2.31",
+				"click":    ">=8.0",
+			},
+			"python_version_matrix": []interface{}{"3.10", "3.11", "3.12"},
+		},
+	}
+
+	diff := Diff(base, pr)
+
+	expected := []string{
+		"| `common.project_version` | changed | `1.2.0` | `1.3.0` |",
+		"| `language_specific.dependencies.click` | added | - | `>=8.0` |",
+		"| `language_specific.dependencies.urllib3` | removed | `>=2.0` | - |",
+		"| `language_specific.python_version_matrix` | changed | `3.10, 3.11` | `3.10, 3.11, 3.12` |",
+	}
+	for _, row := range expected {
+		if !strings.Contains(diff, row) {
+			t.Errorf("Diff should contain %q\nGot: %s", row, diff)
+		}
+	}
+
+	for _, unexpected := range []string{"project_name", "requests", "build_timestamp"} {
+		if strings.Contains(diff, unexpected) {
+			t.Errorf("Diff should not mention unchanged field %q\nGot: %s", unexpected, diff)
+		}
+	}
+
+	// Rows are sorted by field name
+	if strings.Index(diff, "dependencies.click") > strings.Index(diff, "dependencies.urllib3") {
+		t.Errorf("Diff rows should be sorted\nGot: %s", diff)
+	}
+}
+
+// TestDiff_NoChanges tests identical snapshots
+func TestDiff_NoChanges(t *testing.T) {
+	metadata := map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_name":    "example",
+			"project_version": "1.2.0",
+		},
+	}
+
+	diff := Diff(metadata, metadata)
+	if !strings.Contains(diff, "No metadata changes") {
+		t.Errorf("Diff of identical metadata should report no changes\nGot: %s", diff)
+	}
+}
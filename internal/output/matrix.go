@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/extractor"
+)
+
+// GenerateMatrixJSON returns the normalized version matrix of metadata,
+// e.g. {"version":["8.2"],"include":[...]}, for piping to jq. It fails
+// when no matrix was generated for the project type.
+func GenerateMatrixJSON(metadata interface{}) (string, error) {
+	metadataMap := convertToMap(metadata)
+	common, _ := metadataMap["common"].(map[string]interface{})
+	langSpecific, _ := metadataMap["language_specific"].(map[string]interface{})
+
+	projectType, _ := common["project_type"].(string)
+	if projectType == "" {
+		projectType = "unknown"
+	}
+	matrix := extractor.NormalizeMetadataMatrix(langSpecific)
+	if matrix == "" {
+		return "", fmt.Errorf("no version matrix could be generated for project type %s", projectType)
+	}
+	return matrix, nil
+}
+
+// ValidateMatrixJSON checks that matrixJSON is usable with fromJSON() in
+// strategy.matrix: a JSON object with a single "<language>-version" key
+// (or "version") holding a non-empty array of non-empty version strings.
+func ValidateMatrixJSON(matrixJSON string) error {
+	var matrix map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(matrixJSON), &matrix); err != nil {
+		return fmt.Errorf("matrix_json is not a JSON object: %w", err)
+	}
+
+	var versionKeys []string
+	for key := range matrix {
+		if extractor.IsMatrixVersionKey(key) {
+			versionKeys = append(versionKeys, key)
+		}
+	}
+	switch len(versionKeys) {
+	case 0:
+		return errors.New("matrix_json has no version key")
+	case 1:
+	default:
+		return fmt.Errorf("matrix_json has %d version keys, want 1", len(versionKeys))
+	}
+
+	key := versionKeys[0]
+	var versions []string
+	if err := json.Unmarshal(matrix[key], &versions); err != nil {
+		return fmt.Errorf("matrix_json %q is not an array of strings: %w", key, err)
+	}
+	if len(versions) == 0 {
+		return fmt.Errorf("matrix_json %q is empty", key)
+	}
+	for _, version := range versions {
+		if strings.TrimSpace(version) == "" {
+			return fmt.Errorf("matrix_json %q contains an empty version", key)
+		}
+	}
+	return nil
+}
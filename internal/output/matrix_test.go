@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestValidateMatrixJSON tests accepting generated matrices and rejecting
+// corrupted ones
+func TestValidateMatrixJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		matrix    string
+		wantError string
+	}{
+		{name: "python", matrix: `{"python-version": ["3.10", "3.11", "3.12"]}`},
+		{name: "compact dotnet", matrix: `{"dotnet-version":["8.0","9.0"]}`},
+		{name: "normalized key", matrix: `{"version": ["1.22"]}`},
+		{name: "truncated", matrix: `{"go-version": ["1.22", `, wantError: "not a JSON object"},
+		{name: "unquoted versions", matrix: `{"php-version": [8.1, 8.2]}`, wantError: "not an array of strings"},
+		{name: "array", matrix: `["3.12"]`, wantError: "not a JSON object"},
+		{name: "empty string", matrix: "", wantError: "not a JSON object"},
+		{name: "no version key", matrix: `{"os": ["ubuntu-latest"]}`, wantError: "no version key"},
+		{name: "empty versions", matrix: `{"rust-version": []}`, wantError: "is empty"},
+		{name: "blank version", matrix: `{"rust-version": ["1.75", ""]}`, wantError: "empty version"},
+		{name: "several version keys", matrix: `{"go-version": ["1.22"], "node-version": ["20"]}`, wantError: "2 version keys"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateMatrixJSON(tt.matrix)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Errorf("ValidateMatrixJSON(%q) error = %v", tt.matrix, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+				t.Errorf("ValidateMatrixJSON(%q) error = %v, want one containing %q", tt.matrix, err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestGenerateMatrixJSON(t *testing.T) {
+	metadata := map[string]interface{}{
+		"common": map[string]interface{}{"project_type": "go-module"},
+		"language_specific": map[string]interface{}{
+			"matrix_json": `{"go-version": ["1.22", "1.23"]}`,
+		},
+	}
+	matrix, err := GenerateMatrixJSON(metadata)
+	if err != nil {
+		t.Fatalf("GenerateMatrixJSON() error = %v", err)
+	}
+	if !strings.HasPrefix(matrix, `{"version":["1.22","1.23"]`) {
+		t.Errorf("GenerateMatrixJSON() = %s, want the normalized matrix", matrix)
+	}
+
+	scala := map[string]interface{}{
+		"common": map[string]interface{}{"project_type": "scala-sbt"},
+		"language_specific": map[string]interface{}{
+			"scala_version_matrix": []string{"2.13", "3.3"},
+		},
+	}
+	matrix, err = GenerateMatrixJSON(scala)
+	if err != nil {
+		t.Fatalf("GenerateMatrixJSON() error = %v for a version list", err)
+	}
+	if !strings.Contains(matrix, `{"scala-version":"3.3","version":"3.3"}`) {
+		t.Errorf("GenerateMatrixJSON() = %s, want the scala-version key", matrix)
+	}
+
+	noMatrix := map[string]interface{}{
+		"common": map[string]interface{}{"project_type": "docker"},
+	}
+	if _, err := GenerateMatrixJSON(noMatrix); err == nil || !strings.Contains(err.Error(), "project type docker") {
+		t.Errorf("GenerateMatrixJSON() error = %v, want one naming the project type", err)
+	}
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+
+	sarifToolName = "build-metadata-action"
+	sarifToolURI  = "https://github.com/lfreleng-actions/build-metadata-action"
+)
+
+// sarifRuleDescriptions describes each warning rule for the SARIF driver
+var sarifRuleDescriptions = map[string]string{
+	RuleProjectNameMismatch: "Project name does not match the repository name",
+	RulePackageNameMismatch: "Project name does not match the package name",
+	RuleEOLVersions:         "Build matrix includes end-of-life language versions",
+	RuleUnpinnedBaseImage:   "Container base image is not pinned to a version tag or digest",
+}
+
+// SARIF 2.1.0 document structure (subset used by this action)
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// GenerateSARIF renders the collected warnings as a SARIF 2.1.0 document
+func GenerateSARIF(metadata interface{}) (string, error) {
+	warnings := collectWarnings(convertToMap(metadata))
+
+	rules := make([]sarifRule, 0)
+	seenRules := make(map[string]bool)
+	results := make([]sarifResult, 0, len(warnings))
+
+	for _, warning := range warnings {
+		if !seenRules[warning.RuleID] {
+			seenRules[warning.RuleID] = true
+			rules = append(rules, sarifRule{
+				ID:               warning.RuleID,
+				ShortDescription: sarifMessage{Text: sarifRuleDescriptions[warning.RuleID]},
+			})
+		}
+
+		result := sarifResult{
+			RuleID:  warning.RuleID,
+			Level:   warning.Level,
+			Message: sarifMessage{Text: warning.Message},
+		}
+		if warning.File != "" {
+			result.Locations = []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: warning.File},
+				},
+			}}
+		}
+		results = append(results, result)
+	}
+
+	log := sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs: []sarifRun{{
+			Tool: sarifTool{
+				Driver: sarifDriver{
+					Name:           sarifToolName,
+					InformationURI: sarifToolURI,
+					Rules:          rules,
+				},
+			},
+			Results: results,
+		}},
+	}
+
+	sarifBytes, err := json.MarshalIndent(log, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal SARIF: %w", err)
+	}
+
+	return string(sarifBytes), nil
+}
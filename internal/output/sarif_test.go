@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestGenerateSARIF_OneResultPerWarning tests that each warning becomes a SARIF result
+func TestGenerateSARIF_OneResultPerWarning(t *testing.T) {
+	metadata := map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_type":       "docker",
+			"project_name":       "my-image",
+			"project_match_repo": false,
+		},
+		"language_specific": map[string]interface{}{
+			"metadata_source": "Dockerfile",
+			"base_images":     []string{"golang:1.22", "alpine", "builder", "debian:latest", "nginx@sha256:abc"},
+			"build_stages":    []string{"builder"},
+			"eol_versions":    "3.8",
+		},
+	}
+
+	sarif, err := GenerateSARIF(metadata)
+	if err != nil {
+		t.Fatalf("GenerateSARIF failed: %v", err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal([]byte(sarif), &log); err != nil {
+		t.Fatalf("Failed to parse SARIF: %v", err)
+	}
+
+	if log.Version != "2.1.0" {
+		t.Errorf("Version = %v, want 2.1.0", log.Version)
+	}
+	if len(log.Runs) != 1 {
+		t.Fatalf("Runs = %d, want 1", len(log.Runs))
+	}
+
+	expectedRules := []string{
+		RuleProjectNameMismatch,
+		RuleEOLVersions,
+		RuleUnpinnedBaseImage,
+		RuleUnpinnedBaseImage,
+	}
+	results := log.Runs[0].Results
+	if len(results) != len(expectedRules) {
+		t.Fatalf("Results = %d, want %d: %+v", len(results), len(expectedRules), results)
+	}
+	for i, ruleID := range expectedRules {
+		if results[i].RuleID != ruleID {
+			t.Errorf("Result %d ruleId = %v, want %v", i, results[i].RuleID, ruleID)
+		}
+		if results[i].Level != LevelWarning {
+			t.Errorf("Result %d level = %v, want %v", i, results[i].Level, LevelWarning)
+		}
+		if len(results[i].Locations) != 1 || results[i].Locations[0].PhysicalLocation.ArtifactLocation.URI != "Dockerfile" {
+			t.Errorf("Result %d should be located in Dockerfile: %+v", i, results[i].Locations)
+		}
+	}
+
+	if len(log.Runs[0].Tool.Driver.Rules) != 3 {
+		t.Errorf("Rules = %d, want 3 unique rules", len(log.Runs[0].Tool.Driver.Rules))
+	}
+}
+
+// TestGenerateSARIF_RepositoryRelativeLocation tests that locations use
+// the real manifest name below the project's repository subdirectory
+func TestGenerateSARIF_RepositoryRelativeLocation(t *testing.T) {
+	metadata := map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_type":          "docker",
+			"project_path_relative": "services/api",
+		},
+		"language_specific": map[string]interface{}{
+			"metadata_source": "api.dockerfile",
+			"base_images":     []string{"alpine"},
+		},
+	}
+
+	sarif, err := GenerateSARIF(metadata)
+	if err != nil {
+		t.Fatalf("GenerateSARIF failed: %v", err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal([]byte(sarif), &log); err != nil {
+		t.Fatalf("Failed to parse SARIF: %v", err)
+	}
+
+	results := log.Runs[0].Results
+	if len(results) != 1 {
+		t.Fatalf("Results = %d, want 1: %+v", len(results), results)
+	}
+	if len(results[0].Locations) != 1 {
+		t.Fatalf("Locations = %+v, want one", results[0].Locations)
+	}
+	if uri := results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI; uri != "services/api/api.dockerfile" {
+		t.Errorf("Location = %q, want services/api/api.dockerfile", uri)
+	}
+}
+
+// TestGenerateSARIF_NoWarnings tests a clean SARIF document
+func TestGenerateSARIF_NoWarnings(t *testing.T) {
+	metadata := map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_type":       "go-module",
+			"project_match_repo": true,
+		},
+	}
+
+	sarif, err := GenerateSARIF(metadata)
+	if err != nil {
+		t.Fatalf("GenerateSARIF failed: %v", err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal([]byte(sarif), &log); err != nil {
+		t.Fatalf("Failed to parse SARIF: %v", err)
+	}
+	if len(log.Runs[0].Results) != 0 {
+		t.Errorf("Results = %d, want 0", len(log.Runs[0].Results))
+	}
+}
+
+// TestIsUnpinnedImage tests base image pinning detection
+func TestIsUnpinnedImage(t *testing.T) {
+	tests := []struct {
+		image    string
+		unpinned bool
+	}{
+		{"alpine", true},
+		{"alpine:latest", true},
+		{"alpine:3.19", false},
+		{"registry.example.com:5000/app", true},
+		{"registry.example.com:5000/app:1.0", false},
+		{"nginx@sha256:abc", false},
+		{"scratch", false},
+		{"${BASE_IMAGE}", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			if got := isUnpinnedImage(tt.image); got != tt.unpinned {
+				t.Errorf("isUnpinnedImage(%q) = %v, want %v", tt.image, got, tt.unpinned)
+			}
+		})
+	}
+}
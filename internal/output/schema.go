@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+// metadataSchema is the JSON Schema for the metadata document produced by
+// GetMetadataJSON. Keys renamed with field_aliases are not covered.
+const metadataSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$id": "https://github.com/lfreleng-actions/build-metadata-action/metadata.schema.json",
+  "title": "Build metadata",
+  "type": "object",
+  "required": ["common", "environment", "build"],
+  "properties": {
+    "common": {
+      "type": "object",
+      "required": [
+        "project_type",
+        "project_name",
+        "project_version",
+        "project_path",
+        "version_source",
+        "versioning_type",
+        "build_timestamp"
+      ],
+      "properties": {
+        "project_type": {"type": "string"},
+        "extractor_name": {"type": "string"},
+        "primary_language": {"type": "string"},
+        "project_name": {"type": "string"},
+        "project_version": {"type": "string"},
+        "description": {"type": "string"},
+        "project_path": {"type": "string"},
+        "project_path_relative": {"type": "string"},
+        "version_source": {"type": "string"},
+        "versioning_type": {"enum": ["", "static", "dynamic"]},
+        "version_channel": {"enum": ["", "stable", "prerelease", "dev", "snapshot", "unknown"]},
+        "is_prerelease": {"type": "boolean"},
+        "build_timestamp": {"type": "string", "format": "date-time"},
+        "build_os": {"type": "string"},
+        "build_arch": {"type": "string"},
+        "git_sha": {"type": "string"},
+        "git_branch": {"type": "string"},
+        "is_default_branch": {"type": "boolean"},
+        "git_tag": {"type": "string"},
+        "git_commit_author": {"type": "string"},
+        "git_commit_email": {"type": "string"},
+        "git_commit_date": {"type": "string", "format": "date-time"},
+        "license": {"type": "string"},
+        "license_spdx": {"type": "string"},
+        "license_source": {"enum": ["", "manifest", "file"]},
+        "repository_name": {"type": "string"},
+        "project_match_repo": {"type": "boolean"},
+        "repository_slug": {"type": "string"},
+        "repository_provider": {"type": "string"},
+        "dependency_automation": {"type": "string"},
+        "extraction_warnings": {"type": "array", "items": {"type": "string"}},
+        "files_changed_since_tag": {"type": "integer", "minimum": 0},
+        "manifest_changed_since_tag": {"type": "boolean"},
+        "dependency_ecosystems": {"type": "array", "items": {"type": "string"}},
+        "tooling": {"type": "object", "additionalProperties": {"type": "boolean"}},
+        "devcontainer": {
+          "type": "object",
+          "required": ["config_file"],
+          "properties": {
+            "config_file": {"type": "string"},
+            "name": {"type": "string"},
+            "image": {"type": "string"},
+            "dockerfile": {"type": "string"},
+            "features": {"type": "array", "items": {"type": "string"}}
+          }
+        },
+        "security_posture": {
+          "type": "object",
+          "required": ["score", "max_score", "level", "factors"],
+          "properties": {
+            "score": {"type": "integer", "minimum": 0},
+            "max_score": {"type": "integer", "minimum": 0},
+            "level": {"enum": ["high", "medium", "low"]},
+            "factors": {
+              "type": "array",
+              "items": {
+                "type": "object",
+                "required": ["name", "passed"],
+                "properties": {
+                  "name": {"type": "string"},
+                  "passed": {"type": "boolean"}
+                }
+              }
+            }
+          }
+        },
+        "extraction_error": {"type": "string"}
+      }
+    },
+    "environment": {
+      "type": "object",
+      "properties": {
+        "ci": {
+          "type": "object",
+          "required": ["platform", "is_ci", "runner_os", "runner_arch"],
+          "properties": {
+            "platform": {"type": "string"},
+            "is_ci": {"type": "boolean"},
+            "runner_os": {"type": "string"},
+            "runner_arch": {"type": "string"}
+          },
+          "additionalProperties": {"type": "string"}
+        },
+        "runtime": {
+          "type": "object",
+          "required": ["os", "arch", "go_version"],
+          "properties": {
+            "os": {"type": "string"},
+            "arch": {"type": "string"},
+            "go_version": {"type": "string"},
+            "shell": {"type": "string"},
+            "env": {"type": "object", "additionalProperties": {"type": "string"}}
+          }
+        },
+        "setup_actions": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "object",
+            "required": ["name"],
+            "properties": {
+              "name": {"type": "string"},
+              "version": {"type": "string"},
+              "inputs": {"type": "object", "additionalProperties": {"type": "string"}}
+            }
+          }
+        },
+        "tools": {"type": "object", "additionalProperties": {"type": "string"}},
+        "tool_version_mismatch": {"type": "array", "items": {"type": "string"}}
+      }
+    },
+    "language_specific": {
+      "type": "object",
+      "description": "Extractor-specific keys; open-ended by design"
+    },
+    "subprojects": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "required": ["path", "extractor"],
+        "properties": {
+          "path": {"type": "string"},
+          "extractor": {"type": "string"}
+        }
+      }
+    },
+    "build": {
+      "type": "object",
+      "required": ["ci_platform", "ci_run_id", "ci_run_url", "runner_os", "runner_arch"],
+      "properties": {
+        "ci_platform": {"type": "string"},
+        "ci_run_id": {"type": "string"},
+        "ci_run_url": {"type": "string"},
+        "runner_os": {"type": "string"},
+        "runner_arch": {"type": "string"}
+      }
+    },
+    "generator": {
+      "type": "object",
+      "required": ["name", "version"],
+      "properties": {
+        "name": {"type": "string"},
+        "version": {"type": "string"},
+        "commit": {"type": "string"}
+      }
+    }
+  }
+}
+`
+
+// JSONSchema returns the JSON Schema (draft 2020-12) describing the
+// metadata JSON output
+func JSONSchema() string {
+	return metadataSchema
+}
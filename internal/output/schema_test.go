@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// compileSchema compiles JSONSchema for validation
+func compileSchema(t *testing.T) *jsonschema.Schema {
+	t.Helper()
+	doc, err := jsonschema.UnmarshalJSON(strings.NewReader(JSONSchema()))
+	if err != nil {
+		t.Fatalf("Schema is not valid JSON: %v", err)
+	}
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource("metadata.schema.json", doc); err != nil {
+		t.Fatalf("AddResource failed: %v", err)
+	}
+	schema, err := compiler.Compile("metadata.schema.json")
+	if err != nil {
+		t.Fatalf("Schema does not compile: %v", err)
+	}
+	return schema
+}
+
+// sampleMetadata returns a metadata object in the shape of the action output
+func sampleMetadata() map[string]interface{} {
+	return map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_type":          "go-module",
+			"project_name":          "sample",
+			"project_version":       "1.2.3",
+			"project_path":          "/workspace/sample",
+			"version_source":        "git-tag",
+			"versioning_type":       "static",
+			"build_timestamp":       "2025-11-03T12:00:00Z",
+			"project_match_repo":    true,
+			"dependency_automation": "dependabot",
+			"dependency_ecosystems": []string{"gomod"},
+			"security_posture": map[string]interface{}{
+				"score":     4,
+				"max_score": 5,
+				"level":     "high",
+				"factors":   []map[string]interface{}{{"name": "lock_file", "passed": true}},
+			},
+		},
+		"environment": map[string]interface{}{
+			"ci": map[string]interface{}{
+				"platform":    "github",
+				"is_ci":       true,
+				"runner_os":   "Linux",
+				"runner_arch": "X64",
+			},
+			"runtime": map[string]interface{}{
+				"os":         "linux",
+				"arch":       "amd64",
+				"go_version": "go1.24.0",
+			},
+			"tools": map[string]string{"go": "1.24.0", "git": "2.43.0"},
+		},
+		"language_specific": map[string]interface{}{
+			"go_version":    "1.24",
+			"build_targets": []string{"./cmd/app"},
+		},
+		"subprojects": []map[string]string{{"path": "tools/gen", "extractor": "go"}},
+		"build": map[string]interface{}{
+			"ci_platform": "github",
+			"ci_run_id":   "42",
+			"ci_run_url":  "https://github.com/example/sample/actions/runs/42",
+			"runner_os":   "Linux",
+			"runner_arch": "X64",
+		},
+	}
+}
+
+// validateJSON validates a JSON document against the schema
+func validateJSON(t *testing.T, schema *jsonschema.Schema, document string) error {
+	t.Helper()
+	instance, err := jsonschema.UnmarshalJSON(strings.NewReader(document))
+	if err != nil {
+		t.Fatalf("Document is not valid JSON: %v", err)
+	}
+	return schema.Validate(instance)
+}
+
+// TestJSONSchema_ValidatesSample tests that generated JSON matches the schema
+func TestJSONSchema_ValidatesSample(t *testing.T) {
+	schema := compileSchema(t)
+
+	document, err := GetMetadataJSON(sampleMetadata(), true)
+	if err != nil {
+		t.Fatalf("GetMetadataJSON failed: %v", err)
+	}
+	if err := validateJSON(t, schema, document); err != nil {
+		t.Errorf("Sample metadata does not match the schema: %v", err)
+	}
+}
+
+// TestJSONSchema_RejectsMissingCommonKeys tests that required common keys
+// are enforced
+func TestJSONSchema_RejectsMissingCommonKeys(t *testing.T) {
+	schema := compileSchema(t)
+
+	var parsed struct {
+		Properties struct {
+			Common struct {
+				Required []string `json:"required"`
+			} `json:"common"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal([]byte(JSONSchema()), &parsed); err != nil {
+		t.Fatalf("Failed to parse schema: %v", err)
+	}
+	if len(parsed.Properties.Common.Required) == 0 {
+		t.Fatal("Schema should require common keys")
+	}
+
+	for _, key := range parsed.Properties.Common.Required {
+		t.Run(key, func(t *testing.T) {
+			metadata := sampleMetadata()
+			delete(metadata["common"].(map[string]interface{}), key)
+
+			document, err := GetMetadataJSON(metadata, false)
+			if err != nil {
+				t.Fatalf("GetMetadataJSON failed: %v", err)
+			}
+			if err := validateJSON(t, schema, document); err == nil {
+				t.Errorf("Schema should reject metadata without common.%s", key)
+			}
+		})
+	}
+}
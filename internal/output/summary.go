@@ -16,13 +16,130 @@ import (
 // This is a simplified interface - actual implementation should match main.Metadata
 type Metadata interface{}
 
+// TimestampFormat selects how the build timestamp is rendered in the summary
+type TimestampFormat string
+
+const (
+	// TimestampFormatHuman renders timestamps as "2006-01-02 15:04:05 UTC"
+	TimestampFormatHuman TimestampFormat = "human"
+
+	// TimestampFormatRFC3339 renders timestamps as RFC3339 strings
+	TimestampFormatRFC3339 TimestampFormat = "rfc3339"
+)
+
+// SummaryMode selects how much detail the summary shows
+type SummaryMode string
+
+const (
+	// SummaryModeFull renders the complete summary
+	SummaryModeFull SummaryMode = "full"
+
+	// SummaryModeCompact renders only the project type, name, version and
+	// version matrix, for large matrix jobs
+	SummaryModeCompact SummaryMode = "compact"
+)
+
+// humanTimestampLayout is the layout used by TimestampFormatHuman
+const humanTimestampLayout = "2006-01-02 15:04:05"
+
+// SummaryOptions controls optional aspects of summary rendering
+type SummaryOptions struct {
+	// TimestampFormat selects the build timestamp rendering
+	TimestampFormat TimestampFormat
+
+	// DependencyCollapseThreshold is the number of dependencies above
+	// which they are rendered in a collapsible <details> block instead
+	// of inline in the Project Information table. Zero uses the default.
+	DependencyCollapseThreshold int
+
+	// InlineDependencies lists dependency sets within the threshold in
+	// a Dependencies row of the Project Information table
+	InlineDependencies bool
+
+	// Mode selects the full or compact summary
+	Mode SummaryMode
+
+	// ShowCompleteness adds the CompletenessScore of the metadata and
+	// its missing fields to the Project Information table
+	ShowCompleteness bool
+
+	// ShowBuildCommands adds the canonical build and test commands for
+	// the project type below the Project Information table
+	ShowBuildCommands bool
+}
+
+// manifestFingerprintLength is the number of manifest_sha256 hex digits shown
+const manifestFingerprintLength = 12
+
+// shortSHALength is the number of commit SHA hex digits shown
+const shortSHALength = 7
+
+// defaultDependencyCollapseThreshold is the default DependencyCollapseThreshold
+const defaultDependencyCollapseThreshold = 10
+
+// DefaultSummaryOptions returns the options used by GenerateSummary
+func DefaultSummaryOptions() SummaryOptions {
+	return SummaryOptions{
+		TimestampFormat:             TimestampFormatHuman,
+		DependencyCollapseThreshold: defaultDependencyCollapseThreshold,
+		Mode:                        SummaryModeFull,
+	}
+}
+
+// ParseTimestampFormat converts a user supplied value to a TimestampFormat
+func ParseTimestampFormat(value string) (TimestampFormat, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "human":
+		return TimestampFormatHuman, nil
+	case "rfc3339":
+		return TimestampFormatRFC3339, nil
+	default:
+		return TimestampFormatHuman, fmt.Errorf("unknown timestamp format: %s", value)
+	}
+}
+
+// ParseSummaryMode converts a user supplied value to a SummaryMode
+func ParseSummaryMode(value string) (SummaryMode, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "full":
+		return SummaryModeFull, nil
+	case "compact":
+		return SummaryModeCompact, nil
+	default:
+		return SummaryModeFull, fmt.Errorf("unknown summary mode: %s", value)
+	}
+}
+
 // GenerateSummary creates a GitHub Step Summary formatted output
 func GenerateSummary(metadata interface{}) string {
+	return GenerateSummaryWithOptions(metadata, DefaultSummaryOptions())
+}
+
+// GenerateSummaryWithOptions creates a GitHub Step Summary formatted output
+// using the supplied rendering options
+func GenerateSummaryWithOptions(metadata interface{}, opts SummaryOptions) string {
+	tmpl := defaultSummaryTemplate
+	if opts.Mode == SummaryModeCompact {
+		tmpl = compactSummaryTemplate
+	}
+
+	// The built-in templates only reference fields SummaryData always
+	// provides, so execution cannot fail
+	summary, _ := executeSummaryTemplate(tmpl, newSummaryData(metadata, opts))
+	return summary
+}
+
+// newSummaryData gathers the values rendered by the summary templates.
+// The Project Information table rows are pre-rendered into Table.
+func newSummaryData(metadata interface{}, opts SummaryOptions) *SummaryData {
 	var sb strings.Builder
 
 	// Try to extract metadata fields using type assertion
 	// In real implementation, this would work with the actual Metadata struct
 	metadataMap := convertToMap(metadata)
+	data := &SummaryData{Tools: make(map[string]string)}
+	data.Common, _ = metadataMap["common"].(map[string]interface{})
+	data.LanguageSpecific, _ = metadataMap["language_specific"].(map[string]interface{})
 
 	// Extract project type early as we need it for filtering
 	var projectType string
@@ -40,27 +157,36 @@ func GenerateSummary(metadata interface{}) string {
 		}
 	}
 
-	// Header
-	sb.WriteString("## 🔧 Build Metadata\n\n")
+	data.ProjectType = projectType
+	if projectType != "" {
+		data.ProjectTypeName = formatProjectType(projectType)
+	}
+	data.ProjectName, _ = data.Common["project_name"].(string)
+	data.ProjectVersion, _ = data.Common["project_version"].(string)
+	data.MatrixJSON, _ = data.LanguageSpecific["matrix_json"].(string)
+
+	// Identity banner: the gist of the build in a single line
+	if common, ok := metadataMap["common"].(map[string]interface{}); ok {
+		data.Banner = identityBanner(common, data.LanguageSpecific)
+	}
 
 	// Detect repository information
 	var repoInfo string
+	var repoDetails *repository.RepositoryInfo
 	if projectPath != "" {
 		if info, err := repository.DetectRepository(projectPath); err == nil {
 			repoInfo = info.FormatForDisplay()
+			repoDetails = info
 		}
 	}
 
 	// Project Information Section (consolidated)
 	if common, ok := metadataMap["common"].(map[string]interface{}); ok {
 		// Include repository info in header if available
+		data.Title = "Project Information"
 		if repoInfo != "" {
-			sb.WriteString(fmt.Sprintf("### %s\n\n", repoInfo))
-		} else {
-			sb.WriteString("### Project Information\n\n")
+			data.Title = repoInfo
 		}
-		sb.WriteString("| Key | Value |\n")
-		sb.WriteString("|-----|-------|\n")
 
 		// Basic project info
 		if projectType != "" {
@@ -75,6 +201,13 @@ func GenerateSummary(metadata interface{}) string {
 			sb.WriteString(fmt.Sprintf("| Project Version | %s |\n", projectVersion))
 		}
 
+		// Only the path relative to the repository root is shown; absolute
+		// runner paths are noise in a summary, so without one the row is
+		// omitted
+		if relPath, ok := common["project_path_relative"].(string); ok && relPath != "" {
+			sb.WriteString(fmt.Sprintf("| Project Path | `%s` |\n", relPath))
+		}
+
 		if versionSource, ok := common["version_source"].(string); ok && versionSource != "" {
 			sb.WriteString(fmt.Sprintf("| Version Source | %s |\n", versionSource))
 		}
@@ -88,13 +221,12 @@ func GenerateSummary(metadata interface{}) string {
 
 		// Handle timestamp - could be time.Time or string after JSON conversion
 		if buildTimestamp, ok := common["build_timestamp"].(time.Time); ok {
-			// Format as: 2025-11-03 11:37:48 UTC
-			formattedTime := buildTimestamp.UTC().Format("2006-01-02 15:04:05") + " UTC"
+			formattedTime := formatTimestamp(buildTimestamp, opts.TimestampFormat)
 			sb.WriteString(fmt.Sprintf("| Build Timestamp | %s |\n", formattedTime))
 		} else if buildTimestampStr, ok := common["build_timestamp"].(string); ok && buildTimestampStr != "" {
 			// Already in string format from JSON marshaling, try to parse and reformat
 			if parsedTime, err := time.Parse(time.RFC3339, buildTimestampStr); err == nil {
-				formattedTime := parsedTime.UTC().Format("2006-01-02 15:04:05") + " UTC"
+				formattedTime := formatTimestamp(parsedTime, opts.TimestampFormat)
 				sb.WriteString(fmt.Sprintf("| Build Timestamp | %s |\n", formattedTime))
 			} else {
 				// If parsing fails, use original string
@@ -103,11 +235,55 @@ func GenerateSummary(metadata interface{}) string {
 		}
 
 		if gitBranch, ok := common["git_branch"].(string); ok && gitBranch != "" {
-			sb.WriteString(fmt.Sprintf("| Git Branch | `%s` |\n", gitBranch))
+			if isDefault, ok := common["is_default_branch"].(bool); ok && isDefault {
+				sb.WriteString(fmt.Sprintf("| Git Branch | `%s` (default) |\n", gitBranch))
+			} else {
+				sb.WriteString(fmt.Sprintf("| Git Branch | `%s` |\n", gitBranch))
+			}
 		}
 
 		if gitTag, ok := common["git_tag"].(string); ok && gitTag != "" {
-			sb.WriteString(fmt.Sprintf("| Git Tag | `%s` |\n", gitTag))
+			tagURL := ""
+			if repoDetails != nil {
+				tagURL = repoDetails.TagURL(gitTag)
+			}
+			sb.WriteString(fmt.Sprintf("| Git Tag | %s |\n", codeLink(gitTag, tagURL)))
+		}
+
+		if gitSHA, ok := common["git_sha"].(string); ok && gitSHA != "" {
+			commitURL := ""
+			if repoDetails != nil {
+				commitURL = repoDetails.CommitURL(gitSHA)
+			}
+			shortSHA := gitSHA
+			if len(shortSHA) > shortSHALength {
+				shortSHA = shortSHA[:shortSHALength]
+			}
+			sb.WriteString(fmt.Sprintf("| Git Commit | %s |\n", codeLink(shortSHA, commitURL)))
+		}
+
+		if automation, ok := common["dependency_automation"].(string); ok && automation != "" {
+			if ecosystems, ok := common["dependency_ecosystems"].([]interface{}); ok && len(ecosystems) > 0 {
+				names := make([]string, 0, len(ecosystems))
+				for _, ecosystem := range ecosystems {
+					names = append(names, fmt.Sprintf("%v", ecosystem))
+				}
+				automation = fmt.Sprintf("%s (%s)", automation, strings.Join(names, ", "))
+			}
+			sb.WriteString(fmt.Sprintf("| Dependency Automation | %s |\n", automation))
+		}
+
+		if securityPosture, ok := common["security_posture"].(map[string]interface{}); ok {
+			sb.WriteString(fmt.Sprintf("| 🔒 Security | %s |\n", formatSecurityPosture(securityPosture)))
+		}
+
+		if opts.ShowCompleteness {
+			score, missing := CompletenessScore(metadataMap)
+			completeness := fmt.Sprintf("%d/100", score)
+			if len(missing) > 0 {
+				completeness += fmt.Sprintf(" (missing: %s)", strings.Join(missing, ", "))
+			}
+			sb.WriteString(fmt.Sprintf("| Completeness | %s |\n", completeness))
 		}
 
 		// Add language-specific metadata to the same table
@@ -115,6 +291,29 @@ func GenerateSummary(metadata interface{}) string {
 			addLanguageSpecificToTable(&sb, projectType, langSpecific)
 		}
 
+		// Shortened manifest fingerprint
+		if langSpecific, ok := metadataMap["language_specific"].(map[string]interface{}); ok {
+			if sha, ok := langSpecific["manifest_sha256"].(string); ok && len(sha) >= manifestFingerprintLength {
+				sb.WriteString(fmt.Sprintf("| Manifest Fingerprint | `%s` |\n", sha[:manifestFingerprintLength]))
+			}
+		}
+
+		// Large dependency sets are collapsed below the table; small
+		// ones are listed inline when requested
+		dependencies := dependencyVersions(metadataMap)
+		threshold := opts.DependencyCollapseThreshold
+		if threshold <= 0 {
+			threshold = defaultDependencyCollapseThreshold
+		}
+		collapseDependencies := len(dependencies) > threshold
+		if opts.InlineDependencies && len(dependencies) > 0 && !collapseDependencies {
+			entries := make([]string, 0, len(dependencies))
+			for _, name := range sortMapKeys(dependencies) {
+				entries = append(entries, escapeTableCell(strings.TrimSpace(fmt.Sprintf("%s %s", name, dependencies[name]))))
+			}
+			sb.WriteString(fmt.Sprintf("| Dependencies | %s |\n", strings.Join(entries, ", ")))
+		}
+
 		// Add project_match_repo comparison (common to all project types)
 		if projectMatchRepo, ok := common["project_match_repo"].(bool); ok {
 			matchStatus := "true ✅"
@@ -143,20 +342,90 @@ func GenerateSummary(metadata interface{}) string {
 
 				// Filter to only relevant tools based on project type
 				relevantTools := filterRelevantTools(projectType, allTools)
+				data.Tools = relevantTools
 				if len(relevantTools) > 0 {
+					// Tools outside the declared constraint are flagged
+					mismatched := make(map[string]bool)
+					if mismatches, ok := env["tool_version_mismatch"].([]interface{}); ok {
+						for _, tool := range mismatches {
+							if name, ok := tool.(string); ok {
+								mismatched[name] = true
+							}
+						}
+					}
+					langSpecific, _ := metadataMap["language_specific"].(map[string]interface{})
+
 					// Sort tools alphabetically for consistent output
 					sortedTools := sortMapKeys(relevantTools)
 					for _, tool := range sortedTools {
-						sb.WriteString(fmt.Sprintf("| %s | %s |\n", formatToolName(tool), relevantTools[tool]))
+						version := relevantTools[tool]
+						if mismatched[tool] {
+							version = fmt.Sprintf("%s ⚠️ (requires %s)", version, toolConstraint(tool, langSpecific))
+						}
+						sb.WriteString(fmt.Sprintf("| %s | %s |\n", formatToolName(tool), version))
 					}
 				}
 			}
 		}
 
-		sb.WriteString("\n")
+		data.Table = sb.String()
+
+		if collapseDependencies {
+			var details strings.Builder
+			writeDependencyDetails(&details, dependencies)
+			data.DependencyDetails = details.String()
+		}
+
+		if opts.ShowBuildCommands {
+			data.BuildCommands = buildCommandsSection(BuildCommands(projectType, data.LanguageSpecific))
+		}
+
+		// Extraction warnings flag data that may be incomplete
+		if warnings, ok := common["extraction_warnings"].([]interface{}); ok {
+			for _, warning := range warnings {
+				data.Warnings = append(data.Warnings, fmt.Sprintf("%v", warning))
+			}
+		}
+	}
+
+	return data
+}
+
+// dependencyVersions returns the language-specific dependencies map as
+// name to version strings; list-style dependencies are not included
+func dependencyVersions(metadataMap map[string]interface{}) map[string]string {
+	versions := make(map[string]string)
+	langSpecific, ok := metadataMap["language_specific"].(map[string]interface{})
+	if !ok {
+		return versions
+	}
+	dependencies, ok := langSpecific["dependencies"].(map[string]interface{})
+	if !ok {
+		return versions
+	}
+	for name, version := range dependencies {
+		versionStr, _ := version.(string)
+		versions[name] = versionStr
+	}
+	return versions
+}
+
+// writeDependencyDetails renders dependencies as a sorted table inside a
+// collapsible <details> block
+func writeDependencyDetails(sb *strings.Builder, dependencies map[string]string) {
+	sb.WriteString(fmt.Sprintf("<details><summary>Dependencies (%d)</summary>\n\n", len(dependencies)))
+	sb.WriteString("| Name | Version |\n")
+	sb.WriteString("|------|---------|\n")
+	for _, name := range sortMapKeys(dependencies) {
+		sb.WriteString(fmt.Sprintf("| %s | %s |\n", escapeTableCell(name), escapeTableCell(dependencies[name])))
 	}
+	sb.WriteString("\n</details>\n\n")
+}
 
-	return sb.String()
+// escapeTableCell escapes pipes, e.g. in "^5.4 || ^6.0", so a value
+// cannot split a Markdown table cell
+func escapeTableCell(value string) string {
+	return strings.ReplaceAll(value, "|", "\\|")
 }
 
 // GenerateMarkdown creates a markdown formatted output
@@ -165,38 +434,190 @@ func GenerateMarkdown(metadata interface{}) string {
 	return GenerateSummary(metadata)
 }
 
+// identityBannerSeparator joins the fields of the identity banner
+const identityBannerSeparator = " · "
+
+// identityBanner combines the most important fields (type, name, version,
+// artifact kind, branch@sha) into a single line. Missing fields are omitted.
+func identityBanner(common map[string]interface{}, langSpecific map[string]interface{}) string {
+	parts := make([]string, 0, 5)
+
+	if projectType, ok := common["project_type"].(string); ok && projectType != "" {
+		parts = append(parts, projectType)
+	}
+
+	if projectName, ok := common["project_name"].(string); ok && projectName != "" {
+		parts = append(parts, projectName)
+	}
+
+	if projectVersion, ok := common["project_version"].(string); ok && projectVersion != "" {
+		if !strings.HasPrefix(projectVersion, "v") {
+			projectVersion = "v" + projectVersion
+		}
+		parts = append(parts, projectVersion)
+	}
+
+	if kind := artifactKind(langSpecific); kind != "" {
+		parts = append(parts, kind)
+	}
+
+	gitBranch, _ := common["git_branch"].(string)
+	gitSHA, _ := common["git_sha"].(string)
+	if len(gitSHA) > 7 {
+		gitSHA = gitSHA[:7]
+	}
+	switch {
+	case gitBranch != "" && gitSHA != "":
+		parts = append(parts, gitBranch+"@"+gitSHA)
+	case gitBranch != "":
+		parts = append(parts, gitBranch)
+	case gitSHA != "":
+		parts = append(parts, gitSHA)
+	}
+
+	return strings.Join(parts, identityBannerSeparator)
+}
+
+// artifactKind derives the kind of artifact (library, application, jar, ...)
+// from language-specific metadata
+func artifactKind(langSpecific map[string]interface{}) string {
+	if isLibrary, ok := langSpecific["is_library"].(bool); ok && isLibrary {
+		return "library"
+	}
+	if isExecutable, ok := langSpecific["is_executable"].(bool); ok && isExecutable {
+		return "application"
+	}
+	for _, key := range []string{"package_type", "packaging"} {
+		if kind, ok := langSpecific[key].(string); ok && kind != "" {
+			return kind
+		}
+	}
+	return ""
+}
+
+// codeLink renders text as inline code, linked to url when it is known
+func codeLink(text, url string) string {
+	if url == "" {
+		return fmt.Sprintf("`%s`", text)
+	}
+	return fmt.Sprintf("[`%s`](%s)", text, url)
+}
+
+// formatTimestamp renders a timestamp in the requested format. UTC values
+// keep the historical "2025-11-03 11:37:48 UTC" form; values carrying a
+// non-UTC offset keep that offset instead of being converted to UTC.
+func formatTimestamp(t time.Time, format TimestampFormat) string {
+	_, offset := t.Zone()
+
+	if format == TimestampFormatRFC3339 {
+		if offset == 0 {
+			return t.UTC().Format(time.RFC3339)
+		}
+		return t.Format(time.RFC3339)
+	}
+
+	if offset == 0 {
+		return t.UTC().Format(humanTimestampLayout) + " UTC"
+	}
+	return t.Format(humanTimestampLayout + " -07:00")
+}
+
+// primaryLanguageOverrides maps formatProjectType display names, with
+// the tooling suffix removed, and title-cased type prefixes that are not
+// themselves a language name
+var primaryLanguageOverrides = map[string]string{
+	"C/C++":        "C++",
+	"C":            "C++",
+	"Csharp":       "C#",
+	"Dotnet":       "C#",
+	"Dart/Flutter": "Dart",
+	".NET Project": "C#",
+	"Helm Chart":   "Helm",
+	"Terraform":    "HCL",
+	"OpenTofu":     "HCL",
+	"Docker":       "Dockerfile",
+}
+
+// PrimaryLanguage returns a coarse language label for a project type,
+// e.g. "python-modern" -> "Python" and "c-cmake" -> "C++". Unknown types
+// use the title-cased first segment, e.g. "zig-build" -> "Zig".
+func PrimaryLanguage(projectType string) string {
+	if projectType == "" {
+		return ""
+	}
+
+	display, ok := projectTypeNames[projectType]
+	if !ok {
+		first := strings.SplitN(projectType, "-", 2)[0]
+		display = strings.ToUpper(first[:1]) + first[1:]
+	}
+
+	// Drop the tooling suffix, e.g. "Go (Module)" -> "Go"
+	if idx := strings.Index(display, " ("); idx >= 0 {
+		display = display[:idx]
+	}
+	if language, ok := primaryLanguageOverrides[display]; ok {
+		return language
+	}
+	return display
+}
+
+// projectTypeNames maps internal project types to display names
+var projectTypeNames = map[string]string{
+	"python-modern":      "Python (Modern)",
+	"python-legacy":      "Python (Legacy)",
+	"javascript-npm":     "JavaScript (npm)",
+	"javascript-yarn":    "JavaScript (Yarn)",
+	"javascript-pnpm":    "JavaScript (pnpm)",
+	"typescript-npm":     "TypeScript (npm)",
+	"java-maven":         "Java (Maven)",
+	"java-gradle":        "Java (Gradle)",
+	"java-gradle-kts":    "Java (Gradle Kotlin DSL)",
+	"kotlin-gradle":      "Kotlin (Gradle)",
+	"csharp-project":     "C# (.NET Project)",
+	"csharp-solution":    "C# (.NET Solution)",
+	"csharp-props":       "C# (MSBuild Props)",
+	"dotnet-project":     ".NET Project",
+	"go-module":          "Go (Module)",
+	"go-workspace":       "Go (Workspace)",
+	"rust-cargo":         "Rust (Cargo)",
+	"ruby-gemspec":       "Ruby (Gem)",
+	"ruby-bundler":       "Ruby (Bundler)",
+	"php-composer":       "PHP (Composer)",
+	"swift-package":      "Swift (Package)",
+	"dart-flutter":       "Dart/Flutter",
+	"elixir-mix":         "Elixir (Mix)",
+	"scala-sbt":          "Scala (sbt)",
+	"haskell-cabal":      "Haskell (Cabal)",
+	"julia-project":      "Julia (Project)",
+	"clojure-leiningen":  "Clojure (Leiningen)",
+	"clojure-deps":       "Clojure (deps.edn)",
+	"erlang-rebar":       "Erlang (rebar3)",
+	"terraform":          "Terraform",
+	"terraform-module":   "Terraform (Module)",
+	"terraform-opentofu": "OpenTofu",
+	"docker":             "Docker",
+	"helm":               "Helm Chart",
+	"helm-chart":         "Helm Chart",
+	"c-cmake":            "C/C++ (CMake)",
+	"c-qmake":            "C/C++ (Qt qmake)",
+	"c-autoconf":         "C/C++ (Autoconf)",
+	"c-autoconf-legacy":  "C/C++ (Autoconf, legacy)",
+	"c-meson":            "C/C++ (Meson)",
+	"c-conan":            "C/C++ (Conan)",
+	"nim-nimble":         "Nim (Nimble)",
+	"r-package":          "R (Package)",
+	"perl-cpan":          "Perl (MakeMaker)",
+	"perl-module-build":  "Perl (Module::Build)",
+	"perl-cpanfile":      "Perl (cpanfile)",
+	"zig-package":        "Zig (Package)",
+	"zig-build":          "Zig (Build)",
+	"openapi-spec":       "OpenAPI (Spec)",
+}
+
 // formatProjectType converts internal project type to display name
 func formatProjectType(projectType string) string {
-	typeMap := map[string]string{
-		"python-modern":      "Python (Modern)",
-		"python-legacy":      "Python (Legacy)",
-		"javascript-npm":     "JavaScript (npm)",
-		"javascript-yarn":    "JavaScript (Yarn)",
-		"javascript-pnpm":    "JavaScript (pnpm)",
-		"typescript-npm":     "TypeScript (npm)",
-		"java-maven":         "Java (Maven)",
-		"java-gradle":        "Java (Gradle)",
-		"java-gradle-kts":    "Java (Gradle Kotlin DSL)",
-		"csharp-project":     "C# (.NET Project)",
-		"csharp-solution":    "C# (.NET Solution)",
-		"dotnet-project":     ".NET Project",
-		"go-module":          "Go (Module)",
-		"rust-cargo":         "Rust (Cargo)",
-		"ruby-gemspec":       "Ruby (Gem)",
-		"ruby-bundler":       "Ruby (Bundler)",
-		"php-composer":       "PHP (Composer)",
-		"swift-package":      "Swift (Package)",
-		"dart-flutter":       "Dart/Flutter",
-		"terraform":          "Terraform",
-		"terraform-opentofu": "OpenTofu",
-		"docker":             "Docker",
-		"helm":               "Helm Chart",
-		"c-cmake":            "C/C++ (CMake)",
-		"c-qmake":            "C/C++ (Qt qmake)",
-		"c-autoconf":         "C/C++ (Autoconf)",
-	}
-
-	if display, ok := typeMap[projectType]; ok {
+	if display, ok := projectTypeNames[projectType]; ok {
 		return display
 	}
 
@@ -341,7 +762,8 @@ func addLanguageSpecificToTable(sb *strings.Builder, projectType string, metadat
 	}
 }
 
-// filterRelevantTools filters tools to only those relevant to the project type
+// filterRelevantTools filters tools to only those relevant to the project type.
+// It only shapes the Markdown summary; JSON and YAML output keep the full map.
 func filterRelevantTools(projectType string, allTools map[string]string) map[string]string {
 	if projectType == "" || len(allTools) == 0 {
 		return make(map[string]string)
@@ -533,3 +955,33 @@ func sortMapKeys(m map[string]string) []string {
 
 	return keys
 }
+
+// formatSecurityPosture renders the posture score, level and any factors
+// that did not pass
+func formatSecurityPosture(securityPosture map[string]interface{}) string {
+	score, _ := securityPosture["score"].(float64)
+	maxScore, _ := securityPosture["max_score"].(float64)
+	level, _ := securityPosture["level"].(string)
+
+	result := fmt.Sprintf("%d/%d (%s)", int(score), int(maxScore), level)
+
+	missing := make([]string, 0)
+	if factors, ok := securityPosture["factors"].([]interface{}); ok {
+		for _, f := range factors {
+			factor, ok := f.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if passed, _ := factor["passed"].(bool); !passed {
+				if name, ok := factor["name"].(string); ok {
+					missing = append(missing, strings.ReplaceAll(name, "_", " "))
+				}
+			}
+		}
+	}
+	if len(missing) > 0 {
+		result += " — missing: " + strings.Join(missing, ", ")
+	}
+
+	return result
+}
@@ -5,9 +5,13 @@ package output
 
 import (
 	"encoding/json"
+	"fmt"
+	"os/exec"
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/detector"
 )
 
 // TestGenerateSummary_BasicMetadata tests summary generation with basic metadata
@@ -748,6 +752,130 @@ func TestGenerateSummary_TimestampFormatting(t *testing.T) {
 	}
 }
 
+// TestGenerateSummary_TimestampOffsetPreserved tests that non-UTC offsets are kept
+func TestGenerateSummary_TimestampOffsetPreserved(t *testing.T) {
+	location := time.FixedZone("CEST", 2*60*60)
+	timestamp := time.Date(2025, 1, 3, 15, 30, 45, 0, location)
+
+	metadata := map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_type":    "python-modern",
+			"build_timestamp": timestamp,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		format   TimestampFormat
+		expected string
+	}{
+		{name: "human", format: TimestampFormatHuman, expected: "2025-01-03 15:30:45 +02:00"},
+		{name: "rfc3339", format: TimestampFormatRFC3339, expected: "2025-01-03T15:30:45+02:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := GenerateSummaryWithOptions(metadata, SummaryOptions{TimestampFormat: tt.format})
+			if !strings.Contains(summary, tt.expected) {
+				t.Errorf("Should contain timestamp %s\nGot:\n%s", tt.expected, summary)
+			}
+			if strings.Contains(summary, "13:30:45") {
+				t.Error("Timestamp should not be converted to UTC")
+			}
+		})
+	}
+}
+
+// TestGenerateSummary_TimestampRFC3339UTC tests RFC3339 rendering of UTC timestamps
+func TestGenerateSummary_TimestampRFC3339UTC(t *testing.T) {
+	metadata := map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_type":    "python-modern",
+			"build_timestamp": time.Date(2025, 1, 3, 15, 30, 45, 0, time.UTC),
+		},
+	}
+
+	summary := GenerateSummaryWithOptions(metadata, SummaryOptions{TimestampFormat: TimestampFormatRFC3339})
+	if !strings.Contains(summary, "| Build Timestamp | 2025-01-03T15:30:45Z |") {
+		t.Errorf("Should contain RFC3339 timestamp\nGot:\n%s", summary)
+	}
+}
+
+// TestGenerateSummary_IdentityBanner tests the top-line identity banner
+func TestGenerateSummary_IdentityBanner(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata map[string]interface{}
+		expected string
+	}{
+		{
+			name: "all fields",
+			metadata: map[string]interface{}{
+				"common": map[string]interface{}{
+					"project_type":    "python-modern",
+					"project_name":    "example-project",
+					"project_version": "1.2.3",
+					"git_branch":      "main",
+					"git_sha":         "abc1234def5678",
+				},
+				"language_specific": map[string]interface{}{
+					"is_library": true,
+				},
+			},
+			expected: "**python-modern · example-project · v1.2.3 · library · main@abc1234**",
+		},
+		{
+			name: "missing fields omitted",
+			metadata: map[string]interface{}{
+				"common": map[string]interface{}{
+					"project_type": "go-module",
+					"project_name": "tool",
+					"git_sha":      "0123456789abcdef",
+				},
+			},
+			expected: "**go-module · tool · 0123456**",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := GenerateSummary(tt.metadata)
+			if !strings.Contains(summary, tt.expected+"\n") {
+				t.Errorf("Should contain banner %s\nGot:\n%s", tt.expected, summary)
+			}
+			if strings.Contains(summary, "·  ·") || strings.Contains(summary, "· ·") {
+				t.Error("Banner should not contain empty segments")
+			}
+		})
+	}
+}
+
+// TestParseTimestampFormat tests parsing of timestamp format option values
+func TestParseTimestampFormat(t *testing.T) {
+	tests := []struct {
+		input       string
+		expected    TimestampFormat
+		expectError bool
+	}{
+		{input: "", expected: TimestampFormatHuman},
+		{input: "human", expected: TimestampFormatHuman},
+		{input: "RFC3339", expected: TimestampFormatRFC3339},
+		{input: "iso", expected: TimestampFormatHuman, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			format, err := ParseTimestampFormat(tt.input)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ParseTimestampFormat(%q) error = %v, expectError %v", tt.input, err, tt.expectError)
+			}
+			if format != tt.expected {
+				t.Errorf("ParseTimestampFormat(%q) = %v, want %v", tt.input, format, tt.expected)
+			}
+		})
+	}
+}
+
 // TestGenerateSummary_AllProjectTypes tests all supported project types
 func TestGenerateSummary_AllProjectTypes(t *testing.T) {
 	projectTypes := []string{
@@ -821,3 +949,346 @@ func TestGenerateSummary_JSONMarshaling(t *testing.T) {
 		t.Error("Should generate non-empty summary from unmarshaled data")
 	}
 }
+
+// TestGenerateSummary_SecurityPosture tests the security posture row
+func TestGenerateSummary_SecurityPosture(t *testing.T) {
+	metadata := map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_type": "go-module",
+			"project_name": "tool",
+			"security_posture": map[string]interface{}{
+				"score":     3,
+				"max_score": 5,
+				"level":     "medium",
+				"factors": []map[string]interface{}{
+					{"name": "lock_file", "passed": true},
+					{"name": "pinned_base_images", "passed": true},
+					{"name": "dependency_automation", "passed": false},
+					{"name": "supported_runtime", "passed": true},
+					{"name": "security_policy", "passed": false},
+				},
+			},
+		},
+	}
+
+	summary := GenerateSummary(metadata)
+	expected := "| 🔒 Security | 3/5 (medium) — missing: dependency automation, security policy |"
+	if !strings.Contains(summary, expected) {
+		t.Errorf("Should contain %s\nGot:\n%s", expected, summary)
+	}
+}
+
+// TestGenerateSummary_Completeness tests the optional completeness row
+func TestGenerateSummary_Completeness(t *testing.T) {
+	metadata := map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_type":    "go-module",
+			"project_name":    "tool",
+			"project_version": "1.0.0",
+			"license_spdx":    "MIT",
+		},
+	}
+
+	if summary := GenerateSummary(metadata); strings.Contains(summary, "| Completeness |") {
+		t.Error("Completeness should only be shown when requested")
+	}
+
+	opts := DefaultSummaryOptions()
+	opts.ShowCompleteness = true
+	summary := GenerateSummaryWithOptions(metadata, opts)
+	expected := "| Completeness | 60/100 (missing: project_match_repo, description) |"
+	if !strings.Contains(summary, expected) {
+		t.Errorf("Should contain %s\nGot:\n%s", expected, summary)
+	}
+}
+
+// TestGenerateSummary_DependencyDetails tests collapsing long dependency lists
+func TestGenerateSummary_DependencyDetails(t *testing.T) {
+	dependencies := make(map[string]interface{})
+	for i := 0; i < 12; i++ {
+		dependencies[fmt.Sprintf("dep-%02d", 11-i)] = fmt.Sprintf("^1.%d", i)
+	}
+	metadata := map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_type": "javascript-npm",
+		},
+		"language_specific": map[string]interface{}{
+			"dependencies": dependencies,
+		},
+	}
+
+	summary := GenerateSummary(metadata)
+	if !strings.Contains(summary, "<details><summary>Dependencies (12)</summary>") {
+		t.Fatalf("Should contain a collapsible dependency block\nGot:\n%s", summary)
+	}
+	first := strings.Index(summary, "| dep-00 | ^1.11 |")
+	last := strings.Index(summary, "| dep-11 | ^1.0 |")
+	if first < 0 || last < 0 || first > last {
+		t.Errorf("Dependencies should be rendered as a sorted table\nGot:\n%s", summary)
+	}
+	if strings.Contains(summary, "| Dependencies |") {
+		t.Error("Collapsed dependencies should not also be listed inline")
+	}
+
+	// A higher threshold keeps the dependencies out of the details
+	// block, and only lists them inline when requested
+	opts := DefaultSummaryOptions()
+	opts.DependencyCollapseThreshold = 20
+	summary = GenerateSummaryWithOptions(metadata, opts)
+	if strings.Contains(summary, "<details>") {
+		t.Error("Dependencies below the threshold should not be collapsed")
+	}
+	if strings.Contains(summary, "| Dependencies |") {
+		t.Error("Dependencies should only be listed inline when requested")
+	}
+	opts.InlineDependencies = true
+	summary = GenerateSummaryWithOptions(metadata, opts)
+	if !strings.Contains(summary, "| Dependencies | dep-00 ^1.11, dep-01 ^1.10,") {
+		t.Errorf("Should list dependencies inline\nGot:\n%s", summary)
+	}
+
+	// An unset threshold uses the default rather than collapsing
+	// every dependency set
+	small := map[string]interface{}{
+		"common":            map[string]interface{}{"project_type": "php-composer"},
+		"language_specific": map[string]interface{}{"dependencies": map[string]interface{}{"symfony/console": "^5.4 || ^6.0"}},
+	}
+	summary = GenerateSummaryWithOptions(small, SummaryOptions{InlineDependencies: true})
+	if strings.Contains(summary, "<details>") {
+		t.Error("A zero threshold should use the default")
+	}
+	if !strings.Contains(summary, "| Dependencies | symfony/console ^5.4 \\|\\| ^6.0 |") {
+		t.Errorf("Pipes in versions should be escaped\nGot:\n%s", summary)
+	}
+}
+
+// TestWriteDependencyDetails_EscapesPipes tests that constraints cannot
+// split the collapsed table's cells
+func TestWriteDependencyDetails_EscapesPipes(t *testing.T) {
+	var sb strings.Builder
+	writeDependencyDetails(&sb, map[string]string{"symfony/console": "^5.4 || ^6.0"})
+	if !strings.Contains(sb.String(), "| symfony/console | ^5.4 \\|\\| ^6.0 |\n") {
+		t.Errorf("Pipes should be escaped\nGot:\n%s", sb.String())
+	}
+}
+
+// TestGenerateSummary_ManifestFingerprint tests the shortened manifest hash
+func TestGenerateSummary_ExtractionWarnings(t *testing.T) {
+	metadata := map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_type":        "swift-package",
+			"extraction_warnings": []interface{}{"dependency parsing incomplete: regex fallback used"},
+		},
+	}
+
+	summary := GenerateSummary(metadata)
+	if !strings.Contains(summary, "### ⚠️ Extraction Warnings\n\n- dependency parsing incomplete: regex fallback used\n") {
+		t.Errorf("Should contain the warnings section\nGot:\n%s", summary)
+	}
+
+	delete(metadata["common"].(map[string]interface{}), "extraction_warnings")
+	if summary := GenerateSummary(metadata); strings.Contains(summary, "Extraction Warnings") {
+		t.Errorf("Should not contain a warnings section without warnings\nGot:\n%s", summary)
+	}
+}
+
+func TestGenerateSummary_ManifestFingerprint(t *testing.T) {
+	metadata := map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_type": "go-module",
+		},
+		"language_specific": map[string]interface{}{
+			"manifest_sha256": "80f9dbae82ed37e3248356069826e0c49345747bd16ca405380dc7f008d7abec",
+		},
+	}
+
+	summary := GenerateSummary(metadata)
+	if !strings.Contains(summary, "| Manifest Fingerprint | `80f9dbae82ed` |") {
+		t.Errorf("Should contain the shortened fingerprint\nGot:\n%s", summary)
+	}
+}
+
+func TestGenerateSummary_GitLinks(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"remote", "add", "origin", "https://github.com/example-org/example-repo.git"},
+	} {
+		if output, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v (%s)", args, err, output)
+		}
+	}
+
+	metadata := map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_type": "go-module",
+			"project_path": dir,
+			"git_sha":      "0123456789abcdef0123456789abcdef01234567",
+			"git_tag":      "v1.2.3",
+		},
+	}
+
+	summary := GenerateSummary(metadata)
+	if !strings.Contains(summary, "| Git Commit | [`0123456`](https://github.com/example-org/example-repo/commit/0123456789abcdef0123456789abcdef01234567) |") {
+		t.Errorf("Should link the commit\nGot:\n%s", summary)
+	}
+	if !strings.Contains(summary, "| Git Tag | [`v1.2.3`](https://github.com/example-org/example-repo/releases/tag/v1.2.3) |") {
+		t.Errorf("Should link the tag\nGot:\n%s", summary)
+	}
+}
+
+func TestGenerateSummary_GitPlainWithoutProvider(t *testing.T) {
+	metadata := map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_type": "go-module",
+			"git_sha":      "0123456789abcdef0123456789abcdef01234567",
+			"git_tag":      "v1.2.3",
+		},
+	}
+
+	summary := GenerateSummary(metadata)
+	if !strings.Contains(summary, "| Git Commit | `0123456` |") {
+		t.Errorf("Should render the commit as plain code\nGot:\n%s", summary)
+	}
+	if !strings.Contains(summary, "| Git Tag | `v1.2.3` |") {
+		t.Errorf("Should render the tag as plain code\nGot:\n%s", summary)
+	}
+}
+
+// TestGenerateSummary_ProjectPath tests that the relative project path is
+// shown and the absolute runner path never is
+func TestGenerateSummary_ProjectPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		common   map[string]interface{}
+		expected string
+	}{
+		{
+			name: "nested project",
+			common: map[string]interface{}{
+				"project_path":          "/home/runner/work/repo/repo/services/api",
+				"project_path_relative": "services/api",
+			},
+			expected: "| Project Path | `services/api` |",
+		},
+		{
+			name: "repository root",
+			common: map[string]interface{}{
+				"project_path":          "/home/runner/work/repo/repo",
+				"project_path_relative": ".",
+			},
+			expected: "| Project Path | `.` |",
+		},
+		{
+			name: "outside a git repository",
+			common: map[string]interface{}{
+				"project_path": "/nonexistent/project",
+			},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.common["project_type"] = "go-module"
+			summary := GenerateSummary(map[string]interface{}{"common": tt.common})
+			if tt.expected == "" {
+				if strings.Contains(summary, "Project Path") {
+					t.Errorf("Summary should omit the project path\nGot:\n%s", summary)
+				}
+				return
+			}
+			if !strings.Contains(summary, tt.expected) {
+				t.Errorf("Summary should contain %q\nGot:\n%s", tt.expected, summary)
+			}
+		})
+	}
+}
+
+// TestGenerateSummary_DefaultBranch tests marking the default branch
+func TestGenerateSummary_DefaultBranch(t *testing.T) {
+	common := map[string]interface{}{
+		"project_type":      "go-module",
+		"git_branch":        "main",
+		"is_default_branch": true,
+	}
+	summary := GenerateSummary(map[string]interface{}{"common": common})
+	if !strings.Contains(summary, "| Git Branch | `main` (default) |") {
+		t.Errorf("Should mark the default branch\nGot:\n%s", summary)
+	}
+
+	common["git_branch"] = "feature"
+	common["is_default_branch"] = false
+	summary = GenerateSummary(map[string]interface{}{"common": common})
+	if !strings.Contains(summary, "| Git Branch | `feature` |") {
+		t.Errorf("Should not mark other branches\nGot:\n%s", summary)
+	}
+}
+
+// TestPrimaryLanguage tests the coarse language label for project types
+func TestPrimaryLanguage(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"python-modern", "Python"},
+		{"python-legacy", "Python"},
+		{"javascript-npm", "JavaScript"},
+		{"typescript-npm", "TypeScript"},
+		{"java-gradle-kts", "Java"},
+		{"csharp-solution", "C#"},
+		{"dotnet-project", "C#"},
+		{"go-module", "Go"},
+		{"rust-cargo", "Rust"},
+		{"ruby-gemspec", "Ruby"},
+		{"php-composer", "PHP"},
+		{"swift-package", "Swift"},
+		{"dart-flutter", "Dart"},
+		{"c-cmake", "C++"},
+		{"c-autoconf", "C++"},
+		{"c-meson", "C++"},
+		{"c-autoconf-legacy", "C++"},
+		{"csharp-props", "C#"},
+		{"kotlin-gradle", "Kotlin"},
+		{"elixir-mix", "Elixir"},
+		{"scala-sbt", "Scala"},
+		{"terraform-module", "HCL"},
+		{"terraform-opentofu", "HCL"},
+		{"docker", "Dockerfile"},
+		{"helm", "Helm"},
+		{"helm-chart", "Helm"},
+		{"csharp-unknown", "C#"},
+		{"terraform-unknown", "HCL"},
+		{"r-package", "R"},
+		{"zig-build", "Zig"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if result := PrimaryLanguage(tt.input); result != tt.expected {
+				t.Errorf("PrimaryLanguage(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestProjectTypeNames_DetectorTypes tests that every project type the
+// detector can report has a display name
+func TestProjectTypeNames_DetectorTypes(t *testing.T) {
+	for _, rule := range detector.GetDetectionRules() {
+		projectType := (&detector.ProjectType{Type: rule.Type, Subtype: rule.Subtype}).String()
+		t.Run(projectType, func(t *testing.T) {
+			if _, ok := projectTypeNames[projectType]; !ok {
+				t.Errorf("projectTypeNames has no entry for %q", projectType)
+			}
+			if language := PrimaryLanguage(projectType); language == "" {
+				t.Errorf("PrimaryLanguage(%q) is empty", projectType)
+			}
+		})
+	}
+}
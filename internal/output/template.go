@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// DefaultSummaryTemplate is the text/template rendering the standard
+// GitHub Step Summary layout. Custom templates passed to
+// GenerateFromTemplate receive the same SummaryData.
+const DefaultSummaryTemplate = `## 🔧 Build Metadata
+
+{{if .Banner}}**{{.Banner}}**
+
+{{end}}{{if .Title}}### {{.Title}}
+
+| Key | Value |
+|-----|-------|
+{{.Table}}
+{{.DependencyDetails}}{{.BuildCommands}}{{if .Warnings}}### ⚠️ Extraction Warnings
+
+{{range .Warnings}}- {{.}}
+{{end}}
+{{end}}{{end}}`
+
+// CompactSummaryTemplate renders the essentials in a single small table,
+// for jobs in large matrices where full summaries are noise
+const CompactSummaryTemplate = `## 🔧 Build Metadata
+
+| Key | Value |
+|-----|-------|
+{{if .ProjectTypeName}}| Project Type | {{.ProjectTypeName}} |
+{{end}}{{if .ProjectName}}| Project Name | {{.ProjectName}} |
+{{end}}{{if .ProjectVersion}}| Project Version | {{.ProjectVersion}} |
+{{end}}{{if .MatrixJSON}}| Matrix JSON | ` + "`{{.MatrixJSON}}`" + ` |
+{{end}}
+`
+
+// defaultSummaryTemplate and compactSummaryTemplate are the built-in
+// templates, parsed once
+var (
+	defaultSummaryTemplate = template.Must(template.New("summary").Parse(DefaultSummaryTemplate))
+	compactSummaryTemplate = template.Must(template.New("summary").Parse(CompactSummaryTemplate))
+)
+
+// SummaryData is the data passed to summary templates
+type SummaryData struct {
+	// ProjectType is the detected project type, e.g. "python-modern"
+	ProjectType string
+
+	// ProjectTypeName is the display name, e.g. "Python (Modern)"
+	ProjectTypeName string
+
+	ProjectName    string
+	ProjectVersion string
+
+	// MatrixJSON is the language version matrix, e.g. {"python-version": ["3.11"]}
+	MatrixJSON string
+
+	// Common and LanguageSpecific are the metadata sections as decoded
+	// from JSON, e.g. {{index .Common "git_tag"}}
+	Common           map[string]interface{}
+	LanguageSpecific map[string]interface{}
+
+	// Tools holds the tool versions relevant to the project type
+	Tools map[string]string
+
+	// Banner is the one-line identity banner, without emphasis
+	Banner string
+
+	// Title is the Project Information heading: the repository when
+	// detected, otherwise "Project Information". Empty when the metadata
+	// has no common section.
+	Title string
+
+	// Table holds the rendered Project Information table rows
+	Table string
+
+	// DependencyDetails is the collapsible dependency table, rendered
+	// when the dependency count exceeds the collapse threshold
+	DependencyDetails string
+
+	// BuildCommands is the Build Commands section, rendered when
+	// SummaryOptions.ShowBuildCommands is set
+	BuildCommands string
+
+	// Warnings lists the extraction warnings
+	Warnings []string
+}
+
+// GenerateFromTemplate renders metadata with a custom text/template, for
+// branded or trimmed summaries. An empty template renders the default
+// summary layout.
+func GenerateFromTemplate(metadata interface{}, tmpl string) (string, error) {
+	return GenerateFromTemplateWithOptions(metadata, tmpl, DefaultSummaryOptions())
+}
+
+// GenerateFromTemplateWithOptions renders metadata with a custom
+// text/template using the supplied rendering options
+func GenerateFromTemplateWithOptions(metadata interface{}, tmpl string, opts SummaryOptions) (string, error) {
+	parsed := defaultSummaryTemplate
+	if strings.TrimSpace(tmpl) != "" {
+		var err error
+		parsed, err = template.New("summary").Option("missingkey=zero").Parse(tmpl)
+		if err != nil {
+			return "", fmt.Errorf("failed to parse summary template: %w", err)
+		}
+	}
+	return executeSummaryTemplate(parsed, newSummaryData(metadata, opts))
+}
+
+// executeSummaryTemplate renders data with tmpl
+func executeSummaryTemplate(tmpl *template.Template, data *SummaryData) (string, error) {
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("failed to render summary template: %w", err)
+	}
+	return sb.String(), nil
+}
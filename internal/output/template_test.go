@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+import (
+	"strings"
+	"testing"
+)
+
+// templateTestMetadata returns metadata for a Python project
+func templateTestMetadata() map[string]interface{} {
+	return map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_type":    "python-modern",
+			"project_name":    "example",
+			"project_version": "1.2.3",
+		},
+		"language_specific": map[string]interface{}{
+			"requires_python": ">=3.10",
+		},
+		"environment": map[string]interface{}{
+			"tools": map[string]interface{}{
+				"pip": "24.0",
+				"go":  "1.22.0",
+			},
+		},
+	}
+}
+
+func TestGenerateFromTemplate_Custom(t *testing.T) {
+	tmpl := `# {{.ProjectName}} {{.ProjectVersion}} ({{.ProjectTypeName}})
+Python: {{index .LanguageSpecific "requires_python"}}
+{{range $tool, $version := .Tools}}- {{$tool}} {{$version}}
+{{end}}`
+
+	summary, err := GenerateFromTemplate(templateTestMetadata(), tmpl)
+	if err != nil {
+		t.Fatalf("GenerateFromTemplate() error = %v", err)
+	}
+
+	expected := "# example 1.2.3 (Python (Modern))\nPython: >=3.10\n- pip 24.0\n"
+	if summary != expected {
+		t.Errorf("GenerateFromTemplate() = %q, want %q", summary, expected)
+	}
+}
+
+func TestGenerateFromTemplate_Default(t *testing.T) {
+	metadata := templateTestMetadata()
+
+	summary, err := GenerateFromTemplate(metadata, "")
+	if err != nil {
+		t.Fatalf("GenerateFromTemplate() error = %v", err)
+	}
+	if summary != GenerateSummary(metadata) {
+		t.Errorf("Empty template should render the default summary\nGot: %s", summary)
+	}
+}
+
+func TestGenerateFromTemplate_Errors(t *testing.T) {
+	if _, err := GenerateFromTemplate(templateTestMetadata(), "{{.ProjectName"); err == nil ||
+		!strings.Contains(err.Error(), "failed to parse summary template") {
+		t.Errorf("Expected a parse error, got %v", err)
+	}
+
+	if _, err := GenerateFromTemplate(templateTestMetadata(), "{{.NoSuchField}}"); err == nil ||
+		!strings.Contains(err.Error(), "failed to render summary template") {
+		t.Errorf("Expected a render error, got %v", err)
+	}
+}
+
+func TestGenerateSummaryWithOptions_Compact(t *testing.T) {
+	metadata := templateTestMetadata()
+	metadata["language_specific"].(map[string]interface{})["matrix_json"] = `{"python-version": ["3.11", "3.12"]}`
+
+	opts := DefaultSummaryOptions()
+	opts.Mode = SummaryModeCompact
+	summary := GenerateSummaryWithOptions(metadata, opts)
+
+	expected := "## 🔧 Build Metadata\n\n" +
+		"| Key | Value |\n" +
+		"|-----|-------|\n" +
+		"| Project Type | Python (Modern) |\n" +
+		"| Project Name | example |\n" +
+		"| Project Version | 1.2.3 |\n" +
+		"| Matrix JSON | `{\"python-version\": [\"3.11\", \"3.12\"]}` |\n\n"
+	if summary != expected {
+		t.Errorf("Compact summary = %q, want %q", summary, expected)
+	}
+
+	for _, omitted := range []string{"pip Version", "Requires Python", "Build Timestamp"} {
+		if strings.Contains(summary, omitted) {
+			t.Errorf("Compact summary should omit %q\nGot: %s", omitted, summary)
+		}
+	}
+}
+
+func TestParseSummaryMode(t *testing.T) {
+	for value, expected := range map[string]SummaryMode{
+		"":        SummaryModeFull,
+		"full":    SummaryModeFull,
+		"Compact": SummaryModeCompact,
+	} {
+		if mode, err := ParseSummaryMode(value); err != nil || mode != expected {
+			t.Errorf("ParseSummaryMode(%q) = %q, %v, want %q", value, mode, err, expected)
+		}
+	}
+	if _, err := ParseSummaryMode("tiny"); err == nil {
+		t.Error("ParseSummaryMode(\"tiny\") should fail")
+	}
+}
@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// toolConstraintKeys maps detected tools to the language-specific key
+// holding the project's declared version for that tool. Node.js is read
+// from the package.json engines map instead.
+var toolConstraintKeys = map[string]string{
+	"go":        "go_version",
+	"rustc":     "rust_version",
+	"java":      "java_version",
+	"javac":     "java_version",
+	"php":       "requires_php",
+	"ruby":      "ruby_version",
+	"swift":     "swift_tools_version",
+	"terraform": "terraform_version",
+	"tofu":      "terraform_version",
+}
+
+// ToolVersionMismatches returns the sorted names of detected tools whose
+// version falls outside the constraint declared in the project manifest,
+// e.g. Go 1.20 on the runner when go.mod requires 1.22
+func ToolVersionMismatches(metadata interface{}) []string {
+	metadataMap := convertToMap(metadata)
+
+	common, _ := metadataMap["common"].(map[string]interface{})
+	langSpecific, _ := metadataMap["language_specific"].(map[string]interface{})
+	env, _ := metadataMap["environment"].(map[string]interface{})
+	toolsInterface, _ := env["tools"].(map[string]interface{})
+	projectType, _ := common["project_type"].(string)
+
+	tools := make(map[string]string)
+	for k, v := range toolsInterface {
+		if strVal, ok := v.(string); ok {
+			tools[k] = strVal
+		}
+	}
+
+	return toolVersionMismatches(projectType, tools, langSpecific)
+}
+
+// toolVersionMismatches compares the tools relevant to projectType with
+// their declared constraints
+func toolVersionMismatches(projectType string, tools map[string]string, langSpecific map[string]interface{}) []string {
+	mismatches := make([]string, 0)
+	for tool, detected := range filterRelevantTools(projectType, tools) {
+		constraint := toolConstraint(tool, langSpecific)
+		if constraint == "" {
+			continue
+		}
+		if !satisfiesToolConstraint(tool, detected, constraint) {
+			mismatches = append(mismatches, tool)
+		}
+	}
+	sort.Strings(mismatches)
+	return mismatches
+}
+
+// toolConstraint returns the version constraint the project declares for
+// tool, or an empty string when there is none
+func toolConstraint(tool string, langSpecific map[string]interface{}) string {
+	if tool == "node" {
+		engines, _ := langSpecific["engines"].(map[string]interface{})
+		constraint, _ := engines["node"].(string)
+		return strings.TrimSpace(constraint)
+	}
+
+	key, ok := toolConstraintKeys[tool]
+	if !ok {
+		return ""
+	}
+	constraint, _ := langSpecific[key].(string)
+	return strings.TrimSpace(constraint)
+}
+
+// satisfiesToolConstraint reports whether detected meets constraint. A
+// bare version is a minimum, as with the go directive in go.mod; lower
+// bounds (>=, >, ^, ~, ~>, =) and upper bounds (<, <=) are checked.
+// Constraints with alternatives ("||") or unparsable versions are treated
+// as satisfied so that only definite mismatches are reported.
+func satisfiesToolConstraint(tool, detected, constraint string) bool {
+	if strings.Contains(constraint, "||") {
+		return true
+	}
+
+	detected = normalizeToolVersion(tool, detected)
+	if !isNumericVersion(detected) {
+		return true
+	}
+
+	for _, clause := range strings.FieldsFunc(constraint, func(r rune) bool { return r == ',' }) {
+		operator, bound := splitConstraintClause(clause)
+		bound = normalizeToolVersion(tool, bound)
+		if !isNumericVersion(bound) {
+			continue
+		}
+
+		cmp := compareToolVersions(detected, bound)
+		switch operator {
+		case "<":
+			if cmp >= 0 {
+				return false
+			}
+		case "<=":
+			if cmp > 0 {
+				return false
+			}
+		case ">":
+			if cmp <= 0 {
+				return false
+			}
+		case "!=":
+			// Exclusions do not describe a range
+		default:
+			if cmp < 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// splitConstraintClause splits ">= 1.22" into its operator and version
+func splitConstraintClause(clause string) (operator, version string) {
+	clause = strings.TrimSpace(clause)
+	for _, op := range []string{">=", "<=", "~>", "!=", "==", ">", "<", "^", "~", "="} {
+		if strings.HasPrefix(clause, op) {
+			return op, strings.TrimSpace(strings.TrimPrefix(clause, op))
+		}
+	}
+	return "", clause
+}
+
+// normalizeToolVersion strips a leading "v" and trailing qualifiers, and
+// maps legacy Java "1.8" style versions to "8"
+func normalizeToolVersion(tool, version string) string {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if end := strings.IndexFunc(version, func(r rune) bool {
+		return r != '.' && (r < '0' || r > '9')
+	}); end >= 0 {
+		version = version[:end]
+	}
+	version = strings.TrimSuffix(version, ".")
+	if (tool == "java" || tool == "javac") && strings.HasPrefix(version, "1.") {
+		version = strings.TrimPrefix(version, "1.")
+	}
+	return version
+}
+
+// isNumericVersion reports whether version is dot-separated integers
+func isNumericVersion(version string) bool {
+	if version == "" {
+		return false
+	}
+	for _, part := range strings.Split(version, ".") {
+		if _, err := strconv.Atoi(part); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// compareToolVersions compares dot-separated numeric versions, treating
+// missing components as zero
+func compareToolVersions(a, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aNum, bNum int
+		if i < len(aParts) {
+			aNum, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bNum, _ = strconv.Atoi(bParts[i])
+		}
+		if aNum != bNum {
+			if aNum < bNum {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
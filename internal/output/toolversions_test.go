@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// goToolMetadata builds metadata for a Go module requiring goVersion with
+// detectedGo installed on the runner
+func goToolMetadata(goVersion, detectedGo string) map[string]interface{} {
+	return map[string]interface{}{
+		"common": map[string]interface{}{
+			"project_type":    "go-module",
+			"project_name":    "test",
+			"project_version": "1.0.0",
+		},
+		"language_specific": map[string]interface{}{
+			"go_version": goVersion,
+		},
+		"environment": map[string]interface{}{
+			"tools": map[string]string{
+				"go": detectedGo,
+			},
+		},
+	}
+}
+
+// TestToolVersionMismatches_Go tests matching and mismatching Go versions
+func TestToolVersionMismatches_Go(t *testing.T) {
+	tests := []struct {
+		name     string
+		required string
+		detected string
+		expected []string
+	}{
+		{"same version", "1.22", "1.22.0", []string{}},
+		{"newer patch", "1.22", "1.22.5", []string{}},
+		{"newer minor", "1.22", "1.23.1", []string{}},
+		{"older minor", "1.22", "1.20.14", []string{"go"}},
+		{"older patch", "1.22.3", "1.22.1", []string{"go"}},
+		{"no constraint", "", "1.20.14", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ToolVersionMismatches(goToolMetadata(tt.required, tt.detected))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ToolVersionMismatches() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestSatisfiesToolConstraint tests constraint operators and version normalization
+func TestSatisfiesToolConstraint(t *testing.T) {
+	tests := []struct {
+		tool       string
+		detected   string
+		constraint string
+		expected   bool
+	}{
+		{"node", "20.11.0", ">=18", true},
+		{"node", "16.20.2", ">=18", false},
+		{"node", "20.11.0", ">=18, <20", false},
+		{"node", "16.20.2", "^16 || ^18", true},
+		{"php", "8.1.2", "^8.2", false},
+		{"java", "17.0.9", "1.8", true},
+		{"java", "1.8.0_392", "17", false},
+		{"terraform", "1.9.5", ">= 1.5.0", true},
+		{"ruby", "3.1.4", "3.2.2", false},
+		{"go", "devel", "1.22", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tool+" "+tt.detected+" "+tt.constraint, func(t *testing.T) {
+			if got := satisfiesToolConstraint(tt.tool, tt.detected, tt.constraint); got != tt.expected {
+				t.Errorf("satisfiesToolConstraint(%q, %q, %q) = %v, want %v",
+					tt.tool, tt.detected, tt.constraint, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestGenerateSummary_ToolVersionMismatch tests the summary marker for mismatched tools
+func TestGenerateSummary_ToolVersionMismatch(t *testing.T) {
+	metadata := goToolMetadata("1.22", "1.20.14")
+	metadata["environment"].(map[string]interface{})["tool_version_mismatch"] = []string{"go"}
+
+	summary := GenerateSummary(metadata)
+	if !strings.Contains(summary, "| Go Version | 1.20.14 ⚠️ (requires 1.22) |") {
+		t.Errorf("Summary should flag the mismatched Go version\nGot: %s", summary)
+	}
+
+	summary = GenerateSummary(goToolMetadata("1.22", "1.22.5"))
+	if !strings.Contains(summary, "| Go Version | 1.22.5 |") {
+		t.Errorf("Summary should show the matching Go version unmarked\nGot: %s", summary)
+	}
+}
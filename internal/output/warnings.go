@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Warning rule identifiers
+const (
+	RuleProjectNameMismatch = "project-name-mismatch"
+	RulePackageNameMismatch = "package-name-mismatch"
+	RuleEOLVersions         = "eol-versions"
+	RuleUnpinnedBaseImage   = "unpinned-base-image"
+)
+
+// Warning levels (aligned with SARIF result levels)
+const (
+	LevelError   = "error"
+	LevelWarning = "warning"
+	LevelNote    = "note"
+)
+
+// Warning describes an issue found while collecting metadata
+type Warning struct {
+	RuleID  string
+	Level   string
+	Message string
+	File    string // Manifest file the warning relates to, relative to the repository root
+}
+
+// CollectWarnings derives warnings from the collected metadata
+func CollectWarnings(metadata interface{}) []Warning {
+	return collectWarnings(convertToMap(metadata))
+}
+
+// collectWarnings derives warnings from the collected metadata map
+func collectWarnings(metadataMap map[string]interface{}) []Warning {
+	warnings := make([]Warning, 0)
+
+	common, _ := metadataMap["common"].(map[string]interface{})
+	langSpecific, _ := metadataMap["language_specific"].(map[string]interface{})
+	manifest := repoRelative(common, manifestFile(common, langSpecific))
+
+	// Project name does not match repository name
+	if match, ok := common["project_match_repo"].(bool); ok && !match {
+		projectName, _ := common["project_name"].(string)
+		warnings = append(warnings, Warning{
+			RuleID:  RuleProjectNameMismatch,
+			Level:   LevelWarning,
+			Message: fmt.Sprintf("Project name %q does not match the repository name", projectName),
+			File:    manifest,
+		})
+	}
+
+	// Project name does not match package name (Python)
+	if match, ok := langSpecific["project_match_package"].(bool); ok && !match {
+		packageName, _ := langSpecific["package_name"].(string)
+		warnings = append(warnings, Warning{
+			RuleID:  RulePackageNameMismatch,
+			Level:   LevelWarning,
+			Message: fmt.Sprintf("Project name does not match package name %q", packageName),
+			File:    manifest,
+		})
+	}
+
+	// End-of-life language versions in the build matrix
+	if eolVersions, ok := langSpecific["eol_versions"].(string); ok && strings.TrimSpace(eolVersions) != "" {
+		warnings = append(warnings, Warning{
+			RuleID:  RuleEOLVersions,
+			Level:   LevelWarning,
+			Message: fmt.Sprintf("Build matrix includes end-of-life versions: %s", eolVersions),
+			File:    manifest,
+		})
+	}
+
+	// Container base images without a pinned tag or digest
+	if baseImages, ok := langSpecific["base_images"].([]interface{}); ok {
+		stages := make(map[string]bool)
+		if buildStages, ok := langSpecific["build_stages"].([]interface{}); ok {
+			for _, stage := range buildStages {
+				if name, ok := stage.(string); ok {
+					stages[strings.ToLower(name)] = true
+				}
+			}
+		}
+
+		dockerfile := manifest
+		if dockerfile == "" {
+			dockerfile = repoRelative(common, "Dockerfile")
+		}
+		for _, image := range baseImages {
+			name, ok := image.(string)
+			if !ok || stages[strings.ToLower(name)] || !isUnpinnedImage(name) {
+				continue
+			}
+			warnings = append(warnings, Warning{
+				RuleID:  RuleUnpinnedBaseImage,
+				Level:   LevelWarning,
+				Message: fmt.Sprintf("Base image %q is not pinned to a version tag or digest", name),
+				File:    dockerfile,
+			})
+		}
+	}
+
+	return warnings
+}
+
+// CheckNameMatch returns an error when the metadata reports that the
+// project name does not match the repository name. It returns nil when
+// the names match or when the repository could not be determined.
+func CheckNameMatch(metadata interface{}) error {
+	common, _ := convertToMap(metadata)["common"].(map[string]interface{})
+
+	if match, ok := common["project_match_repo"].(bool); !ok || match {
+		return nil
+	}
+
+	projectName, _ := common["project_name"].(string)
+	repoName, _ := common["repository_name"].(string)
+	return fmt.Errorf("project name %q does not match repository name %q", projectName, repoName)
+}
+
+// manifestFile returns the manifest file the metadata was read from
+func manifestFile(common, langSpecific map[string]interface{}) string {
+	if source, ok := langSpecific["metadata_source"].(string); ok && source != "" {
+		return source
+	}
+	if source, ok := common["version_source"].(string); ok && source != "" {
+		return source
+	}
+	return ""
+}
+
+// repoRelative returns file, which is relative to the project directory,
+// relative to the repository root instead using project_path_relative
+func repoRelative(common map[string]interface{}, file string) string {
+	relPath, _ := common["project_path_relative"].(string)
+	if file == "" || relPath == "" || relPath == "." {
+		return file
+	}
+	return path.Join(filepath.ToSlash(relPath), file)
+}
+
+// isUnpinnedImage reports whether an image reference lacks a digest and
+// either has no tag or uses the floating "latest" tag
+func isUnpinnedImage(image string) bool {
+	if image == "" || image == "scratch" || strings.HasPrefix(image, "$") {
+		return false
+	}
+	if strings.Contains(image, "@sha256:") {
+		return false
+	}
+
+	// The tag follows the last colon, unless that colon is part of a
+	// registry host:port in the path
+	lastColon := strings.LastIndex(image, ":")
+	if lastColon < 0 || strings.Contains(image[lastColon:], "/") {
+		return true
+	}
+
+	return image[lastColon+1:] == "latest"
+}
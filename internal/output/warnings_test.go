@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package output
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestCheckNameMatch tests failing on project/repository name drift
+func TestCheckNameMatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		common      map[string]interface{}
+		expectError bool
+	}{
+		{
+			name: "names match",
+			common: map[string]interface{}{
+				"project_name":       "my-tool",
+				"repository_name":    "my-tool",
+				"project_match_repo": true,
+			},
+		},
+		{
+			name: "names differ",
+			common: map[string]interface{}{
+				"project_name":       "my-tool",
+				"repository_name":    "other-repo",
+				"project_match_repo": false,
+			},
+			expectError: true,
+		},
+		{
+			name: "repository detection failed",
+			common: map[string]interface{}{
+				"project_name": "my-tool",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckNameMatch(map[string]interface{}{"common": tt.common})
+			if !tt.expectError {
+				if err != nil {
+					t.Errorf("CheckNameMatch() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("CheckNameMatch() should return an error")
+			}
+			for _, want := range []string{"my-tool", "other-repo"} {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Error %q should mention %q", err.Error(), want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+// Package posture derives a security posture from signals that other
+// detectors and extractors have already collected.
+package posture
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// Posture levels
+const (
+	LevelHigh   = "high"
+	LevelMedium = "medium"
+	LevelLow    = "low"
+)
+
+// Factor names
+const (
+	FactorLockFile             = "lock_file"
+	FactorPinnedBaseImages     = "pinned_base_images"
+	FactorDependencyAutomation = "dependency_automation"
+	FactorSupportedRuntime     = "supported_runtime"
+	FactorSecurityPolicy       = "security_policy"
+)
+
+// Signals are the existing metadata signals that feed the posture
+type Signals struct {
+	HasLockFile          bool
+	UnpinnedBaseImages   int
+	DependencyAutomation string // "renovate", "dependabot", "none" or empty
+	HasEOLVersions       bool
+	HasSecurityPolicy    bool
+}
+
+// Factor is a single contributing signal
+type Factor struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+}
+
+// Posture is the derived security posture
+type Posture struct {
+	Score    int      `json:"score"`
+	MaxScore int      `json:"max_score"`
+	Level    string   `json:"level"`
+	Factors  []Factor `json:"factors"`
+}
+
+// lockFiles lists well-known dependency lock files across ecosystems
+var lockFiles = []string{
+	"package-lock.json",
+	"npm-shrinkwrap.json",
+	"yarn.lock",
+	"pnpm-lock.yaml",
+	"bun.lockb",
+	"poetry.lock",
+	"Pipfile.lock",
+	"uv.lock",
+	"pdm.lock",
+	"Cargo.lock",
+	"go.sum",
+	"Gemfile.lock",
+	"composer.lock",
+	"pubspec.lock",
+	"mix.lock",
+	"packages.lock.json",
+	"Package.resolved",
+	"gradle.lockfile",
+	"Manifest.toml",
+	".terraform.lock.hcl",
+	"conan.lock",
+}
+
+// securityPolicyFiles lists the locations GitHub recognizes for SECURITY.md
+var securityPolicyFiles = []string{
+	"SECURITY.md",
+	".github/SECURITY.md",
+	"docs/SECURITY.md",
+}
+
+// Compute derives the security posture from the given signals. Each
+// factor contributes one point to the score.
+func Compute(signals Signals) *Posture {
+	factors := []Factor{
+		{Name: FactorLockFile, Passed: signals.HasLockFile},
+		{Name: FactorPinnedBaseImages, Passed: signals.UnpinnedBaseImages == 0},
+		{Name: FactorDependencyAutomation, Passed: signals.DependencyAutomation != "" && signals.DependencyAutomation != "none"},
+		{Name: FactorSupportedRuntime, Passed: !signals.HasEOLVersions},
+		{Name: FactorSecurityPolicy, Passed: signals.HasSecurityPolicy},
+	}
+
+	score := 0
+	for _, factor := range factors {
+		if factor.Passed {
+			score++
+		}
+	}
+
+	level := LevelLow
+	switch {
+	case score >= len(factors)-1:
+		level = LevelHigh
+	case score >= len(factors)/2:
+		level = LevelMedium
+	}
+
+	return &Posture{
+		Score:    score,
+		MaxScore: len(factors),
+		Level:    level,
+		Factors:  factors,
+	}
+}
+
+// HasLockFile reports whether a well-known dependency lock file exists
+func HasLockFile(projectPath string) bool {
+	return anyFileExists(projectPath, lockFiles)
+}
+
+// HasSecurityPolicy reports whether a SECURITY.md policy exists
+func HasSecurityPolicy(projectPath string) bool {
+	return anyFileExists(projectPath, securityPolicyFiles)
+}
+
+// anyFileExists reports whether any of the named files exist in projectPath
+func anyFileExists(projectPath string, names []string) bool {
+	for _, name := range names {
+		if _, err := os.Stat(filepath.Join(projectPath, name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
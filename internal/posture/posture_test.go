@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 The Linux Foundation
+
+package posture
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestCompute_HighPosture tests a project with all positive signals
+func TestCompute_HighPosture(t *testing.T) {
+	posture := Compute(Signals{
+		HasLockFile:          true,
+		UnpinnedBaseImages:   0,
+		DependencyAutomation: "renovate",
+		HasEOLVersions:       false,
+		HasSecurityPolicy:    true,
+	})
+
+	if posture.Score != 5 || posture.MaxScore != 5 {
+		t.Errorf("Score = %d/%d, want 5/5", posture.Score, posture.MaxScore)
+	}
+	if posture.Level != LevelHigh {
+		t.Errorf("Level = %v, want %v", posture.Level, LevelHigh)
+	}
+	for _, factor := range posture.Factors {
+		if !factor.Passed {
+			t.Errorf("Factor %s should pass", factor.Name)
+		}
+	}
+}
+
+// TestCompute_LowPosture tests a project with mostly negative signals
+func TestCompute_LowPosture(t *testing.T) {
+	posture := Compute(Signals{
+		HasLockFile:          false,
+		UnpinnedBaseImages:   2,
+		DependencyAutomation: "none",
+		HasEOLVersions:       true,
+		HasSecurityPolicy:    false,
+	})
+
+	if posture.Score != 0 {
+		t.Errorf("Score = %d, want 0", posture.Score)
+	}
+	if posture.Level != LevelLow {
+		t.Errorf("Level = %v, want %v", posture.Level, LevelLow)
+	}
+	if len(posture.Factors) != 5 {
+		t.Errorf("Factors = %d, want 5", len(posture.Factors))
+	}
+}
+
+// TestFileSignals tests lock file and security policy detection on disk
+func TestFileSignals(t *testing.T) {
+	tmpDir := t.TempDir()
+	if HasLockFile(tmpDir) || HasSecurityPolicy(tmpDir) {
+		t.Fatal("Empty directory should have no lock file or security policy")
+	}
+
+	if err := os.WriteFile(filepath.Join(tmpDir, "go.sum"), []byte(""), 0644); err != nil {
+		t.Fatalf("Failed to write go.sum: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".github"), 0755); err != nil {
+		t.Fatalf("Failed to create .github: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".github", "SECURITY.md"), []byte("# Security"), 0644); err != nil {
+		t.Fatalf("Failed to write SECURITY.md: %v", err)
+	}
+
+	if !HasLockFile(tmpDir) {
+		t.Error("HasLockFile should detect go.sum")
+	}
+	if !HasSecurityPolicy(tmpDir) {
+		t.Error("HasSecurityPolicy should detect .github/SECURITY.md")
+	}
+}
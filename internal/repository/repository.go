@@ -5,124 +5,143 @@ package repository
 
 import (
 	"bufio"
+	"context"
 	"fmt"
+	"net/url"
 	"os"
-	"os/exec"
 	"path/filepath"
-	"regexp"
 	"strings"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/git"
 )
 
 // RepositoryInfo contains information about the repository source
 type RepositoryInfo struct {
-	Type         string // "github", "gerrit", or "local"
-	Organization string // GitHub org or Gerrit server
+	Type         string // "github", "gitlab", "bitbucket", "git", "gerrit", or "local"
+	Organization string // Owner path (e.g. GitLab group/subgroup) or Gerrit server
 	Repository   string // Repository/project name
 	FullName     string // Formatted full name for display
+	Host         string // Git server hostname from the remote URL, if any
 }
 
 // DetectRepository detects repository information from git remotes and .gitreview
 func DetectRepository(projectPath string) (*RepositoryInfo, error) {
+	return DetectRepositoryContext(context.Background(), projectPath)
+}
+
+// DetectRepositoryContext is DetectRepository with the git command bound
+// to ctx
+func DetectRepositoryContext(ctx context.Context, projectPath string) (*RepositoryInfo, error) {
+	gitURL, remoteErr := remoteURL(ctx, projectPath)
+
 	// Try GitHub first (from git remotes)
-	if info, err := detectGitHubRepository(projectPath); err == nil {
-		return info, nil
+	if remoteErr == nil {
+		if info, err := parseGitHubURL(gitURL); err == nil {
+			return info, nil
+		}
 	}
 
-	// Try Gerrit (from .gitreview file)
+	// Try Gerrit (from .gitreview file). Gerrit projects usually also have
+	// a remote pointing at the Gerrit server, so this comes before the
+	// other hosting providers.
 	if info, err := detectGerritRepository(projectPath); err == nil {
 		return info, nil
 	}
 
+	// Other hosting providers (GitLab, Bitbucket, self-hosted)
+	if remoteErr == nil {
+		if info, err := parseRemoteURL(gitURL); err == nil {
+			return info, nil
+		}
+	}
+
 	// Fallback to local directory name
 	return detectLocalRepository(projectPath), nil
 }
 
-// detectGitHubRepository detects GitHub repository from git remotes
-func detectGitHubRepository(projectPath string) (*RepositoryInfo, error) {
-	cmd := exec.Command("git", "remote", "-v")
-	cmd.Dir = projectPath
-	output, err := cmd.Output()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get git remotes: %w", err)
-	}
-
-	remotes := string(output)
-	lines := strings.Split(remotes, "\n")
-
-	// Look for upstream first, then origin
-	var upstreamURL, originURL string
-	for _, line := range lines {
-		if strings.Contains(line, "(fetch)") {
-			parts := strings.Fields(line)
-			if len(parts) >= 2 {
-				remoteName := parts[0]
-				remoteURL := parts[1]
-
-				if remoteName == "upstream" {
-					upstreamURL = remoteURL
-				} else if remoteName == "origin" {
-					originURL = remoteURL
-				}
-			}
-		}
-	}
+// remoteURL returns the fetch URL of the upstream remote, or of origin
+// when there is no upstream. The remotes are read through the shared git
+// client, so git runs once per path however many callers need them.
+func remoteURL(ctx context.Context, projectPath string) (string, error) {
+	remotes := git.Default().Remotes(ctx, projectPath)
 
 	// Prefer upstream (for forks), fallback to origin
-	gitURL := upstreamURL
+	gitURL := remotes["upstream"]
 	if gitURL == "" {
-		gitURL = originURL
+		gitURL = remotes["origin"]
 	}
 
 	if gitURL == "" {
-		return nil, fmt.Errorf("no git remotes found")
+		return "", fmt.Errorf("no git remotes found")
 	}
-
-	// Parse GitHub URL
-	return parseGitHubURL(gitURL)
+	return gitURL, nil
 }
 
-// parseGitHubURL extracts org and repo from GitHub URL
+// parseGitHubURL extracts org and repo from a GitHub or GitHub Enterprise
+// remote URL in any of the forms parseRemoteURL accepts
 func parseGitHubURL(gitURL string) (*RepositoryInfo, error) {
-	// Remove .git suffix if present
-	gitURL = strings.TrimSuffix(gitURL, ".git")
-
-	// Handle different GitHub URL formats:
-	// - git@github.com:org/repo.git
-	// - https://github.com/org/repo
-	// - ssh://git@github.com/org/repo
-
-	var org, repo string
-
-	// SSH format: git@github.com:org/repo
-	if strings.Contains(gitURL, "github.com:") {
-		re := regexp.MustCompile(`github\.com:([^/]+)/(.+)$`)
-		matches := re.FindStringSubmatch(gitURL)
-		if len(matches) == 3 {
-			org = matches[1]
-			repo = matches[2]
+	info, err := parseRemoteURL(gitURL)
+	if err != nil || info.Type != "github" {
+		return nil, fmt.Errorf("could not parse GitHub URL: %s", gitURL)
+	}
+	return info, nil
+}
+
+// parseRemoteURL extracts the owner path and repository name from any
+// git remote URL. SSH (git@host:path, ssh://git@host/path) and HTTPS
+// forms are supported, and every path segment before the repository name
+// is kept so nested GitLab groups produce "group/subgroup".
+func parseRemoteURL(gitURL string) (*RepositoryInfo, error) {
+	trimmed := strings.TrimSuffix(strings.TrimSuffix(strings.TrimSpace(gitURL), "/"), ".git")
+
+	var host, path string
+	if strings.Contains(trimmed, "://") {
+		parsed, err := url.Parse(trimmed)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse remote URL %s: %w", gitURL, err)
 		}
-	} else if strings.Contains(gitURL, "github.com/") {
-		// HTTPS format: https://github.com/org/repo
-		re := regexp.MustCompile(`github\.com/([^/]+)/(.+)$`)
-		matches := re.FindStringSubmatch(gitURL)
-		if len(matches) == 3 {
-			org = matches[1]
-			repo = matches[2]
+		host, path = parsed.Hostname(), parsed.Path
+	} else if before, after, ok := strings.Cut(trimmed, ":"); ok {
+		// scp-like syntax: [user@]host:path
+		host, path = before, after
+		if at := strings.LastIndex(host, "@"); at >= 0 {
+			host = host[at+1:]
 		}
 	}
 
-	if org == "" || repo == "" {
-		return nil, fmt.Errorf("could not parse GitHub URL: %s", gitURL)
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if host == "" || len(segments) < 2 || segments[0] == "" {
+		return nil, fmt.Errorf("could not parse remote URL: %s", gitURL)
 	}
 
+	org := strings.Join(segments[:len(segments)-1], "/")
+	repo := segments[len(segments)-1]
 	return &RepositoryInfo{
-		Type:         "github",
+		Type:         providerForHost(host),
 		Organization: org,
 		Repository:   repo,
 		FullName:     fmt.Sprintf("%s/%s", org, repo),
+		Host:         strings.ToLower(host),
 	}, nil
 }
 
+// providerForHost names the hosting provider of a git server, including
+// self-hosted GitHub Enterprise and GitLab instances on conventional
+// hostnames, or "git" when it is not recognized
+func providerForHost(host string) string {
+	host = strings.ToLower(host)
+	switch {
+	case host == "github.com" || strings.HasPrefix(host, "github."):
+		return "github"
+	case host == "gitlab.com" || strings.HasPrefix(host, "gitlab."):
+		return "gitlab"
+	case host == "bitbucket.org" || strings.HasPrefix(host, "bitbucket."):
+		return "bitbucket"
+	default:
+		return "git"
+	}
+}
+
 // detectGerritRepository detects Gerrit repository from .gitreview file
 func detectGerritRepository(projectPath string) (*RepositoryInfo, error) {
 	gitreviewPath := filepath.Join(projectPath, ".gitreview")
@@ -194,3 +213,83 @@ func (r *RepositoryInfo) FormatForDisplay() string {
 		return r.FullName
 	}
 }
+
+// Slug returns the provider-independent repository path, e.g. "org/repo"
+// or "group/subgroup/repo", or an empty string for a local directory
+func (r *RepositoryInfo) Slug() string {
+	switch r.Type {
+	case "local":
+		return ""
+	case "gerrit":
+		// The Gerrit project name is already the full path
+		return r.Repository
+	default:
+		return r.FullName
+	}
+}
+
+// Provider returns the hosting provider, or an empty string for a local
+// directory
+func (r *RepositoryInfo) Provider() string {
+	if r.Type == "local" {
+		return ""
+	}
+	return r.Type
+}
+
+// WebURL returns the repository's web address on the host it was cloned
+// from, so GitHub Enterprise and self-hosted GitLab links stay on that
+// server, or an empty string when the provider has no known web interface
+func (r *RepositoryInfo) WebURL() string {
+	defaultHost, known := defaultHosts[r.Type]
+	if !known {
+		return ""
+	}
+	host := r.Host
+	if host == "" {
+		host = defaultHost
+	}
+	return "https://" + host + "/" + r.FullName
+}
+
+// defaultHosts are the public servers of the providers with a known web
+// interface, used when the remote host is unknown
+var defaultHosts = map[string]string{
+	"github":    "github.com",
+	"gitlab":    "gitlab.com",
+	"bitbucket": "bitbucket.org",
+}
+
+// CommitURL returns the web address of a commit, or an empty string when
+// the provider is unknown
+func (r *RepositoryInfo) CommitURL(sha string) string {
+	base := r.WebURL()
+	if base == "" || sha == "" {
+		return ""
+	}
+	switch r.Type {
+	case "gitlab":
+		return base + "/-/commit/" + sha
+	case "bitbucket":
+		return base + "/commits/" + sha
+	default:
+		return base + "/commit/" + sha
+	}
+}
+
+// TagURL returns the web address of a tag's release page, or an empty
+// string when the provider is unknown
+func (r *RepositoryInfo) TagURL(tag string) string {
+	base := r.WebURL()
+	if base == "" || tag == "" {
+		return ""
+	}
+	switch r.Type {
+	case "gitlab":
+		return base + "/-/tags/" + tag
+	case "bitbucket":
+		return base + "/src/" + tag
+	default:
+		return base + "/releases/tag/" + tag
+	}
+}
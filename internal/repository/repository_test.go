@@ -52,6 +52,18 @@ func TestParseGitHubURL(t *testing.T) {
 			wantRepo: "version-extract-action",
 			wantErr:  false,
 		},
+		{
+			name:     "GitHub Enterprise",
+			gitURL:   "git@github.example.com:platform/service.git",
+			wantOrg:  "platform",
+			wantRepo: "service",
+			wantErr:  false,
+		},
+		{
+			name:    "GitLab URL",
+			gitURL:  "git@gitlab.com:group/repo.git",
+			wantErr: true,
+		},
 		{
 			name:    "invalid URL",
 			gitURL:  "not-a-github-url",
@@ -100,6 +112,149 @@ func TestParseGitHubURL(t *testing.T) {
 	}
 }
 
+func TestParseRemoteURL(t *testing.T) {
+	tests := []struct {
+		name         string
+		gitURL       string
+		wantProvider string
+		wantSlug     string
+		wantOrg      string
+		wantRepo     string
+		wantErr      bool
+	}{
+		{
+			name:         "GitHub SSH",
+			gitURL:       "git@github.com:lfreleng-actions/build-metadata-action.git",
+			wantProvider: "github",
+			wantSlug:     "lfreleng-actions/build-metadata-action",
+			wantOrg:      "lfreleng-actions",
+			wantRepo:     "build-metadata-action",
+		},
+		{
+			name:         "GitHub HTTPS",
+			gitURL:       "https://github.com/lfreleng-actions/build-metadata-action",
+			wantProvider: "github",
+			wantSlug:     "lfreleng-actions/build-metadata-action",
+			wantOrg:      "lfreleng-actions",
+			wantRepo:     "build-metadata-action",
+		},
+		{
+			name:         "nested GitLab groups over SSH",
+			gitURL:       "git@gitlab.com:group/subgroup/repo.git",
+			wantProvider: "gitlab",
+			wantSlug:     "group/subgroup/repo",
+			wantOrg:      "group/subgroup",
+			wantRepo:     "repo",
+		},
+		{
+			name:         "nested GitLab groups over HTTPS",
+			gitURL:       "https://gitlab.com/group/subgroup/deeper/repo.git",
+			wantProvider: "gitlab",
+			wantSlug:     "group/subgroup/deeper/repo",
+			wantOrg:      "group/subgroup/deeper",
+			wantRepo:     "repo",
+		},
+		{
+			name:         "self-hosted GitLab with ssh:// and port",
+			gitURL:       "ssh://git@gitlab.example.org:2222/platform/tools/repo.git",
+			wantProvider: "gitlab",
+			wantSlug:     "platform/tools/repo",
+			wantOrg:      "platform/tools",
+			wantRepo:     "repo",
+		},
+		{
+			name:         "Bitbucket HTTPS with user",
+			gitURL:       "https://user@bitbucket.org/team/repo.git",
+			wantProvider: "bitbucket",
+			wantSlug:     "team/repo",
+			wantOrg:      "team",
+			wantRepo:     "repo",
+		},
+		{
+			name:         "unknown host",
+			gitURL:       "https://git.example.org/org/repo",
+			wantProvider: "git",
+			wantSlug:     "org/repo",
+			wantOrg:      "org",
+			wantRepo:     "repo",
+		},
+		{
+			name:    "local path",
+			gitURL:  "/srv/git/repo.git",
+			wantErr: true,
+		},
+		{
+			name:    "missing owner",
+			gitURL:  "https://gitlab.com/repo",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := parseRemoteURL(tt.gitURL)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseRemoteURL() expected error, got %+v", info)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseRemoteURL() unexpected error: %v", err)
+			}
+
+			if info.Provider() != tt.wantProvider {
+				t.Errorf("Provider() = %v, want %v", info.Provider(), tt.wantProvider)
+			}
+			if info.Slug() != tt.wantSlug {
+				t.Errorf("Slug() = %v, want %v", info.Slug(), tt.wantSlug)
+			}
+			if info.Organization != tt.wantOrg {
+				t.Errorf("Organization = %v, want %v", info.Organization, tt.wantOrg)
+			}
+			if info.Repository != tt.wantRepo {
+				t.Errorf("Repository = %v, want %v", info.Repository, tt.wantRepo)
+			}
+		})
+	}
+}
+
+func TestSlugAndProvider(t *testing.T) {
+	tests := []struct {
+		info         *RepositoryInfo
+		wantSlug     string
+		wantProvider string
+	}{
+		{
+			info:         &RepositoryInfo{Type: "github", Organization: "org", Repository: "repo", FullName: "org/repo"},
+			wantSlug:     "org/repo",
+			wantProvider: "github",
+		},
+		{
+			info:         &RepositoryInfo{Type: "gerrit", Organization: "gerrit.example.com", Repository: "releng/global-jjb", FullName: "gerrit.example.com/releng/global-jjb"},
+			wantSlug:     "releng/global-jjb",
+			wantProvider: "gerrit",
+		},
+		{
+			info:         &RepositoryInfo{Type: "local", Repository: "my-project", FullName: "my-project"},
+			wantSlug:     "",
+			wantProvider: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.info.Type, func(t *testing.T) {
+			if got := tt.info.Slug(); got != tt.wantSlug {
+				t.Errorf("Slug() = %v, want %v", got, tt.wantSlug)
+			}
+			if got := tt.info.Provider(); got != tt.wantProvider {
+				t.Errorf("Provider() = %v, want %v", got, tt.wantProvider)
+			}
+		})
+	}
+}
+
 func TestDetectGerritRepository(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -374,3 +529,67 @@ func TestDetectRepository_Fallback(t *testing.T) {
 		t.Error("Repository name should not be empty")
 	}
 }
+
+func TestWebURLs(t *testing.T) {
+	github := &RepositoryInfo{Type: "github", Organization: "org", Repository: "repo", FullName: "org/repo"}
+	if got := github.CommitURL("abc123"); got != "https://github.com/org/repo/commit/abc123" {
+		t.Errorf("CommitURL() = %v", got)
+	}
+	if got := github.TagURL("v1.2.3"); got != "https://github.com/org/repo/releases/tag/v1.2.3" {
+		t.Errorf("TagURL() = %v", got)
+	}
+
+	enterprise, err := parseRemoteURL("git@github.example.com:org/repo.git")
+	if err != nil {
+		t.Fatalf("parseRemoteURL() unexpected error: %v", err)
+	}
+	if got := enterprise.CommitURL("abc123"); got != "https://github.example.com/org/repo/commit/abc123" {
+		t.Errorf("GitHub Enterprise CommitURL() = %v", got)
+	}
+
+	for _, tt := range []struct {
+		gitURL     string
+		wantCommit string
+		wantTag    string
+	}{
+		{
+			gitURL:     "git@gitlab.com:group/subgroup/repo.git",
+			wantCommit: "https://gitlab.com/group/subgroup/repo/-/commit/abc123",
+			wantTag:    "https://gitlab.com/group/subgroup/repo/-/tags/v1.2.3",
+		},
+		{
+			gitURL:     "https://gitlab.example.org/platform/repo.git",
+			wantCommit: "https://gitlab.example.org/platform/repo/-/commit/abc123",
+			wantTag:    "https://gitlab.example.org/platform/repo/-/tags/v1.2.3",
+		},
+		{
+			gitURL:     "https://user@bitbucket.org/team/repo.git",
+			wantCommit: "https://bitbucket.org/team/repo/commits/abc123",
+			wantTag:    "https://bitbucket.org/team/repo/src/v1.2.3",
+		},
+	} {
+		info, err := parseRemoteURL(tt.gitURL)
+		if err != nil {
+			t.Fatalf("parseRemoteURL(%q) unexpected error: %v", tt.gitURL, err)
+		}
+		if got := info.CommitURL("abc123"); got != tt.wantCommit {
+			t.Errorf("%s CommitURL() = %v, want %v", tt.gitURL, got, tt.wantCommit)
+		}
+		if got := info.TagURL("v1.2.3"); got != tt.wantTag {
+			t.Errorf("%s TagURL() = %v, want %v", tt.gitURL, got, tt.wantTag)
+		}
+	}
+
+	for _, info := range []*RepositoryInfo{
+		{Type: "git", Host: "git.example.org", Organization: "org", Repository: "repo", FullName: "org/repo"},
+		{Type: "gerrit", Organization: "gerrit.example.com", Repository: "test/project"},
+		{Type: "local", Repository: "my-project", FullName: "my-project"},
+	} {
+		if got := info.CommitURL("abc123"); got != "" {
+			t.Errorf("%s CommitURL() = %v, want empty", info.Type, got)
+		}
+		if got := info.TagURL("v1.2.3"); got != "" {
+			t.Errorf("%s TagURL() = %v, want empty", info.Type, got)
+		}
+	}
+}
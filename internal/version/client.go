@@ -4,11 +4,14 @@
 package version
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+
+	"github.com/lfreleng-actions/build-metadata-action/internal/git"
 )
 
 // VersionInfo contains version information extracted from a project
@@ -304,32 +307,27 @@ func extractRustVersion(projectPath string) (*VersionInfo, error) {
 // ensureTagsAreFetched attempts to fetch git tags from remote
 // This is useful in CI environments with shallow clones where tags aren't fetched by default
 func ensureTagsAreFetched(projectPath string) {
-	// Check if this is a git repository
-	cmd := exec.Command("git", "-C", projectPath, "rev-parse", "--git-dir")
-	if err := cmd.Run(); err != nil {
+	ctx := context.Background()
+
+	// Check if this is a git repository. This runs uncached so the tag
+	// lookups that follow the fetch see the fetched tags.
+	if _, err := git.Default().Run(ctx, projectPath, "rev-parse", "--git-dir"); err != nil {
 		// Not a git repo, skip
 		return
 	}
 
 	// Try to fetch tags quietly - don't fail if this doesn't work
 	// (repo might be offline, or tags might already be present)
-	cmd = exec.Command("git", "-C", projectPath, "fetch", "--tags", "--quiet")
-	_ = cmd.Run() // Ignore errors - this is best-effort
+	_, _ = git.Default().Run(ctx, projectPath, "fetch", "--tags", "--quiet")
 }
 
-// extractFromGit extracts version from git tags as a fallback
+// extractFromGit extracts version from git tags as a fallback. Tags were
+// fetched by extractBasic before it got here.
 func extractFromGit(projectPath string) (*VersionInfo, error) {
-	// Ensure tags are fetched (important for CI environments)
-	ensureTagsAreFetched(projectPath)
-
-	// Try to get the latest git tag
-	cmd := exec.Command("git", "-C", projectPath, "describe", "--tags", "--abbrev=0")
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		// If no tags, try to get a short commit hash
-		cmd = exec.Command("git", "-C", projectPath, "rev-parse", "--short", "HEAD")
-		output, err = cmd.CombinedOutput()
-		if err != nil {
+	repo := git.Default().Info(context.Background(), projectPath)
+	if repo.LatestTag == "" {
+		// If no tags, use a short commit hash
+		if repo.HeadSHA == "" {
 			return &VersionInfo{
 				Version:   "unknown",
 				Source:    "none",
@@ -338,15 +336,14 @@ func extractFromGit(projectPath string) (*VersionInfo, error) {
 		}
 
 		return &VersionInfo{
-			Version:   "0.0.0-dev+" + strings.TrimSpace(string(output)),
+			Version:   "0.0.0-dev+" + shortSHA(repo.HeadSHA),
 			Source:    "git-commit",
 			IsDynamic: true,
 		}, nil
 	}
 
-	version := strings.TrimSpace(string(output))
 	// Remove 'v' prefix if present
-	version = strings.TrimPrefix(version, "v")
+	version := strings.TrimPrefix(repo.LatestTag, "v")
 
 	return &VersionInfo{
 		Version:   version,
@@ -355,24 +352,30 @@ func extractFromGit(projectPath string) (*VersionInfo, error) {
 	}, nil
 }
 
+// shortSHA abbreviates a commit hash to git's default seven characters
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
+
 // GetLatestGitTag returns the latest git tag for a repository
 func GetLatestGitTag(projectPath string) (string, error) {
-	cmd := exec.Command("git", "-C", projectPath, "describe", "--tags", "--abbrev=0")
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return "", fmt.Errorf("failed to get git tag: %w", err)
+	tag := git.Default().Info(context.Background(), projectPath).LatestTag
+	if tag == "" {
+		return "", fmt.Errorf("failed to get git tag: no tag reachable from HEAD in %s", projectPath)
 	}
-	return strings.TrimSpace(string(output)), nil
+	return tag, nil
 }
 
 // GetAllGitTags returns all git tags for a repository
 func GetAllGitTags(projectPath string) ([]string, error) {
-	cmd := exec.Command("git", "-C", projectPath, "tag", "--list")
-	output, err := cmd.CombinedOutput()
+	output, err := git.Default().Run(context.Background(), projectPath, "tag", "--list")
 	if err != nil {
 		return nil, fmt.Errorf("failed to get git tags: %w", err)
 	}
 
-	tags := strings.Split(strings.TrimSpace(string(output)), "\n")
+	tags := strings.Split(strings.TrimSpace(output), "\n")
 	return tags, nil
 }